@@ -0,0 +1,75 @@
+package functions
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &CidrHostForApplianceFunction{}
+
+// applianceSubnetAttrTypes describes the object returned by cidr_host_for_appliance.
+var applianceSubnetAttrTypes = map[string]attr.Type{
+	"subnet":       types.StringType,
+	"appliance_ip": types.StringType,
+}
+
+// applianceSubnet is the subnet and appliance_ip pair of an appliance VLAN.
+type applianceSubnet struct {
+	Subnet      types.String `tfsdk:"subnet"`
+	ApplianceIp types.String `tfsdk:"appliance_ip"`
+}
+
+// CidrHostForApplianceFunction computes the subnet and appliance IP of an appliance VLAN from a CIDR.
+type CidrHostForApplianceFunction struct{}
+
+func NewCidrHostForApplianceFunction() function.Function {
+	return &CidrHostForApplianceFunction{}
+}
+
+func (f *CidrHostForApplianceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_host_for_appliance"
+}
+
+func (f *CidrHostForApplianceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compute the subnet and appliance IP of a VLAN",
+		MarkdownDescription: "Returns an object with the `subnet` and `appliance_ip` of an appliance VLAN. The subnet is the network address of `cidr` and the appliance IP is the usable host `host_number` within it, where `1` is the first usable address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 CIDR of the VLAN, for example `192.168.10.0/24`.",
+			},
+			function.Int64Parameter{
+				Name:                "host_number",
+				MarkdownDescription: "Usable host within the subnet to assign to the appliance.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: applianceSubnetAttrTypes,
+		},
+	}
+}
+
+func (f *CidrHostForApplianceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var hostNumber int64
+
+	resp.Error = req.Arguments.Get(ctx, &cidr, &hostNumber)
+	if resp.Error != nil {
+		return
+	}
+
+	subnet, applianceIp, err := utils.ApplianceSubnet(cidr, hostNumber)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, applianceSubnet{
+		Subnet:      types.StringValue(subnet),
+		ApplianceIp: types.StringValue(applianceIp),
+	})
+}
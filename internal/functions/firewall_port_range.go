@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &FirewallPortRangeFunction{}
+
+// FirewallPortRangeFunction builds the src_port or dest_port value of a firewall rule from a range of ports.
+type FirewallPortRangeFunction struct{}
+
+func NewFirewallPortRangeFunction() function.Function {
+	return &FirewallPortRangeFunction{}
+}
+
+func (f *FirewallPortRangeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "firewall_port_range"
+}
+
+func (f *FirewallPortRangeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a firewall rule port range",
+		MarkdownDescription: "Returns the `src_port` or `dest_port` value of a firewall rule for the ports `from` to `to`, such as `8080-8090`. A single port is returned when both are equal.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "from",
+				MarkdownDescription: "First port of the range, between 1 and 65535.",
+			},
+			function.Int64Parameter{
+				Name:                "to",
+				MarkdownDescription: "Last port of the range, between 1 and 65535.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FirewallPortRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var from, to int64
+
+	resp.Error = req.Arguments.Get(ctx, &from, &to)
+	if resp.Error != nil {
+		return
+	}
+
+	ports, err := utils.FirewallPortRange(from, to)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, ports)
+}
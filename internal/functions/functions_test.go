@@ -0,0 +1,76 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// run calls f with the given arguments and returns its result.
+func run(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	// Ensure the definition is valid before running the function
+	var definition function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	require.False(t, definition.Diagnostics.HasError(), definition.Diagnostics)
+
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestNormalizeMacFunction(t *testing.T) {
+	result, err := run(t, functions.NewNormalizeMacFunction(), types.StringUnknown(), types.StringValue("AA-BB-CC-DD-EE-FF"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("aa:bb:cc:dd:ee:ff"), result)
+
+	_, err = run(t, functions.NewNormalizeMacFunction(), types.StringUnknown(), types.StringValue("not-a-mac"))
+	require.NotNil(t, err)
+	assert.Equal(t, int64(0), *err.FunctionArgument)
+}
+
+func TestParseSerialFunction(t *testing.T) {
+	result, err := run(t, functions.NewParseSerialFunction(), types.StringUnknown(), types.StringValue("q2xx-abcd-1234"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("Q2XX-ABCD-1234"), result)
+
+	_, err = run(t, functions.NewParseSerialFunction(), types.StringUnknown(), types.StringValue("Q2XX-ABCD"))
+	assert.NotNil(t, err)
+}
+
+func TestCidrHostForApplianceFunction(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"subnet":       types.StringType,
+		"appliance_ip": types.StringType,
+	}
+
+	result, err := run(t, functions.NewCidrHostForApplianceFunction(), types.ObjectUnknown(attrTypes), types.StringValue("192.168.10.0/24"), types.Int64Value(1))
+	require.Nil(t, err)
+
+	expected := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"subnet":       types.StringValue("192.168.10.0/24"),
+		"appliance_ip": types.StringValue("192.168.10.1"),
+	})
+	assert.Equal(t, expected, result)
+
+	_, err = run(t, functions.NewCidrHostForApplianceFunction(), types.ObjectUnknown(attrTypes), types.StringValue("192.168.10.0/24"), types.Int64Value(255))
+	assert.NotNil(t, err)
+}
+
+func TestFirewallPortRangeFunction(t *testing.T) {
+	result, err := run(t, functions.NewFirewallPortRangeFunction(), types.StringUnknown(), types.Int64Value(8080), types.Int64Value(8090))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("8080-8090"), result)
+
+	_, err = run(t, functions.NewFirewallPortRangeFunction(), types.StringUnknown(), types.Int64Value(8090), types.Int64Value(8080))
+	assert.NotNil(t, err)
+}
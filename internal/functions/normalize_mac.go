@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &NormalizeMacFunction{}
+
+// NormalizeMacFunction converts a MAC address to the lowercase colon-separated format used by the Dashboard API.
+type NormalizeMacFunction struct{}
+
+func NewNormalizeMacFunction() function.Function {
+	return &NormalizeMacFunction{}
+}
+
+func (f *NormalizeMacFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_mac"
+}
+
+func (f *NormalizeMacFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize a MAC address",
+		MarkdownDescription: "Returns a MAC address as lowercase colon-separated octets, the format returned by the Dashboard API. Colon, hyphen and dot separated addresses as well as bare hex strings are accepted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "mac",
+				MarkdownDescription: "MAC address to normalize, for example `AA-BB-CC-DD-EE-FF` or `aabb.ccdd.eeff`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeMacFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mac string
+
+	resp.Error = req.Arguments.Get(ctx, &mac)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := utils.NormalizeMAC(mac)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
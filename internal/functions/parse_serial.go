@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ParseSerialFunction{}
+
+// ParseSerialFunction validates a Meraki serial number and returns it in upper case.
+type ParseSerialFunction struct{}
+
+func NewParseSerialFunction() function.Function {
+	return &ParseSerialFunction{}
+}
+
+func (f *ParseSerialFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_serial"
+}
+
+func (f *ParseSerialFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validate a Meraki serial number",
+		MarkdownDescription: "Returns a Meraki serial number in upper case. Fails when the serial does not have the `Qxxx-xxxx-xxxx` format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "serial",
+				MarkdownDescription: "Serial number to validate, for example `Q2XX-XXXX-XXXX`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseSerialFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serial string
+
+	resp.Error = req.Arguments.Get(ctx, &serial)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := utils.ParseSerial(serial)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, parsed)
+}
@@ -40,16 +40,16 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configuration",
-			fmt.Sprintf("Expected *openApiClient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 // Read retrieves the current user's identity and populates the Terraform state.
@@ -67,10 +67,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	tflog.Debug(ctx, "[identities_me] Calling API to retrieve identity")
 	apiResponse, httpResp, err := d.client.AdministeredApi.GetAdministeredIdentitiesMe(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"API Request Failure",
-			fmt.Sprintf("Failed to call API: %s\nDiagnostics: %s", err.Error(), utils.HttpDiagnostics(httpResp)),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("API Request Failure", httpResp, err))
 		return
 	}
 
@@ -0,0 +1,220 @@
+package leds
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource              = &Resource{}
+	_ resource.ResourceWithConfigure = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource blinks the LEDs of a device when it is created and again whenever its triggers change. Reading,
+// updating and deleting it only affect the Terraform state.
+type Resource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+type resourceModel struct {
+	Id         jsontypes.String `tfsdk:"id"`
+	Serial     jsontypes.String `tfsdk:"serial"`
+	Duration   types.Int64      `tfsdk:"duration"`
+	Period     types.Int64      `tfsdk:"period"`
+	Duty       types.Int64      `tfsdk:"duty"`
+	Triggers   types.Map        `tfsdk:"triggers"`
+	ExecutedAt types.String     `tfsdk:"executed_at"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices_blink_leds"
+}
+
+// blinkSettingAttribute returns an optional blink setting. Settings left unset are filled in from the API response,
+// and changing a setting blinks the LEDs again.
+func blinkSettingAttribute(description string, min, max int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+			int64planmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(min, max),
+		},
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Blink the LEDs on a device. The LEDs blink when the resource is created and again whenever `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:   true,
+				CustomType: jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the device",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					utils.SerialValidator(),
+				},
+			},
+			"duration":    blinkSettingAttribute("The duration in seconds. Must be between 5 and 120. Default is 20 seconds", 5, 120),
+			"period":      blinkSettingAttribute("The period in milliseconds. Must be between 100 and 1000. Default is 160 milliseconds", 100, 1000),
+			"duty":        blinkSettingAttribute("The duty cycle as the percent active. Must be between 10 and 90. Default is 50.", 10, 90),
+			"triggers":    utils.TriggersAttribute(),
+			"executed_at": utils.ExecutedAtAttribute(),
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *resourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings that are not configured are unknown in the plan and left to the API defaults
+	payload := *openApiClient.NewBlinkDeviceLedsRequest()
+	if !data.Duration.IsUnknown() {
+		payload.SetDuration(int32(data.Duration.ValueInt64()))
+	}
+	if !data.Period.IsUnknown() {
+		payload.SetPeriod(int32(data.Period.ValueInt64()))
+	}
+	if !data.Duty.IsUnknown() {
+		payload.SetDuty(int32(data.Duty.ValueInt64()))
+	}
+
+	apiCall := func() (*openApiClient.BlinkDeviceLeds202Response, *http.Response, error) {
+		return r.client.DevicesApi.BlinkDeviceLeds(ctx, data.Serial.ValueString()).BlinkDeviceLedsRequest(payload).Execute()
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to blink device LEDs", httpResp, err))
+		return
+	}
+
+	// Check for API success response code
+	if httpResp.StatusCode != 202 {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Response Status Code",
+			fmt.Sprintf("%v", httpResp.StatusCode),
+		)
+		return
+	}
+
+	// Record the settings the device was blinked with where they were left to the API defaults
+	if data.Duration.IsUnknown() {
+		data.Duration = types.Int64Value(int64(inlineResp.GetDuration()))
+	}
+	if data.Period.IsUnknown() {
+		data.Period = types.Int64Value(int64(inlineResp.GetPeriod()))
+	}
+	if data.Duty.IsUnknown() {
+		data.Duty = types.Int64Value(int64(inlineResp.GetDuty()))
+	}
+
+	data.Id = jsontypes.StringValue(data.Serial.ValueString())
+	data.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "create resource")
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *resourceModel
+
+	// The blink cannot be read back, so the state is kept as recorded on create
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *resourceModel
+
+	// Every attribute that affects the operation requires replacement, so there is nothing to send
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
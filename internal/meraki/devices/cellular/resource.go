@@ -3,6 +3,7 @@ package cellular
 import (
 	"context"
 	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
 )
@@ -34,15 +35,15 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
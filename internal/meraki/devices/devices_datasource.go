@@ -196,17 +196,17 @@ func (d *NetworkDevicesDataSource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 /*
@@ -460,7 +460,7 @@ func updateDevicesDatasourceState(ctx context.Context, state *DeviceDatasourceMo
 
 				detailsList, err := utils.ExtractListAttr(d, "details", detailsAttrs)
 				if err.HasError() {
-					tflog.Error(context.Background(), fmt.Sprintf("%s", err.Errors()))
+					tflog.Error(ctx, fmt.Sprintf("%s", err.Errors()))
 				}
 
 				device.Details = detailsList
@@ -581,10 +581,7 @@ func (d *NetworkDevicesDataSource) Read(ctx context.Context, req datasource.Read
 
 	inlineResp, httpResp, err := d.client.DevicesApi.GetNetworkDevices(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 	}
 
 	// Check for API success inlineResp code
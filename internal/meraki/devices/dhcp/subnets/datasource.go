@@ -3,6 +3,7 @@ package subnets
 import (
 	"context"
 	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,15 +36,15 @@ func (d *ApplianceDhcpSubnetsDataSource) Configure(ctx context.Context, req data
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *openApiClient.APIClient, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
-	d.client = client
+	d.client = providerData.Client
 }
 
 // Read fetches data from the API and sets the state.
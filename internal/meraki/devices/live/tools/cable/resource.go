@@ -0,0 +1,348 @@
+package cable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource              = &Resource{}
+	_ resource.ResourceWithConfigure = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource runs a cable test on switch ports when it is created and again whenever its triggers change, and waits
+// for the results. Reading, updating and deleting it only affect the Terraform state.
+type Resource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+type resourceModel struct {
+	Id         jsontypes.String   `tfsdk:"id"`
+	Serial     jsontypes.String   `tfsdk:"serial"`
+	Ports      []jsontypes.String `tfsdk:"ports"`
+	Triggers   types.Map          `tfsdk:"triggers"`
+	Timeout    types.Int64        `tfsdk:"timeout"`
+	Status     types.String       `tfsdk:"status"`
+	Results    types.List         `tfsdk:"results"`
+	ExecutedAt types.String       `tfsdk:"executed_at"`
+}
+
+type resourceModelResult struct {
+	Port      types.String `tfsdk:"port"`
+	Status    types.String `tfsdk:"status"`
+	SpeedMbps types.Int64  `tfsdk:"speed_mbps"`
+	Error     types.String `tfsdk:"error"`
+	Pairs     types.List   `tfsdk:"pairs"`
+}
+
+type resourceModelPair struct {
+	Index        types.Int64  `tfsdk:"index"`
+	Status       types.String `tfsdk:"status"`
+	LengthMeters types.Int64  `tfsdk:"length_meters"`
+}
+
+func pairAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"index":         types.Int64Type,
+		"status":        types.StringType,
+		"length_meters": types.Int64Type,
+	}
+}
+
+func resultAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"port":       types.StringType,
+		"status":     types.StringType,
+		"speed_mbps": types.Int64Type,
+		"error":      types.StringType,
+		"pairs":      types.ListType{ElemType: types.ObjectType{AttrTypes: pairAttrTypes()}},
+	}
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices_live_tools_cable_test"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Run a cable test on switch ports and wait for the results. The test runs when the resource is created and again whenever `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the cable test",
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the switch",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					utils.SerialValidator(),
+				},
+			},
+			"ports": schema.SetAttribute{
+				MarkdownDescription: "A list of ports for which to perform the cable test",
+				ElementType:         jsontypes.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"triggers": utils.TriggersAttribute(),
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of seconds to wait for the results. Defaults to `%d`.", utils.DefaultLiveToolsTimeout),
+				Optional:            true,
+				Computed:            true,
+				Default:             utils.NewInt64Default(utils.DefaultLiveToolsTimeout),
+				Validators: []validator.Int64{
+					int64validator.Between(10, 3600),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the cable test",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Results of the cable test, one for each requested port",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.StringAttribute{
+							MarkdownDescription: "The port for which the test was performed",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the port. If an error occurred during the cable test, `error` is used and the error attribute is populated",
+							Computed:            true,
+						},
+						"speed_mbps": schema.Int64Attribute{
+							MarkdownDescription: "Speed in Mbps. A speed of 0 indicates the port is down or the port speed is automatic",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "The error message if an error occurred during the cable test",
+							Computed:            true,
+						},
+						"pairs": schema.ListNestedAttribute{
+							MarkdownDescription: "Results for each twisted pair within the cable",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"index": schema.Int64Attribute{
+										MarkdownDescription: "The index of the twisted pair tested",
+										Computed:            true,
+									},
+									"status": schema.StringAttribute{
+										MarkdownDescription: "The test result of the twisted pair tested",
+										Computed:            true,
+									},
+									"length_meters": schema.Int64Attribute{
+										MarkdownDescription: "The detected length of the twisted pair",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"executed_at": utils.ExecutedAtAttribute(),
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *resourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serial := data.Serial.ValueString()
+
+	var ports []string
+	for _, port := range data.Ports {
+		ports = append(ports, port.ValueString())
+	}
+
+	payload := *openApiClient.NewCreateDeviceLiveToolsCableTestRequest(ports)
+
+	createCall := func() (*openApiClient.CreateDeviceLiveToolsCableTest201Response, *http.Response, error) {
+		return r.client.DevicesApi.CreateDeviceLiveToolsCableTest(ctx, serial).CreateDeviceLiveToolsCableTestRequest(payload).Execute()
+	}
+
+	cableTest, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, createCall)
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to start cable test", httpResp, err))
+		return
+	}
+
+	// Wait for the cable test to complete
+	getCall := func() (*openApiClient.DevicesSerialLiveToolsCableTestPostRequestMessage, *http.Response, error) {
+		return utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.DevicesSerialLiveToolsCableTestPostRequestMessage, *http.Response, error) {
+			return r.client.DevicesApi.GetDeviceLiveToolsCableTest(ctx, serial, cableTest.GetCableTestId()).Execute()
+		})
+	}
+
+	timeout := time.Duration(data.Timeout.ValueInt64()) * time.Second
+	result, httpResp, err := utils.WaitForLiveToolsJob(ctx, utils.DefaultLiveToolsPollInterval, timeout, getCall)
+	var apiErr *utils.MerakiAPIError
+	if errors.As(err, &apiErr) {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to read cable test results", httpResp, err))
+		return
+	}
+	if err != nil {
+		detail := err.Error()
+		if result != nil && result.GetError() != "" {
+			detail = fmt.Sprintf("%s: %s", detail, result.GetError())
+		}
+		resp.Diagnostics.AddError(
+			"Cable Test Did Not Complete",
+			fmt.Sprintf("Cable test %s on device %s: %s", cableTest.GetCableTestId(), serial, detail),
+		)
+		return
+	}
+
+	data.Id = jsontypes.StringValue(cableTest.GetCableTestId())
+	data.Status = types.StringValue(result.GetStatus())
+	data.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	// Record the results of the cable test
+	var results []resourceModelResult
+	for _, port := range result.GetResults() {
+		var pairs []resourceModelPair
+		for _, pair := range port.GetPairs() {
+			pairs = append(pairs, resourceModelPair{
+				Index:        types.Int64Value(int64(pair.GetIndex())),
+				Status:       types.StringValue(pair.GetStatus()),
+				LengthMeters: types.Int64Value(int64(pair.GetLengthMeters())),
+			})
+		}
+
+		pairsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pairAttrTypes()}, pairs)
+		resp.Diagnostics.Append(diags...)
+
+		results = append(results, resourceModelResult{
+			Port:      types.StringValue(port.GetPort()),
+			Status:    types.StringValue(port.GetStatus()),
+			SpeedMbps: types.Int64Value(int64(port.GetSpeedMbps())),
+			Error:     types.StringPointerValue(port.Error),
+			Pairs:     pairsValue,
+		})
+	}
+
+	resultsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: resultAttrTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	data.Results = resultsValue
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "create resource")
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *resourceModel
+
+	// The results are kept as recorded on create, since the Dashboard API only keeps them for a short time
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *resourceModel
+
+	// Only the timeout can change without replacement, so there is nothing to send
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
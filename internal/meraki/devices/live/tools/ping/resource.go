@@ -0,0 +1,281 @@
+package ping
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource              = &Resource{}
+	_ resource.ResourceWithConfigure = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource pings a target from a device when it is created and again whenever its triggers change, and waits for
+// the results. Reading, updating and deleting it only affect the Terraform state.
+type Resource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+type resourceModel struct {
+	Id             jsontypes.String `tfsdk:"id"`
+	Serial         jsontypes.String `tfsdk:"serial"`
+	Target         jsontypes.String `tfsdk:"target"`
+	PingCount      types.Int64      `tfsdk:"ping_count"`
+	Triggers       types.Map        `tfsdk:"triggers"`
+	Timeout        types.Int64      `tfsdk:"timeout"`
+	Status         types.String     `tfsdk:"status"`
+	Sent           types.Int64      `tfsdk:"sent"`
+	Received       types.Int64      `tfsdk:"received"`
+	LossPercentage types.Float64    `tfsdk:"loss_percentage"`
+	LatencyMinimum types.Float64    `tfsdk:"latency_minimum"`
+	LatencyAverage types.Float64    `tfsdk:"latency_average"`
+	LatencyMaximum types.Float64    `tfsdk:"latency_maximum"`
+	ExecutedAt     types.String     `tfsdk:"executed_at"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices_live_tools_ping"
+}
+
+// resultInt64Attribute returns a computed integer result of the ping, which keeps its value until the ping runs again.
+func resultInt64Attribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// resultFloat64Attribute returns a computed decimal result of the ping, which keeps its value until the ping runs again.
+func resultFloat64Attribute(description string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Ping a target from a device and wait for the results. The ping runs when the resource is created and again whenever `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the ping",
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the device",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					utils.SerialValidator(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "FQDN, IPv4 or IPv6 address to ping",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ping_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of pings to send. Must be between 1 and 5. Defaults to `5`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             utils.NewInt64Default(5),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+			"triggers": utils.TriggersAttribute(),
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of seconds to wait for the results. Defaults to `%d`.", utils.DefaultLiveToolsTimeout),
+				Optional:            true,
+				Computed:            true,
+				Default:             utils.NewInt64Default(utils.DefaultLiveToolsTimeout),
+				Validators: []validator.Int64{
+					int64validator.Between(10, 3600),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the ping",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sent":            resultInt64Attribute("Number of packets sent"),
+			"received":        resultInt64Attribute("Number of packets received"),
+			"loss_percentage": resultFloat64Attribute("Percentage of packets lost"),
+			"latency_minimum": resultFloat64Attribute("Minimum latency in milliseconds"),
+			"latency_average": resultFloat64Attribute("Average latency in milliseconds"),
+			"latency_maximum": resultFloat64Attribute("Maximum latency in milliseconds"),
+			"executed_at":     utils.ExecutedAtAttribute(),
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *resourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serial := data.Serial.ValueString()
+
+	payload := *openApiClient.NewCreateDeviceLiveToolsPingRequest(data.Target.ValueString())
+	payload.SetCount(int32(data.PingCount.ValueInt64()))
+
+	createCall := func() (*openApiClient.CreateDeviceLiveToolsPing201Response, *http.Response, error) {
+		return r.client.DevicesApi.CreateDeviceLiveToolsPing(ctx, serial).CreateDeviceLiveToolsPingRequest(payload).Execute()
+	}
+
+	ping, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, createCall)
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to start ping", httpResp, err))
+		return
+	}
+
+	// Wait for the ping to complete
+	getCall := func() (*openApiClient.DevicesSerialLiveToolsPingPostRequestMessage, *http.Response, error) {
+		return utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.DevicesSerialLiveToolsPingPostRequestMessage, *http.Response, error) {
+			return r.client.DevicesApi.GetDeviceLiveToolsPing(ctx, serial, ping.GetPingId()).Execute()
+		})
+	}
+
+	timeout := time.Duration(data.Timeout.ValueInt64()) * time.Second
+	result, httpResp, err := utils.WaitForLiveToolsJob(ctx, utils.DefaultLiveToolsPollInterval, timeout, getCall)
+	var apiErr *utils.MerakiAPIError
+	if errors.As(err, &apiErr) {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to read ping results", httpResp, err))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Ping Did Not Complete",
+			fmt.Sprintf("Ping %s of %s from device %s: %s", ping.GetPingId(), data.Target.ValueString(), serial, err),
+		)
+		return
+	}
+
+	// Record the results of the ping
+	results := result.GetResults()
+	loss := results.GetLoss()
+	latencies := results.GetLatencies()
+
+	data.Id = jsontypes.StringValue(ping.GetPingId())
+	data.Status = types.StringValue(result.GetStatus())
+	data.Sent = types.Int64Value(int64(results.GetSent()))
+	data.Received = types.Int64Value(int64(results.GetReceived()))
+	data.LossPercentage = types.Float64Value(float64(loss.GetPercentage()))
+	data.LatencyMinimum = types.Float64Value(float64(latencies.GetMinimum()))
+	data.LatencyAverage = types.Float64Value(float64(latencies.GetAverage()))
+	data.LatencyMaximum = types.Float64Value(float64(latencies.GetMaximum()))
+	data.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "create resource")
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *resourceModel
+
+	// The results are kept as recorded on create, since the Dashboard API only keeps them for a short time
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *resourceModel
+
+	// Only the timeout can change without replacement, so there is nothing to send
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
@@ -0,0 +1,190 @@
+package reboot
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource              = &Resource{}
+	_ resource.ResourceWithConfigure = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource reboots a device when it is created and again whenever its triggers change. Reading, updating and
+// deleting it only affect the Terraform state.
+type Resource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+type resourceModel struct {
+	Id         jsontypes.String `tfsdk:"id"`
+	Serial     jsontypes.String `tfsdk:"serial"`
+	Triggers   types.Map        `tfsdk:"triggers"`
+	Success    types.Bool       `tfsdk:"success"`
+	ExecutedAt types.String     `tfsdk:"executed_at"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices_reboot"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reboot a device. The device is rebooted when the resource is created and again whenever `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:   true,
+				CustomType: jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the device",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					utils.SerialValidator(),
+				},
+			},
+			"triggers": utils.TriggersAttribute(),
+			"success": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Dashboard API accepted the reboot",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"executed_at": utils.ExecutedAtAttribute(),
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *resourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCall := func() (*openApiClient.RebootDevice202Response, *http.Response, error) {
+		return r.client.DevicesApi.RebootDevice(ctx, data.Serial.ValueString()).Execute()
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to reboot device", httpResp, err))
+		return
+	}
+
+	// Check for API success response code
+	if httpResp.StatusCode != 202 {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Response Status Code",
+			fmt.Sprintf("%v", httpResp.StatusCode),
+		)
+		return
+	}
+
+	if !inlineResp.GetSuccess() {
+		resp.Diagnostics.AddError(
+			"Device Reboot Failed",
+			fmt.Sprintf("The Dashboard API did not reboot device %s", data.Serial.ValueString()),
+		)
+		return
+	}
+
+	data.Id = jsontypes.StringValue(data.Serial.ValueString())
+	data.Success = types.BoolValue(inlineResp.GetSuccess())
+	data.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "create resource")
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *resourceModel
+
+	// The reboot cannot be read back, so the state is kept as recorded on create
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *resourceModel
+
+	// Every attribute that affects the operation requires replacement, so there is nothing to send
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
@@ -2,13 +2,43 @@ package port
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
+// stackPortIdPattern matches stack port IDs such as 2/10 or Q2XX-XXXX-XXXX/10.
+var stackPortIdPattern = regexp.MustCompile(`^[^/]+/[^/]+$`)
+
+// resolveStackPort returns the serial and port ID of a stack port ID of the form member/port, where member is either
+// the serial of a stack member or its position in serials starting at 1.
+func resolveStackPort(stackPortId string, serials []string) (string, string, error) {
+	member, portId, ok := strings.Cut(stackPortId, "/")
+	if !ok || member == "" || portId == "" {
+		return "", "", fmt.Errorf("%q is not a stack port ID: expected the format member/port", stackPortId)
+	}
+
+	if position, err := strconv.Atoi(member); err == nil {
+		if position < 1 || position > len(serials) {
+			return "", "", fmt.Errorf("the stack has no member %d: it has %d members", position, len(serials))
+		}
+		return serials[position-1], portId, nil
+	}
+
+	for _, serial := range serials {
+		if strings.EqualFold(serial, member) {
+			return serial, portId, nil
+		}
+	}
+	return "", "", fmt.Errorf("switch %s is not a member of the stack", member)
+}
+
 func PortResourcePayload(ctx context.Context, plan *resourceModel) (openApiClient.UpdateDeviceSwitchPortRequest, diag.Diagnostics) {
 
 	// Create HTTP request body
@@ -364,3 +394,37 @@ func PortResourceState(ctx context.Context, response *openApiClient.GetDeviceSwi
 
 	return state, nil
 }
+
+// linkAggregationSettings returns the planned settings that must match across the member ports of a link
+// aggregation. Unknown and unset values are returned empty, so that they are not compared.
+func linkAggregationSettings(plan *resourceModel) map[string]string {
+	settings := map[string]attr.Value{
+		"enabled":            plan.Enabled,
+		"type":               plan.Type,
+		"vlan":               plan.Vlan,
+		"voice_vlan":         plan.VoiceVlan,
+		"allowed_vlans":      plan.AllowedVlans,
+		"access_policy_type": plan.AccessPolicyType,
+		"isolation_enabled":  plan.IsolationEnabled,
+		"rstp_enabled":       plan.RstpEnabled,
+		"stp_guard":          plan.StpGuard,
+		"link_negotiation":   plan.LinkNegotiation,
+	}
+
+	values := make(map[string]string, len(settings))
+	for name, value := range settings {
+		switch v := value.(type) {
+		case types.String:
+			values[name] = v.ValueString()
+		case types.Int64:
+			if !v.IsNull() && !v.IsUnknown() {
+				values[name] = strconv.FormatInt(v.ValueInt64(), 10)
+			}
+		case types.Bool:
+			if !v.IsNull() && !v.IsUnknown() {
+				values[name] = strconv.FormatBool(v.ValueBool())
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,29 @@
+package port
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestResolveStackPort(t *testing.T) {
+	serials := []string{"Q2XX-AAAA-0001", "Q2XX-AAAA-0002"}
+
+	// Test case: Members are given by their position in the stack
+	serial, portId, err := resolveStackPort("2/10", serials)
+	require.NoError(t, err)
+	assert.Equal(t, "Q2XX-AAAA-0002", serial)
+	assert.Equal(t, "10", portId)
+
+	// Test case: Members are given by their serial, regardless of case
+	serial, portId, err = resolveStackPort("q2xx-aaaa-0001/1", serials)
+	require.NoError(t, err)
+	assert.Equal(t, "Q2XX-AAAA-0001", serial)
+	assert.Equal(t, "1", portId)
+
+	// Test case: Members that are not in the stack are rejected
+	for _, stackPortId := range []string{"0/1", "3/1", "Q2XX-BBBB-0001/1", "1", "/1", "1/"} {
+		_, _, err = resolveStackPort(stackPortId, serials)
+		assert.Error(t, err, stackPortId)
+	}
+}
@@ -14,6 +14,9 @@ type profileModel struct {
 type resourceModel struct {
 	Id                          types.String `tfsdk:"id"`
 	Serial                      types.String `tfsdk:"serial" json:"serial"`
+	NetworkId                   types.String `tfsdk:"network_id" json:"-"`
+	SwitchStackId               types.String `tfsdk:"switch_stack_id" json:"-"`
+	StackPortId                 types.String `tfsdk:"stack_port_id" json:"-"`
 	PortId                      types.String `tfsdk:"port_id" json:"portId"`
 	Name                        types.String `tfsdk:"name" json:"name"`
 	Tags                        types.Set    `tfsdk:"tags" json:"tags"`
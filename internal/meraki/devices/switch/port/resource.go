@@ -8,18 +8,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
 	"io"
 	"net/http"
 	"strings"
-	"time"
 )
 
 var (
 	_ resource.Resource                = &Resource{} // Terraform resource interface
 	_ resource.ResourceWithConfigure   = &Resource{} // Interface for resources with configuration methods
 	_ resource.ResourceWithImportState = &Resource{} // Interface for resources with import state functionality
+	_ resource.ResourceWithModifyPlan  = &Resource{} // Interface for resources that check their plan
 )
 
 func NewResource() resource.Resource {
@@ -27,7 +28,10 @@ func NewResource() resource.Resource {
 }
 
 type Resource struct {
-	client *openApiClient.APIClient // APIClient instance for making API requests
+	client  *openApiClient.APIClient // APIClient instance for making API requests
+	retry   utils.RetryPolicy
+	batches *utils.ActionBatcher      // Set when writes are submitted as action batches
+	ports   *utils.SwitchPortRegistry // Detects conflicting settings on link aggregation member ports
 }
 
 // Metadata provides a way to define information about the resource.
@@ -58,21 +62,72 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
 	// This allows the resource to use the configured provider for any API calls it needs to make.
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+	r.batches = providerData.ActionBatches
+	r.ports = providerData.SwitchPorts
+}
+
+// ModifyPlan resolves the planned stack port ID to the serial and port ID of the member switch, and reports planned
+// settings that differ from those of the other member ports of a link aggregation.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Forget ports that are being destroyed
+	if req.Plan.Raw.IsNull() {
+		if r.ports == nil {
+			return
+		}
+		var state resourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !resp.Diagnostics.HasError() {
+			r.ports.RemovePort(utils.NewSwitchPortKey(state.Serial.ValueString(), state.PortId.ValueString()))
+		}
+		return
+	}
+
+	var plan resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.StackPortId.IsNull() {
+		plan.Serial = types.StringUnknown()
+		plan.PortId = types.StringUnknown()
+		if r.client != nil && utils.IsKnown(plan.StackPortId) && utils.IsKnown(plan.NetworkId) && utils.IsKnown(plan.SwitchStackId) {
+			resp.Diagnostics.Append(r.checkStackMembership(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	if r.ports == nil || plan.Serial.IsUnknown() || plan.PortId.IsUnknown() {
+		return
+	}
+
+	port := utils.NewSwitchPortKey(plan.Serial.ValueString(), plan.PortId.ValueString())
+	for _, conflict := range r.ports.PlanPort(port, linkAggregationSettings(&plan)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(conflict.Setting),
+			"Conflicting Link Aggregation Member Settings",
+			fmt.Sprintf("Member ports of a link aggregation must share their settings: %s.", conflict),
+		)
+	}
 }
 
 // Create method is responsible for creating a new resource.
@@ -86,22 +141,32 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	payload, diags := PortResourcePayload(context.Background(), data)
+	// Make sure the switch is stacked before its port is configured
+	resp.Diagnostics.Append(r.checkStackMembership(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := PortResourcePayload(ctx, data)
 	if diags.HasError() {
 		resp.Diagnostics.AddError("Resource Payload Error", fmt.Sprintf("\n%v", diags))
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// API call function to be passed to retryOn4xx
+	// API call function to be passed to CustomHttpRequestRetry
 	apiCall := func() (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
-		inline, httpResp, err := r.client.SwitchApi.UpdateDeviceSwitchPort(context.Background(), data.Serial.ValueString(), data.PortId.ValueString()).UpdateDeviceSwitchPortRequest(payload).Execute()
+		inline, httpResp, err := r.client.SwitchApi.UpdateDeviceSwitchPort(ctx, data.Serial.ValueString(), data.PortId.ValueString()).UpdateDeviceSwitchPortRequest(payload).Execute()
 		return inline, httpResp, err
 	}
 
-	apiResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCall)
+	var apiResp *openApiClient.GetDeviceSwitchPorts200ResponseInner
+	var httpResp *http.Response
+	var err error
+	if r.batches != nil {
+		apiResp, httpResp, err = r.updateWithActionBatch(ctx, data, payload)
+	} else {
+		apiResp, httpResp, err = utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating switch port config",
@@ -130,10 +195,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Create HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Create HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -175,21 +237,15 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
 	// usage of CustomHttpRequestRetry with a strongly typed struct
-	apiCall := func() (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error, diag.Diagnostics) {
+	apiCall := func() (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
 		inline, httpResp, err := r.client.SwitchApi.GetDeviceSwitchPort(ctx, data.Serial.ValueString(), data.PortId.ValueString()).Execute()
 
-		return inline, httpResp, err, diags
+		return inline, httpResp, err
 	}
 
-	inlineResp, httpResp, err, tfDiags := utils.CustomHttpRequestRetryStronglyTyped(ctx, maxRetries, retryDelay, apiCall)
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
-		if tfDiags.HasError() {
-			resp.Diagnostics.AddError("Diagnostics Errors", fmt.Sprintf(" %s", tfDiags.Errors()))
-		}
 		resp.Diagnostics.AddError("Error reading device switch port", fmt.Sprintf(" %s", err))
 
 		if httpResp != nil {
@@ -257,22 +313,32 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	payload, diags := PortResourcePayload(context.Background(), data)
+	// Make sure the switch is stacked before its port is configured
+	resp.Diagnostics.Append(r.checkStackMembership(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := PortResourcePayload(ctx, data)
 	if diags.HasError() {
 		resp.Diagnostics.AddError("Resource Payload Error", fmt.Sprintf("\n%v", diags))
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// API call function to be passed to retryOn4xx
+	// API call function to be passed to CustomHttpRequestRetry
 	apiCall := func() (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
-		inline, httpResp, err := r.client.SwitchApi.UpdateDeviceSwitchPort(context.Background(), data.Serial.ValueString(), data.PortId.ValueString()).UpdateDeviceSwitchPortRequest(payload).Execute()
+		inline, httpResp, err := r.client.SwitchApi.UpdateDeviceSwitchPort(ctx, data.Serial.ValueString(), data.PortId.ValueString()).UpdateDeviceSwitchPortRequest(payload).Execute()
 		return inline, httpResp, err
 	}
 
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCall)
+	var inlineResp *openApiClient.GetDeviceSwitchPorts200ResponseInner
+	var httpResp *http.Response
+	var err error
+	if r.batches != nil {
+		inlineResp, httpResp, err = r.updateWithActionBatch(ctx, data, payload)
+	} else {
+		inlineResp, httpResp, err = utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating switch port config",
@@ -351,16 +417,29 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	payload.SetAllowedVlans("1")
 	payload.SetAccessPolicyType("Open")
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// API call function to be passed to retryOn4xx
+	// API call function to be passed to CustomHttpRequestRetry
 	apiCall := func() (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
-		inline, httpResp, err := r.client.SwitchApi.UpdateDeviceSwitchPort(context.Background(), data.Serial.ValueString(), data.PortId.ValueString()).UpdateDeviceSwitchPortRequest(payload).Execute()
+		inline, httpResp, err := r.client.SwitchApi.UpdateDeviceSwitchPort(ctx, data.Serial.ValueString(), data.PortId.ValueString()).UpdateDeviceSwitchPortRequest(payload).Execute()
 		return inline, httpResp, err
 	}
 
-	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCall)
+	// Queue the port reset into an action batch when enabled
+	if r.batches != nil {
+		action, err := utils.NewBatchAction(portBatchResource(data), utils.BatchOperationUpdate, payload)
+		if err == nil {
+			_, err = r.batches.SubmitForDevice(ctx, data.Serial.ValueString(), action)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Action Batch Failure", fmt.Sprintf("Could not reset switch port %s: %s", data.PortId.ValueString(), err))
+			return
+		}
+
+		resp.State.RemoveResource(ctx)
+		tflog.Trace(ctx, "removed resource")
+		return
+	}
+
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating switch port config",
@@ -416,6 +495,72 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	tflog.Trace(ctx, "removed resource")
 }
 
+// updateWithActionBatch submits the port update as part of an action batch and reads back the updated port,
+// since action batches do not return the resources they update.
+func (r *Resource) updateWithActionBatch(ctx context.Context, data *resourceModel, payload openApiClient.UpdateDeviceSwitchPortRequest) (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
+	action, err := utils.NewBatchAction(portBatchResource(data), utils.BatchOperationUpdate, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = r.batches.SubmitForDevice(ctx, data.Serial.ValueString(), action); err != nil {
+		return nil, nil, err
+	}
+
+	return utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
+		return r.client.SwitchApi.GetDeviceSwitchPort(ctx, data.Serial.ValueString(), data.PortId.ValueString()).Execute()
+	})
+}
+
+// checkStackMembership verifies that the switch is a member of the configured switch stack, if any. When a stack port
+// ID is set, the serial and port ID of data are resolved from it first.
+func (r *Resource) checkStackMembership(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.SwitchStackId) {
+		return diags
+	}
+
+	stack, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetNetworkSwitchStacks200ResponseInner, *http.Response, error) {
+		return r.client.SwitchApi.GetNetworkSwitchStack(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString()).Execute()
+	})
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic("Failed to read switch stack", httpResp, err))
+		return diags
+	}
+
+	if utils.IsKnown(data.StackPortId) {
+		serial, portId, err := resolveStackPort(data.StackPortId.ValueString(), stack.GetSerials())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("stack_port_id"),
+				"Invalid Stack Port",
+				fmt.Sprintf("Could not resolve stack port %s of switch stack %s (%s): %s", data.StackPortId.ValueString(), stack.GetName(), data.SwitchStackId.ValueString(), err),
+			)
+			return diags
+		}
+		data.Serial = types.StringValue(serial)
+		data.PortId = types.StringValue(portId)
+	}
+
+	for _, serial := range stack.GetSerials() {
+		if strings.EqualFold(serial, data.Serial.ValueString()) {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("switch_stack_id"),
+		"Switch Not In Stack",
+		fmt.Sprintf("Switch %s is not a member of switch stack %s (%s)", data.Serial.ValueString(), stack.GetName(), data.SwitchStackId.ValueString()),
+	)
+	return diags
+}
+
+// portBatchResource returns the action batch resource path of the switch port.
+func portBatchResource(data *resourceModel) string {
+	return fmt.Sprintf("/devices/%s/switch/ports/%s", data.Serial.ValueString(), data.PortId.ValueString())
+}
+
 // ImportState function is used to import an existing resource into Terraform.
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, ",")
@@ -2,6 +2,7 @@ package port
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -16,17 +17,42 @@ var portResourceSchema = map[string]schema.Attribute{
 		Computed: true,
 	},
 	"serial": schema.StringAttribute{
-		MarkdownDescription: "The devices serial number",
-		Required:            true,
+		MarkdownDescription: "The devices serial number. Resolved from `stack_port_id` when that is set instead.",
+		Optional:            true,
+		Computed:            true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
 		},
 		Validators: []validator.String{
 			stringvalidator.LengthBetween(14, 14),
+			stringvalidator.ExactlyOneOf(path.MatchRoot("stack_port_id")),
+		},
+	},
+	"network_id": schema.StringAttribute{
+		MarkdownDescription: "The network of the switch stack given in `switch_stack_id`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("switch_stack_id")),
+		},
+	},
+	"switch_stack_id": schema.StringAttribute{
+		MarkdownDescription: "The switch stack that the switch belongs to. When set, the switch is checked to be a member of the stack before its port is configured, and `stack_port_id` can be used instead of `serial` and `port_id`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("network_id")),
+		},
+	},
+	"stack_port_id": schema.StringAttribute{
+		MarkdownDescription: "A port of the switch stack given in `switch_stack_id`, in the format `member/port`. The member is either the serial of a stack member or its position in the stack starting at 1, such as `2/10` for port 10 of the second switch. It is resolved to the `serial` and `port_id` of the member switch.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("switch_stack_id")),
+			stringvalidator.ConflictsWith(path.MatchRoot("port_id")),
+			stringvalidator.RegexMatches(stackPortIdPattern, "must have the format member/port"),
 		},
 	},
 	"port_id": schema.StringAttribute{
-		MarkdownDescription: "The identifier of the switch port.",
+		MarkdownDescription: "The identifier of the switch port. Resolved from `stack_port_id` when that is set instead.",
 		Optional:            true,
 		Computed:            true,
 	},
@@ -79,12 +105,12 @@ var portResourceSchema = map[string]schema.Attribute{
 		},
 	},
 	"access_policy_number": schema.Int64Attribute{
-		MarkdownDescription: "The number of a custom access policy to configure on the switch port. Only applicable when 'accessPolicyType' is 'Custom access policy'.",
+		MarkdownDescription: "The number of a custom access policy to configure on the switch port, such as the `access_policy_number` of a `meraki_networks_switch_access_policy`. Only applicable when 'accessPolicyType' is 'Custom access policy'.",
 		Optional:            true,
 		Computed:            true,
 	},
 	"port_schedule_id": schema.StringAttribute{
-		MarkdownDescription: "The ID of the port schedule. A value of null will clear the port schedule.",
+		MarkdownDescription: "The ID of the port schedule, such as the `port_schedule_id` of a `meraki_networks_switch_port_schedule`. A value of null will clear the port schedule.",
 		Optional:            true,
 		Computed:            true,
 	},
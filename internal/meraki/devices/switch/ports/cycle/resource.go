@@ -2,14 +2,21 @@ package cycle
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -22,13 +29,20 @@ func NewResource() resource.Resource {
 	return &PortsCycleResource{}
 }
 
+// PortsCycleResource cycles switch ports when it is created and again whenever its triggers change. Reading,
+// updating and deleting it only affect the Terraform state.
 type PortsCycleResource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 type PortsCycleResourceModel struct {
-	Id    jsontypes.String   `tfsdk:"id" json:"serial"`
-	Ports []jsontypes.String `tfsdk:"ports" json:"ports"`
+	Id          jsontypes.String   `tfsdk:"id"`
+	Serial      jsontypes.String   `tfsdk:"serial"`
+	Ports       []jsontypes.String `tfsdk:"ports"`
+	Triggers    types.Map          `tfsdk:"triggers"`
+	CycledPorts []jsontypes.String `tfsdk:"cycled_ports"`
+	ExecutedAt  types.String       `tfsdk:"executed_at"`
 }
 
 func (r *PortsCycleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,18 +51,47 @@ func (r *PortsCycleResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *PortsCycleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Cycle a set of switch ports",
+		MarkdownDescription: "Cycle a set of switch ports. The ports are cycled when the resource is created and again whenever `triggers` change.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:   true,
 				CustomType: jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the switch",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					utils.SerialValidator(),
+				},
 			},
 			"ports": schema.SetAttribute{
-				MarkdownDescription: "URL that is consuming SAML Identity Provider (IdP)",
+				MarkdownDescription: "List of switch ports. Example: [1, 2-5, 1_MA-MOD-8X10G_1, 1_MA-MOD-8X10G_2-1_MA-MOD-8X10G_8]",
+				ElementType:         jsontypes.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"triggers": utils.TriggersAttribute(),
+			"cycled_ports": schema.SetAttribute{
+				MarkdownDescription: "The ports that were cycled, as reported by the Dashboard API",
 				ElementType:         jsontypes.StringType,
-				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"executed_at": utils.ExecutedAtAttribute(),
 		},
 	}
 }
@@ -59,18 +102,19 @@ func (r *PortsCycleResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
 func (r *PortsCycleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -91,13 +135,13 @@ func (r *PortsCycleResource) Create(ctx context.Context, req resource.CreateRequ
 
 	payload := openApiClient.NewCycleDeviceSwitchPortsRequest(ports)
 
-	_, httpResp, err := r.client.PortsApi.CycleDeviceSwitchPorts(ctx, data.Id.ValueString()).CycleDeviceSwitchPortsRequest(*payload).Execute()
+	apiCall := func() (*openApiClient.CycleDeviceSwitchPorts200Response, *http.Response, error) {
+		return r.client.SwitchApi.CycleDeviceSwitchPorts(ctx, data.Serial.ValueString()).CycleDeviceSwitchPortsRequest(*payload).Execute()
+	}
 
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to cycle switch ports", httpResp, err))
 		return
 	}
 
@@ -107,24 +151,17 @@ func (r *PortsCycleResource) Create(ctx context.Context, req resource.CreateRequ
 			"Unexpected HTTP Response Status Code",
 			fmt.Sprintf("%v", httpResp.StatusCode),
 		)
-	}
-
-	// Check for errors after diagnostics collected
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("Plan Data", fmt.Sprintf("\n%s", data))
 		return
 	}
 
-	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
-		resp.Diagnostics.AddError(
-			"JSON decoding error",
-			fmt.Sprintf("%v\n", err.Error()),
-		)
-		return
+	// Record the result of the operation
+	data.CycledPorts = []jsontypes.String{}
+	for _, port := range inlineResp.GetPorts() {
+		data.CycledPorts = append(data.CycledPorts, jsontypes.StringValue(port))
 	}
 
-	data.Id = jsontypes.StringValue("example-id")
+	data.Id = jsontypes.StringValue(data.Serial.ValueString())
+	data.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -135,7 +172,7 @@ func (r *PortsCycleResource) Create(ctx context.Context, req resource.CreateRequ
 func (r *PortsCycleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data *PortsCycleResourceModel
 
-	// Read Terraform plan data into the model
+	// The port cycle cannot be read back, so the state is kept as recorded on create
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
@@ -151,7 +188,7 @@ func (r *PortsCycleResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *PortsCycleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *PortsCycleResourceModel
 
-	// Read Terraform plan data into the model
+	// Every attribute that affects the operation requires replacement, so there is nothing to send
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
@@ -165,16 +202,7 @@ func (r *PortsCycleResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *PortsCycleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data *PortsCycleResourceModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.State.RemoveResource(ctx)
 
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "removed resource")
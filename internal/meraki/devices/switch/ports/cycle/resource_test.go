@@ -41,7 +41,9 @@ func TestAccDevicesSwitchPortsCycleResource(t *testing.T) {
 				{
 					Config: testAccDevicesSwitchPortsCycleResourceConfigCycle(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), os.Getenv("TF_ACC_MERAKI_MS_SERIAL")),
 					Check: resource.ComposeAggregateTestCheckFunc(
-						resource.TestCheckResourceAttr("meraki_devices_switch_ports_cycle.test", "id", "example-id"),
+						resource.TestCheckResourceAttr("meraki_devices_switch_ports_cycle.test", "id", os.Getenv("TF_ACC_MERAKI_MS_SERIAL")),
+						resource.TestCheckResourceAttr("meraki_devices_switch_ports_cycle.test", "cycled_ports.#", "1"),
+						resource.TestCheckResourceAttrSet("meraki_devices_switch_ports_cycle.test", "executed_at"),
 					),
 				},
 			*/
@@ -5,13 +5,10 @@ import (
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
 	"io"
-	"net/http"
-	"time"
 )
 
 var _ datasource.DataSource = &DevicesSwitchPortsStatusesDataSource{}
@@ -24,6 +21,7 @@ func NewDataSource() datasource.DataSource {
 // It includes an APIClient field for making requests to the Meraki API.
 type DevicesSwitchPortsStatusesDataSource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 func (d *DevicesSwitchPortsStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,26 +47,26 @@ func (d *DevicesSwitchPortsStatusesDataSource) Configure(ctx context.Context, re
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// This allows the data source to use the configured provider for any API calls it needs to make.
-	d.client = client
+	d.client = providerData.Client
+	d.retry = providerData.Retry
 }
 
 // Read method is responsible for reading an existing data source's state.
 func (d *DevicesSwitchPortsStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data DataSourceModel
-	var diags diag.Diagnostics
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -77,22 +75,9 @@ func (d *DevicesSwitchPortsStatusesDataSource) Read(ctx context.Context, req dat
 		return
 	}
 
-	maxRetries := d.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(d.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// usage of CustomHttpRequestRetry with a slice of strongly typed structs
-	apiCallSlice := func() ([]openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error, diag.Diagnostics) {
-		inline, httpResp, err := d.client.SwitchApi.GetDeviceSwitchPorts(ctx, data.Serial.ValueString()).Execute()
-		return inline, httpResp, err, diags
-	}
-
-	resultSlice, httpRespSlice, errSlice, tfDiags := utils.CustomHttpRequestRetryStronglyTyped(ctx, maxRetries, retryDelay, apiCallSlice)
+	resultSlice, httpRespSlice, errSlice := listSwitchPorts(ctx, d.client, d.retry, data.Serial.ValueString())
 	if errSlice != nil {
 
-		if tfDiags.HasError() {
-			fmt.Printf(": %s\n", tfDiags.Errors())
-		}
-
 		fmt.Printf("Error creating group policy: %s\n", errSlice)
 		if httpRespSlice != nil {
 			var responseBody string
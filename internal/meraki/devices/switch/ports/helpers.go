@@ -1,8 +1,17 @@
 package ports
 
 import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"strconv"
+	"strings"
 )
 
 func mapSwitchDataToPort(switchData openApiClient.GetDeviceSwitchPorts200ResponseInner) ResourceModel {
@@ -45,3 +54,334 @@ func mapSwitchDataToPort(switchData openApiClient.GetDeviceSwitchPorts200Respons
 
 	return devicesSwitchPortData
 }
+
+// listSwitchPorts returns every port of a switch in a single request.
+func listSwitchPorts(ctx context.Context, client *openApiClient.APIClient, retry utils.RetryPolicy, serial string) ([]openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
+	return utils.CustomHttpRequestRetry(ctx, retry, func() ([]openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
+		return client.SwitchApi.GetDeviceSwitchPorts(ctx, serial).Execute()
+	})
+}
+
+// expandPortRanges returns the port IDs of a comma separated list of ports and port ranges, such as "1-24,49".
+// Ranges of module ports share the prefix of their first and last port, such as
+// "1_MA-MOD-8X10G_1-1_MA-MOD-8X10G_8". Ports listed more than once are returned once.
+func expandPortRanges(ranges string) ([]string, error) {
+	var portIds []string
+	seen := map[string]bool{}
+
+	for _, entry := range strings.Split(ranges, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			return nil, fmt.Errorf("%q contains an empty port range", ranges)
+		}
+
+		expanded, err := expandPortRange(entry)
+		if err != nil {
+			return nil, err
+		}
+		for _, portId := range expanded {
+			if !seen[portId] {
+				seen[portId] = true
+				portIds = append(portIds, portId)
+			}
+		}
+	}
+
+	return portIds, nil
+}
+
+// expandPortRange returns the port IDs of a single port or port range.
+func expandPortRange(entry string) ([]string, error) {
+	// Module port IDs contain hyphens, so try each one as the separator of the range
+	for i, c := range entry {
+		if c != '-' {
+			continue
+		}
+
+		firstPrefix, first, firstOk := splitPortId(entry[:i])
+		lastPrefix, last, lastOk := splitPortId(entry[i+1:])
+		if !firstOk || !lastOk || firstPrefix != lastPrefix {
+			continue
+		}
+		if first > last {
+			return nil, fmt.Errorf("port range %q ends before it starts", entry)
+		}
+
+		portIds := make([]string, 0, last-first+1)
+		for port := first; port <= last; port++ {
+			portIds = append(portIds, firstPrefix+strconv.Itoa(port))
+		}
+		return portIds, nil
+	}
+
+	if strings.HasPrefix(entry, "-") || strings.HasSuffix(entry, "-") {
+		return nil, fmt.Errorf("port range %q is missing a port", entry)
+	}
+	return []string{entry}, nil
+}
+
+// splitPortId splits a port ID into the prefix and number of its trailing digits, so "1_MA-MOD-8X10G_3" is split
+// into "1_MA-MOD-8X10G_" and 3.
+func splitPortId(portId string) (string, int, bool) {
+	digits := len(portId)
+	for digits > 0 && portId[digits-1] >= '0' && portId[digits-1] <= '9' {
+		digits--
+	}
+	if digits == len(portId) {
+		return "", 0, false
+	}
+
+	number, err := strconv.Atoi(portId[digits:])
+	if err != nil {
+		return "", 0, false
+	}
+	return portId[:digits], number, true
+}
+
+// configuredPorts returns the settings configured for each port of the configs, along with the port IDs in the
+// order they are configured. Settings that are not configured are left out. known is false when a config is not
+// known yet, in which case the ports cannot be planned.
+func configuredPorts(configs types.List) (ports map[string]map[string]attr.Value, portIds []string, known bool, diags diag.Diagnostics) {
+	if configs.IsNull() {
+		return map[string]map[string]attr.Value{}, nil, true, diags
+	}
+	if configs.IsUnknown() {
+		return nil, nil, false, diags
+	}
+
+	ports = map[string]map[string]attr.Value{}
+	owners := map[string]int{}
+	known = true
+
+	for i, element := range configs.Elements() {
+		config, ok := element.(types.Object)
+		if !ok || config.IsUnknown() {
+			known = false
+			continue
+		}
+
+		attributes := config.Attributes()
+		ranges, ok := attributes["ports"].(types.String)
+		if !ok || ranges.IsUnknown() {
+			known = false
+			continue
+		}
+
+		configPath := path.Root("configs").AtListIndex(i)
+		expanded, err := expandPortRanges(ranges.ValueString())
+		if err != nil {
+			diags.AddAttributeError(configPath.AtName("ports"), "Invalid Port Range", err.Error())
+			continue
+		}
+
+		settings := map[string]attr.Value{}
+		for _, setting := range portSettings {
+			if value, ok := attributes[setting.Name]; ok && !value.IsNull() {
+				settings[setting.Name] = value
+			}
+		}
+
+		for _, portId := range expanded {
+			if owner, ok := owners[portId]; ok {
+				diags.AddAttributeError(configPath.AtName("ports"), "Overlapping Port Ranges",
+					fmt.Sprintf("Port %s is already configured by configs[%d]. Each port can only be configured once.", portId, owner))
+				continue
+			}
+			owners[portId] = i
+			ports[portId] = settings
+			portIds = append(portIds, portId)
+		}
+	}
+
+	return ports, portIds, known, diags
+}
+
+// plannedPorts returns the planned settings of each configured port: the configured settings applied over the
+// settings currently in state. Settings of new ports that are not configured are unknown until they are read.
+func plannedPorts(configured map[string]map[string]attr.Value, state types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elementType := types.ObjectType{AttrTypes: portSettingsAttrTypes()}
+
+	var current map[string]attr.Value
+	if !state.IsNull() && !state.IsUnknown() {
+		current = state.Elements()
+	}
+
+	ports := make(map[string]attr.Value, len(configured))
+	for portId, settings := range configured {
+		values := make(map[string]attr.Value, len(portSettings))
+		if object, ok := current[portId].(types.Object); ok && !object.IsNull() && !object.IsUnknown() {
+			for name, value := range object.Attributes() {
+				values[name] = value
+			}
+		} else {
+			for _, setting := range portSettings {
+				values[setting.Name] = unknownValue(setting.Type)
+			}
+		}
+
+		for name, value := range settings {
+			values[name] = value
+		}
+
+		var objectDiags diag.Diagnostics
+		ports[portId], objectDiags = types.ObjectValue(portSettingsAttrTypes(), values)
+		diags.Append(objectDiags...)
+	}
+
+	planned, mapDiags := types.MapValue(elementType, ports)
+	diags.Append(mapDiags...)
+	return planned, diags
+}
+
+// readPorts returns the settings of the configured ports from the ports of the switch. Configured settings are
+// applied over the values read when given, so that the state matches the plan right after the ports are written.
+func readPorts(response []openApiClient.GetDeviceSwitchPorts200ResponseInner, portIds []string, configured map[string]map[string]attr.Value) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elementType := types.ObjectType{AttrTypes: portSettingsAttrTypes()}
+
+	byId := make(map[string]openApiClient.GetDeviceSwitchPorts200ResponseInner, len(response))
+	for _, port := range response {
+		byId[port.GetPortId()] = port
+	}
+
+	ports := make(map[string]attr.Value, len(portIds))
+	for _, portId := range portIds {
+		port, ok := byId[portId]
+		if !ok {
+			diags.AddError("Unknown Switch Port", fmt.Sprintf("The switch does not have a port %s.", portId))
+			continue
+		}
+
+		values, err := portSettingValues(port)
+		if err != nil {
+			diags.AddError("Resource Response Error", fmt.Sprintf("Could not read switch port %s: %s", portId, err))
+			continue
+		}
+		for name, value := range configured[portId] {
+			values[name] = value
+		}
+
+		var objectDiags diag.Diagnostics
+		ports[portId], objectDiags = types.ObjectValue(portSettingsAttrTypes(), values)
+		diags.Append(objectDiags...)
+	}
+
+	if diags.HasError() {
+		return types.MapNull(elementType), diags
+	}
+
+	result, mapDiags := types.MapValue(elementType, ports)
+	diags.Append(mapDiags...)
+	return result, diags
+}
+
+// portSettingValues returns the settings of a switch port returned by the Dashboard API.
+func portSettingValues(port openApiClient.GetDeviceSwitchPorts200ResponseInner) (map[string]attr.Value, error) {
+	var fields map[string]interface{}
+	if err := utils.ConvertJSON(port, &fields); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]attr.Value, len(portSettings))
+	for _, setting := range portSettings {
+		field, ok := fields[setting.ApiName]
+
+		switch setting.Type {
+		case types.StringType:
+			value, _ := field.(string)
+			values[setting.Name] = types.StringValue(value)
+			if !ok {
+				values[setting.Name] = types.StringNull()
+			}
+		case types.Int64Type:
+			value, _ := field.(float64)
+			values[setting.Name] = types.Int64Value(int64(value))
+			if !ok {
+				values[setting.Name] = types.Int64Null()
+			}
+		case types.BoolType:
+			value, _ := field.(bool)
+			values[setting.Name] = types.BoolValue(value)
+			if !ok {
+				values[setting.Name] = types.BoolNull()
+			}
+		default:
+			elements := []attr.Value{}
+			items, _ := field.([]interface{})
+			for _, item := range items {
+				if value, ok := item.(string); ok {
+					elements = append(elements, types.StringValue(value))
+				}
+			}
+			set, diags := types.SetValue(types.StringType, elements)
+			if diags.HasError() {
+				return nil, fmt.Errorf("reading %s", setting.ApiName)
+			}
+			values[setting.Name] = set
+		}
+	}
+	return values, nil
+}
+
+// portSettingsPayload returns the request that applies the configured settings to a switch port.
+func portSettingsPayload(ctx context.Context, settings map[string]attr.Value) (openApiClient.UpdateDeviceSwitchPortRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var payload openApiClient.UpdateDeviceSwitchPortRequest
+
+	body := map[string]interface{}{}
+	for _, setting := range portSettings {
+		value, ok := settings[setting.Name]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		switch v := value.(type) {
+		case types.String:
+			body[setting.ApiName] = v.ValueString()
+		case types.Int64:
+			body[setting.ApiName] = v.ValueInt64()
+		case types.Bool:
+			body[setting.ApiName] = v.ValueBool()
+		case types.Set:
+			elements := []string{}
+			diags.Append(v.ElementsAs(ctx, &elements, false)...)
+			body[setting.ApiName] = elements
+		}
+	}
+
+	if err := utils.ConvertJSON(body, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the switch port payload: %s", err))
+	}
+	return payload, diags
+}
+
+// registrySettings returns the configured settings of a port in the format of the switch port registry, which
+// compares them with the other member ports of its link aggregation.
+func registrySettings(settings map[string]attr.Value) map[string]string {
+	values := make(map[string]string, len(settings))
+	for name, value := range settings {
+		switch v := value.(type) {
+		case types.String:
+			values[name] = v.ValueString()
+		case types.Int64:
+			values[name] = strconv.FormatInt(v.ValueInt64(), 10)
+		case types.Bool:
+			values[name] = strconv.FormatBool(v.ValueBool())
+		}
+	}
+	return values
+}
+
+func unknownValue(attrType attr.Type) attr.Value {
+	switch attrType {
+	case types.StringType:
+		return types.StringUnknown()
+	case types.Int64Type:
+		return types.Int64Unknown()
+	case types.BoolType:
+		return types.BoolUnknown()
+	default:
+		return types.SetUnknown(types.StringType)
+	}
+}
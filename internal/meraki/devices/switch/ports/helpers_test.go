@@ -0,0 +1,157 @@
+package ports
+
+import (
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExpandPortRanges(t *testing.T) {
+	tests := []struct {
+		ranges   string
+		expected []string
+		err      string
+	}{
+		{ranges: "1-4,49", expected: []string{"1", "2", "3", "4", "49"}},
+		{ranges: "3, 1-2, 2", expected: []string{"3", "1", "2"}},
+		{ranges: "1_MA-MOD-8X10G_1-1_MA-MOD-8X10G_3", expected: []string{"1_MA-MOD-8X10G_1", "1_MA-MOD-8X10G_2", "1_MA-MOD-8X10G_3"}},
+		{ranges: "1_MA-MOD-8X10G_4", expected: []string{"1_MA-MOD-8X10G_4"}},
+		{ranges: "5-3", err: "ends before it starts"},
+		{ranges: "1,,2", err: "empty port range"},
+		{ranges: "4-", err: "missing a port"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ranges, func(t *testing.T) {
+			portIds, err := expandPortRanges(test.ranges)
+			if test.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, portIds)
+		})
+	}
+}
+
+// testConfig returns a config that applies the given settings to a range of ports.
+func testConfig(ranges string, settings map[string]attr.Value) types.Object {
+	values := map[string]attr.Value{"ports": types.StringValue(ranges)}
+	for _, setting := range portSettings {
+		values[setting.Name] = nullValue(setting.Type)
+	}
+	for name, value := range settings {
+		values[name] = value
+	}
+	return types.ObjectValueMust(portConfigAttrTypes(), values)
+}
+
+func nullValue(attrType attr.Type) attr.Value {
+	switch attrType {
+	case types.StringType:
+		return types.StringNull()
+	case types.Int64Type:
+		return types.Int64Null()
+	case types.BoolType:
+		return types.BoolNull()
+	default:
+		return types.SetNull(types.StringType)
+	}
+}
+
+func TestConfiguredPorts(t *testing.T) {
+	configs := types.ListValueMust(types.ObjectType{AttrTypes: portConfigAttrTypes()}, []attr.Value{
+		testConfig("1-2", map[string]attr.Value{"type": types.StringValue("access"), "vlan": types.Int64Value(10)}),
+		testConfig("3", map[string]attr.Value{"type": types.StringValue("trunk")}),
+	})
+
+	ports, portIds, known, diags := configuredPorts(configs)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, known)
+	assert.Equal(t, []string{"1", "2", "3"}, portIds)
+	assert.Equal(t, map[string]attr.Value{"type": types.StringValue("access"), "vlan": types.Int64Value(10)}, ports["2"])
+	assert.Equal(t, map[string]attr.Value{"type": types.StringValue("trunk")}, ports["3"])
+
+	// Test case: A port can only be configured once
+	configs = types.ListValueMust(types.ObjectType{AttrTypes: portConfigAttrTypes()}, []attr.Value{
+		testConfig("1-4", nil),
+		testConfig("4-6", nil),
+	})
+	_, _, _, diags = configuredPorts(configs)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Overlapping Port Ranges", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "Port 4 is already configured by configs[0]")
+
+	// Test case: Unknown port ranges cannot be planned
+	unknown := testConfig("1", nil).Attributes()
+	unknown["ports"] = types.StringUnknown()
+	configs = types.ListValueMust(types.ObjectType{AttrTypes: portConfigAttrTypes()}, []attr.Value{
+		types.ObjectValueMust(portConfigAttrTypes(), unknown),
+	})
+	_, _, known, diags = configuredPorts(configs)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, known)
+}
+
+func testSwitchPorts(t *testing.T) []openApiClient.GetDeviceSwitchPorts200ResponseInner {
+	var response []openApiClient.GetDeviceSwitchPorts200ResponseInner
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"portId": "1", "name": "uplink", "enabled": true, "type": "trunk", "vlan": 1, "allowedVlans": "all", "tags": ["core"]},
+		{"portId": "2", "enabled": false, "type": "access", "vlan": 20, "tags": []}
+	]`), &response))
+	return response
+}
+
+func TestPlannedAndReadPorts(t *testing.T) {
+	configured := map[string]map[string]attr.Value{
+		"1": {"vlan": types.Int64Value(10)},
+		"2": {"vlan": types.Int64Value(10)},
+	}
+
+	// Test case: Ports read as they are
+	current, diags := readPorts(testSwitchPorts(t), []string{"1"}, nil)
+	require.False(t, diags.HasError(), diags)
+	port := current.Elements()["1"].(types.Object).Attributes()
+	assert.Equal(t, types.Int64Value(1), port["vlan"])
+	assert.Equal(t, types.StringValue("uplink"), port["name"])
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("core")}), port["tags"])
+
+	// Test case: Configured settings are planned over the current settings, and new ports are unknown otherwise
+	planned, diags := plannedPorts(configured, current)
+	require.False(t, diags.HasError(), diags)
+
+	port = planned.Elements()["1"].(types.Object).Attributes()
+	assert.Equal(t, types.Int64Value(10), port["vlan"])
+	assert.Equal(t, types.StringValue("trunk"), port["type"])
+
+	port = planned.Elements()["2"].(types.Object).Attributes()
+	assert.Equal(t, types.Int64Value(10), port["vlan"])
+	assert.True(t, port["type"].IsUnknown())
+
+	// Test case: Configured settings are applied over the ports read after they are written
+	written, diags := readPorts(testSwitchPorts(t), []string{"1", "2"}, configured)
+	require.False(t, diags.HasError(), diags)
+	port = written.Elements()["2"].(types.Object).Attributes()
+	assert.Equal(t, types.Int64Value(10), port["vlan"])
+	assert.Equal(t, types.BoolValue(false), port["enabled"])
+
+	// Test case: Ports the switch does not have are reported
+	_, diags = readPorts(testSwitchPorts(t), []string{"52"}, nil)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unknown Switch Port", diags.Errors()[0].Summary())
+}
+
+func TestRegistrySettings(t *testing.T) {
+	// Test case: Settings are compared as strings for link aggregation members
+	assert.Equal(t, map[string]string{"type": "access", "vlan": "10", "poe_enabled": "false"}, registrySettings(map[string]attr.Value{
+		"type":        types.StringValue("access"),
+		"vlan":        types.Int64Value(10),
+		"poe_enabled": types.BoolValue(false),
+		"tags":        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("desk")}),
+	}))
+}
@@ -1,6 +1,7 @@
 package ports
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -46,3 +47,57 @@ type DataSourceModel struct {
 	Serial types.String    `tfsdk:"serial"`
 	List   []ResourceModel `tfsdk:"list"`
 }
+
+// PortsConfigResourceModel describes the data model of the switch ports config resource.
+type PortsConfigResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Serial  types.String `tfsdk:"serial"`
+	Configs types.List   `tfsdk:"configs"`
+	Ports   types.Map    `tfsdk:"ports"`
+}
+
+// portSetting is a switch port setting that the switch ports config resource applies to port ranges.
+type portSetting struct {
+	Name        string
+	ApiName     string
+	Type        attr.Type
+	Description string
+}
+
+// portSettings are the settings that can be applied to port ranges, in the order they are documented.
+var portSettings = []portSetting{
+	{"name", "name", types.StringType, "The name of the switch port."},
+	{"tags", "tags", types.SetType{ElemType: types.StringType}, "The list of tags of the switch port."},
+	{"enabled", "enabled", types.BoolType, "The status of the switch port."},
+	{"poe_enabled", "poeEnabled", types.BoolType, "The PoE status of the switch port."},
+	{"type", "type", types.StringType, "The type of the switch port ('trunk' or 'access')."},
+	{"vlan", "vlan", types.Int64Type, "The VLAN of the switch port."},
+	{"voice_vlan", "voiceVlan", types.Int64Type, "The voice VLAN of the switch port. Only applicable to access ports."},
+	{"allowed_vlans", "allowedVlans", types.StringType, "The VLANs allowed on the switch port. Only applicable to trunk ports."},
+	{"isolation_enabled", "isolationEnabled", types.BoolType, "The isolation status of the switch port."},
+	{"rstp_enabled", "rstpEnabled", types.BoolType, "The rapid spanning tree protocol status."},
+	{"stp_guard", "stpGuard", types.StringType, "The state of the STP guard ('disabled', 'root guard', 'bpdu guard' or 'loop guard')."},
+	{"link_negotiation", "linkNegotiation", types.StringType, "The link speed for the switch port."},
+	{"port_schedule_id", "portScheduleId", types.StringType, "The ID of the port schedule, such as the `port_schedule_id` of a `meraki_networks_switch_port_schedule`."},
+	{"udld", "udld", types.StringType, "The action to take when Unidirectional Link is detected ('Alert only' or 'Enforce')."},
+	{"access_policy_type", "accessPolicyType", types.StringType, "The type of the access policy of the switch port. Only applicable to access ports. Can be one of 'Open', 'Custom access policy', 'MAC allow list' or 'Sticky MAC allow list'."},
+	{"access_policy_number", "accessPolicyNumber", types.Int64Type, "The number of a custom access policy to configure on the switch port. Only applicable when `access_policy_type` is 'Custom access policy'."},
+	{"storm_control_enabled", "stormControlEnabled", types.BoolType, "The storm control status of the switch port."},
+	{"dai_trusted", "daiTrusted", types.BoolType, "If true, ARP packets for this port will be considered trusted, and Dynamic ARP Inspection will allow the traffic."},
+}
+
+// portSettingsAttrTypes returns the attribute types of the settings of a switch port.
+func portSettingsAttrTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(portSettings))
+	for _, setting := range portSettings {
+		attrTypes[setting.Name] = setting.Type
+	}
+	return attrTypes
+}
+
+// portConfigAttrTypes returns the attribute types of a config, which applies settings to a range of ports.
+func portConfigAttrTypes() map[string]attr.Type {
+	attrTypes := portSettingsAttrTypes()
+	attrTypes["ports"] = types.StringType
+	return attrTypes
+}
@@ -0,0 +1,330 @@
+package ports
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &DevicesSwitchPortsConfigResource{}
+	_ resource.ResourceWithConfigure      = &DevicesSwitchPortsConfigResource{}
+	_ resource.ResourceWithModifyPlan     = &DevicesSwitchPortsConfigResource{}
+	_ resource.ResourceWithValidateConfig = &DevicesSwitchPortsConfigResource{}
+)
+
+func NewResource() resource.Resource {
+	return &DevicesSwitchPortsConfigResource{}
+}
+
+// DevicesSwitchPortsConfigResource applies settings to ranges of ports on a switch, so that a switch is managed by
+// one resource rather than one meraki_devices_switch_port per port.
+type DevicesSwitchPortsConfigResource struct {
+	client  *openApiClient.APIClient
+	retry   utils.RetryPolicy
+	batches *utils.ActionBatcher      // Set when writes are submitted as action batches
+	ports   *utils.SwitchPortRegistry // Detects conflicting settings on link aggregation member ports
+}
+
+func (r *DevicesSwitchPortsConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices_switch_ports_config"
+}
+
+func (r *DevicesSwitchPortsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+	r.batches = providerData.ActionBatches
+	r.ports = providerData.SwitchPorts
+}
+
+// ValidateConfig checks the port ranges and that no port is configured more than once.
+func (r *DevicesSwitchPortsConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PortsConfigResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, _, diags := configuredPorts(data.Configs)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the settings of each configured port, so that the plan shows the changes to each port, and
+// reports settings that conflict with the other member ports of a link aggregation.
+func (r *DevicesSwitchPortsConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	state := &PortsConfigResourceModel{
+		Configs: types.ListNull(types.ObjectType{AttrTypes: portConfigAttrTypes()}),
+		Ports:   types.MapNull(types.ObjectType{AttrTypes: portSettingsAttrTypes()}),
+	}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+
+	plan := &PortsConfigResourceModel{
+		Configs: types.ListNull(types.ObjectType{AttrTypes: portConfigAttrTypes()}),
+	}
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured, _, known, diags := configuredPorts(plan.Configs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Forget the ports that are no longer configured, including every port when the resource is destroyed
+	if r.ports != nil && known {
+		previous, _, _, _ := configuredPorts(state.Configs)
+		for portId := range previous {
+			if _, ok := configured[portId]; !ok {
+				r.ports.RemovePort(utils.NewSwitchPortKey(state.Serial.ValueString(), portId))
+			}
+		}
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ports"), types.MapUnknown(types.ObjectType{AttrTypes: portSettingsAttrTypes()}))...)
+		return
+	}
+
+	// The ports of a different switch are not known until they are read
+	current := state.Ports
+	if !plan.Serial.Equal(state.Serial) {
+		current = types.MapNull(types.ObjectType{AttrTypes: portSettingsAttrTypes()})
+	}
+
+	planned, diags := plannedPorts(configured, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ports"), planned)...)
+
+	if r.ports == nil || plan.Serial.IsUnknown() {
+		return
+	}
+	for portId, settings := range configured {
+		port := utils.NewSwitchPortKey(plan.Serial.ValueString(), portId)
+		for _, conflict := range r.ports.PlanPort(port, registrySettings(settings)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configs"),
+				"Conflicting Link Aggregation Member Settings",
+				fmt.Sprintf("Member ports of a link aggregation must share their settings: %s.", conflict),
+			)
+		}
+	}
+}
+
+func (r *DevicesSwitchPortsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PortsConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, types.MapNull(types.ObjectType{AttrTypes: portSettingsAttrTypes()}))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created resource")
+}
+
+func (r *DevicesSwitchPortsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PortsConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, portIds, _, diags := configuredPorts(data.Configs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, httpResp, err := listSwitchPorts(ctx, r.client, r.retry, data.Serial.ValueString())
+
+	// The switch was removed outside of Terraform
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return
+	}
+
+	// Read the ports as they are, so that settings changed outside of Terraform show up in the plan
+	data.Ports, diags = readPorts(response, portIds, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *DevicesSwitchPortsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PortsConfigResourceModel
+	var state *PortsConfigResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, state.Ports)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+// Delete only removes the resource from the Terraform state. Switch ports cannot be deleted, and resetting every
+// configured port would take down whatever is connected to them.
+func (r *DevicesSwitchPortsConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
+
+// apply writes the configured settings of the ports whose planned settings differ from the current ones, then reads
+// back every port of the switch in a single request.
+func (r *DevicesSwitchPortsConfigResource) apply(ctx context.Context, data *PortsConfigResourceModel, current types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	serial := data.Serial.ValueString()
+
+	configured, portIds, _, configDiags := configuredPorts(data.Configs)
+	diags.Append(configDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var changed []string
+	for _, portId := range portIds {
+		planned, ok := data.Ports.Elements()[portId]
+		if existing, exists := current.Elements()[portId]; !ok || !exists || !planned.Equal(existing) {
+			changed = append(changed, portId)
+		}
+	}
+
+	payloads := make(map[string]openApiClient.UpdateDeviceSwitchPortRequest, len(changed))
+	for _, portId := range changed {
+		payload, payloadDiags := portSettingsPayload(ctx, configured[portId])
+		diags.Append(payloadDiags...)
+		payloads[portId] = payload
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating %d of %d configured switch ports", len(changed), len(portIds)), map[string]interface{}{
+		"serial": serial,
+	})
+
+	if r.batches != nil {
+		diags.Append(r.updateWithActionBatches(ctx, serial, changed, payloads)...)
+	} else {
+		for _, portId := range changed {
+			payload := payloads[portId]
+			_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetDeviceSwitchPorts200ResponseInner, *http.Response, error) {
+				return r.client.SwitchApi.UpdateDeviceSwitchPort(ctx, serial, portId).UpdateDeviceSwitchPortRequest(payload).Execute()
+			})
+			if err != nil {
+				diags.Append(utils.NewAPIErrorDiagnostic(fmt.Sprintf("Failed to update switch port %s", portId), httpResp, err))
+				return diags
+			}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	response, httpResp, err := listSwitchPorts(ctx, r.client, r.retry, serial)
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return diags
+	}
+
+	var readDiags diag.Diagnostics
+	data.Id = types.StringValue(serial)
+	data.Ports, readDiags = readPorts(response, portIds, configured)
+	diags.Append(readDiags...)
+	return diags
+}
+
+// updateWithActionBatches submits the port updates in as few action batches as the batch size limit allows.
+func (r *DevicesSwitchPortsConfigResource) updateWithActionBatches(ctx context.Context, serial string, portIds []string, payloads map[string]openApiClient.UpdateDeviceSwitchPortRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var actions []utils.BatchAction
+	for _, portId := range portIds {
+		action, err := utils.NewBatchAction(fmt.Sprintf("/devices/%s/switch/ports/%s", serial, portId), utils.BatchOperationUpdate, payloads[portId])
+		if err != nil {
+			diags.AddError("Resource Payload Error", err.Error())
+			return diags
+		}
+		actions = append(actions, action)
+	}
+
+	for start := 0; start < len(actions); start += utils.MaxActionBatchSize {
+		end := min(start+utils.MaxActionBatchSize, len(actions))
+		if _, err := r.batches.SubmitForDevice(ctx, serial, actions[start:end]...); err != nil {
+			diags.AddError("Action Batch Failure", fmt.Sprintf("Could not update switch ports: %s", err))
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,128 @@
+package ports
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *DevicesSwitchPortsConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	configAttributes := map[string]schema.Attribute{
+		"ports": schema.StringAttribute{
+			MarkdownDescription: "The ports to apply the settings to, as a comma separated list of ports and port ranges. Example: \"1-24,49\" or \"1_MA-MOD-8X10G_1-1_MA-MOD-8X10G_8\". A port can only be configured once.",
+			Required:            true,
+		},
+	}
+	portAttributes := map[string]schema.Attribute{}
+
+	for _, setting := range portSettings {
+		configAttributes[setting.Name] = configAttribute(setting)
+		portAttributes[setting.Name] = portAttribute(setting)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apply settings to ranges of ports on a switch. All ports of the switch are read in a single request, and the plan shows the changes to each port. " +
+			"Only the configured settings are managed. Destroying the resource leaves the ports unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The serial of the switch",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the switch",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					utils.SerialValidator(),
+				},
+			},
+			"configs": schema.ListNestedAttribute{
+				MarkdownDescription: "The settings to apply to each range of ports. Settings that are not configured are left unchanged.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: configAttributes,
+				},
+			},
+			"ports": schema.MapNestedAttribute{
+				MarkdownDescription: "The settings of each configured port, keyed by port ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: portAttributes,
+				},
+			},
+		},
+	}
+}
+
+// configAttribute returns the attribute that applies a setting to a range of ports.
+func configAttribute(setting portSetting) schema.Attribute {
+	switch setting.Type {
+	case types.StringType:
+		return schema.StringAttribute{
+			MarkdownDescription: setting.Description,
+			Optional:            true,
+			Validators:          settingValidators[setting.Name],
+		}
+	case types.Int64Type:
+		return schema.Int64Attribute{
+			MarkdownDescription: setting.Description,
+			Optional:            true,
+		}
+	case types.BoolType:
+		return schema.BoolAttribute{
+			MarkdownDescription: setting.Description,
+			Optional:            true,
+		}
+	default:
+		return schema.SetAttribute{
+			MarkdownDescription: setting.Description,
+			ElementType:         types.StringType,
+			Optional:            true,
+		}
+	}
+}
+
+// portAttribute returns the attribute that holds the setting of a configured port.
+func portAttribute(setting portSetting) schema.Attribute {
+	switch setting.Type {
+	case types.StringType:
+		return schema.StringAttribute{MarkdownDescription: setting.Description, Computed: true}
+	case types.Int64Type:
+		return schema.Int64Attribute{MarkdownDescription: setting.Description, Computed: true}
+	case types.BoolType:
+		return schema.BoolAttribute{MarkdownDescription: setting.Description, Computed: true}
+	default:
+		return schema.SetAttribute{MarkdownDescription: setting.Description, ElementType: types.StringType, Computed: true}
+	}
+}
+
+// settingValidators validates the string settings that only accept certain values.
+var settingValidators = map[string][]validator.String{
+	"type": {
+		stringvalidator.OneOf("access", "trunk"),
+	},
+	"stp_guard": {
+		stringvalidator.OneOf("disabled", "root guard", "bpdu guard", "loop guard"),
+	},
+	"udld": {
+		stringvalidator.OneOf("Alert only", "Enforce"),
+	},
+	"access_policy_type": {
+		stringvalidator.OneOf("Open", "Custom access policy", "MAC allow list", "Sticky MAC allow list"),
+	},
+}
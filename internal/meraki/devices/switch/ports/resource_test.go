@@ -0,0 +1,85 @@
+package ports_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccDevicesSwitchPortsConfigResource(t *testing.T) {
+	orgId := os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID")
+	serial := os.Getenv("TF_ACC_MERAKI_MS_SERIAL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+
+			// Overlapping port ranges are rejected
+			{
+				Config:      testAccDevicesSwitchPortsConfigResourceConfig(orgId, serial, "1-4", "4-5"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Overlapping Port Ranges"),
+			},
+
+			// Create and Read the port configs
+			{
+				Config: testAccDevicesSwitchPortsConfigResourceConfig(orgId, serial, "1-4", "5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "id", serial),
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.%", "5"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.1.type", "access"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.4.vlan", "10"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.5.type", "trunk"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.5.allowed_vlans", "all"),
+				),
+			},
+
+			// Update the port ranges
+			{
+				Config: testAccDevicesSwitchPortsConfigResourceConfig(orgId, serial, "1-2", "3-5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.%", "5"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.2.type", "access"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_ports_config.test", "ports.3.type", "trunk"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDevicesSwitchPortsConfigResourceConfig(orgId, serial, accessPorts, trunkPorts string) string {
+	return fmt.Sprintf(`
+%s
+%s
+resource "meraki_devices_switch_ports_config" "test" {
+    depends_on = [resource.meraki_networks_devices_claim.test]
+    serial = "%s"
+    configs = [
+        {
+            ports = "%s"
+            enabled = true
+            type = "access"
+            vlan = 10
+            voice_vlan = 20
+        },
+        {
+            ports = "%s"
+            type = "trunk"
+            vlan = 1
+            allowed_vlans = "all"
+        },
+    ]
+}
+`,
+		testAccDevicesSwitchPortsDataSourceConfigCreateNetwork(orgId),
+		testAccDevicesSwitchPortsDataSourceConfigClaimDevice(orgId, serial),
+		serial,
+		accessPorts,
+		trunkPorts,
+	)
+}
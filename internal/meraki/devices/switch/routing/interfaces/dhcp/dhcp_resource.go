@@ -0,0 +1,211 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// dhcpResourceModel is implemented by the resource models of the DHCP settings of switch and switch stack routing
+// interfaces.
+type dhcpResourceModel interface {
+	// dhcpSettings returns the DHCP attributes shared by switches and switch stacks.
+	dhcpSettings() *dhcpModel
+
+	// setId sets the ID of the resource.
+	setId(id string)
+}
+
+// dhcpRequest is the request type of the DHCP settings of switch routing interfaces. Switch stacks take the same
+// settings.
+type dhcpRequest = openApiClient.UpdateDeviceSwitchRoutingInterfaceDhcpRequest
+
+// dhcpApi makes the Dashboard API calls for the routing interface DHCP settings of either switches or switch stacks.
+type dhcpApi[M dhcpResourceModel] struct {
+	// typeName is the resource type name without the provider prefix.
+	typeName string
+
+	get    func(ctx context.Context, client *openApiClient.APIClient, data M) (interface{}, *http.Response, error)
+	update func(ctx context.Context, client *openApiClient.APIClient, data M, payload dhcpRequest) (interface{}, *http.Response, error)
+
+	// id builds the resource ID from the identifiers of the switch or switch stack and the interface.
+	id func(data M) string
+}
+
+// dhcpResource implements the routing interface DHCP resource operations shared by switches and switch stacks.
+type dhcpResource[M dhcpResourceModel] struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+	api    dhcpApi[M]
+}
+
+func (r *dhcpResource[M]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.api.typeName
+}
+
+func (r *dhcpResource[M]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+// ValidateConfig checks that only the settings of the configured DHCP mode are set.
+func (r *dhcpResource[M]) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data M
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDhcp(ctx, data.dhcpSettings())...)
+}
+
+// Create configures DHCP on the interface, which always exists as long as the interface does.
+func (r *dhcpResource[M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, data, "HTTP Client Create Failure")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created resource")
+}
+
+func (r *dhcpResource[M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data M
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (interface{}, *http.Response, error) {
+		return r.api.get(ctx, r.client, data)
+	})
+
+	// The interface was deleted outside of Terraform
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.readResponse(ctx, data, inlineResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *dhcpResource[M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, data, "HTTP Client Update Failure")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+// Delete disables DHCP on the interface.
+func (r *dhcpResource[M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := *openApiClient.NewUpdateDeviceSwitchRoutingInterfaceDhcpRequest()
+	payload.SetDhcpMode(dhcpModeDisabled)
+
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (interface{}, *http.Response, error) {
+		return r.api.update(ctx, r.client, data, payload)
+	})
+
+	// Deleting the interface also removes its DHCP settings
+	if err != nil && !(httpResp != nil && httpResp.StatusCode == http.StatusNotFound) {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Delete Failure", httpResp, err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
+
+// update sends the planned DHCP settings and sets data from the response.
+func (r *dhcpResource[M]) update(ctx context.Context, data M, summary string) diag.Diagnostics {
+	payload, diags := dhcpPayload(ctx, data.dhcpSettings())
+	if diags.HasError() {
+		return diags
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (interface{}, *http.Response, error) {
+		return r.api.update(ctx, r.client, data, payload)
+	})
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic(summary, httpResp, err))
+		return diags
+	}
+
+	diags.Append(r.readResponse(ctx, data, inlineResp)...)
+	return diags
+}
+
+// readResponse sets data from the DHCP settings returned by the Dashboard API.
+func (r *dhcpResource[M]) readResponse(ctx context.Context, data M, response interface{}) diag.Diagnostics {
+	diags := readDhcp(ctx, data.dhcpSettings(), response)
+	data.setId(r.api.id(data))
+	return diags
+}
@@ -0,0 +1,265 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/netip"
+)
+
+const (
+	dhcpModeDisabled = "dhcpDisabled"
+	dhcpModeRelay    = "dhcpRelay"
+	dhcpModeServer   = "dhcpServer"
+)
+
+// dhcpPayload returns the DHCP payload for the planned data. Only the settings of the planned DHCP mode are sent, and
+// lists that are not configured are sent empty so that the Dashboard API clears them.
+func dhcpPayload(ctx context.Context, data *dhcpModel) (openApiClient.UpdateDeviceSwitchRoutingInterfaceDhcpRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateDeviceSwitchRoutingInterfaceDhcpRequest()
+	payload.SetDhcpMode(data.DhcpMode.ValueString())
+
+	switch data.DhcpMode.ValueString() {
+	case dhcpModeRelay:
+		payload.DhcpRelayServerIps = []string{}
+		diags.Append(data.DhcpRelayServerIps.ElementsAs(ctx, &payload.DhcpRelayServerIps, false)...)
+
+	case dhcpModeServer:
+		if utils.IsKnown(data.DhcpLeaseTime) {
+			payload.SetDhcpLeaseTime(data.DhcpLeaseTime.ValueString())
+		}
+		if utils.IsKnown(data.DnsNameserversOption) {
+			payload.SetDnsNameserversOption(data.DnsNameserversOption.ValueString())
+		}
+		if !data.DnsCustomNameservers.IsNull() {
+			diags.Append(data.DnsCustomNameservers.ElementsAs(ctx, &payload.DnsCustomNameservers, false)...)
+		}
+		if !data.BootOptionsEnabled.IsNull() && !data.BootOptionsEnabled.IsUnknown() {
+			payload.SetBootOptionsEnabled(data.BootOptionsEnabled.ValueBool())
+		}
+		if utils.IsKnown(data.BootNextServer) {
+			payload.SetBootNextServer(data.BootNextServer.ValueString())
+		}
+		if utils.IsKnown(data.BootFileName) {
+			payload.SetBootFileName(data.BootFileName.ValueString())
+		}
+
+		var options []dhcpOptionModel
+		diags.Append(data.DhcpOptions.ElementsAs(ctx, &options, false)...)
+		payload.DhcpOptions = []openApiClient.UpdateDeviceSwitchRoutingInterfaceDhcpRequestDhcpOptionsInner{}
+		for _, option := range options {
+			payload.DhcpOptions = append(payload.DhcpOptions, *openApiClient.NewUpdateDeviceSwitchRoutingInterfaceDhcpRequestDhcpOptionsInner(
+				option.Code.ValueString(), option.Type.ValueString(), option.Value.ValueString()))
+		}
+
+		var ranges []reservedIpRangeModel
+		diags.Append(data.ReservedIpRanges.ElementsAs(ctx, &ranges, false)...)
+		payload.ReservedIpRanges = []openApiClient.UpdateDeviceSwitchRoutingInterfaceDhcpRequestReservedIpRangesInner{}
+		for _, ipRange := range ranges {
+			reserved := openApiClient.NewUpdateDeviceSwitchRoutingInterfaceDhcpRequestReservedIpRangesInner(ipRange.Start.ValueString(), ipRange.End.ValueString())
+			if utils.IsKnown(ipRange.Comment) {
+				reserved.SetComment(ipRange.Comment.ValueString())
+			}
+			payload.ReservedIpRanges = append(payload.ReservedIpRanges, *reserved)
+		}
+
+		var assignments []fixedIpAssignmentModel
+		diags.Append(data.FixedIpAssignments.ElementsAs(ctx, &assignments, false)...)
+		payload.FixedIpAssignments = []openApiClient.UpdateDeviceSwitchRoutingInterfaceDhcpRequestFixedIpAssignmentsInner{}
+		for _, assignment := range assignments {
+			payload.FixedIpAssignments = append(payload.FixedIpAssignments, *openApiClient.NewUpdateDeviceSwitchRoutingInterfaceDhcpRequestFixedIpAssignmentsInner(
+				assignment.Name.ValueString(), assignment.Mac.ValueString(), assignment.Ip.ValueString()))
+		}
+	}
+
+	return payload, diags
+}
+
+// readDhcp sets data from the DHCP configuration returned by the Dashboard API. Lists that the API returns empty are
+// set to null, since they are optional.
+func readDhcp(ctx context.Context, data *dhcpModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var dhcp apiDhcp
+	if err := utils.ConvertJSON(response, &dhcp); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the DHCP settings: %s", err))
+		return diags
+	}
+
+	data.DhcpMode = types.StringPointerValue(dhcp.DhcpMode)
+	data.DhcpLeaseTime = types.StringPointerValue(dhcp.DhcpLeaseTime)
+	data.DnsNameserversOption = types.StringPointerValue(dhcp.DnsNameserversOption)
+	data.BootOptionsEnabled = types.BoolPointerValue(dhcp.BootOptionsEnabled)
+	data.BootNextServer = nonEmptyString(dhcp.BootNextServer)
+	data.BootFileName = nonEmptyString(dhcp.BootFileName)
+
+	var listDiags diag.Diagnostics
+	data.DhcpRelayServerIps, listDiags = stringList(ctx, dhcp.DhcpRelayServerIps)
+	diags.Append(listDiags...)
+	data.DnsCustomNameservers, listDiags = stringList(ctx, dhcp.DnsCustomNameservers)
+	diags.Append(listDiags...)
+
+	var options []dhcpOptionModel
+	for _, option := range dhcp.DhcpOptions {
+		options = append(options, dhcpOptionModel{
+			Code:  types.StringValue(option.Code),
+			Type:  types.StringValue(option.Type),
+			Value: types.StringValue(option.Value),
+		})
+	}
+	data.DhcpOptions, listDiags = objectList(ctx, dhcpOptionAttrTypes(), options)
+	diags.Append(listDiags...)
+
+	var ranges []reservedIpRangeModel
+	for _, ipRange := range dhcp.ReservedIpRanges {
+		ranges = append(ranges, reservedIpRangeModel{
+			Start:   types.StringValue(ipRange.Start),
+			End:     types.StringValue(ipRange.End),
+			Comment: nonEmptyString(ipRange.Comment),
+		})
+	}
+	data.ReservedIpRanges, listDiags = objectList(ctx, reservedIpRangeAttrTypes(), ranges)
+	diags.Append(listDiags...)
+
+	// The API returns MAC addresses in lower case, so configured addresses that only differ in format are kept
+	var configured []fixedIpAssignmentModel
+	if !data.FixedIpAssignments.IsNull() && !data.FixedIpAssignments.IsUnknown() {
+		diags.Append(data.FixedIpAssignments.ElementsAs(ctx, &configured, false)...)
+	}
+	var assignments []fixedIpAssignmentModel
+	for i, assignment := range dhcp.FixedIpAssignments {
+		mac := types.StringValue(assignment.Mac)
+		if i < len(configured) && sameMAC(configured[i].Mac.ValueString(), assignment.Mac) {
+			mac = configured[i].Mac
+		}
+		assignments = append(assignments, fixedIpAssignmentModel{
+			Name: types.StringValue(assignment.Name),
+			Mac:  mac,
+			Ip:   types.StringValue(assignment.Ip),
+		})
+	}
+	data.FixedIpAssignments, listDiags = objectList(ctx, fixedIpAssignmentAttrTypes(), assignments)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// validateDhcp checks at plan time that only the settings of the configured DHCP mode are set and that reserved
+// ranges and fixed assignments are well-formed.
+func validateDhcp(ctx context.Context, data *dhcpModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.DhcpMode) {
+		return diags
+	}
+	mode := data.DhcpMode.ValueString()
+
+	if mode == dhcpModeRelay && data.DhcpRelayServerIps.IsNull() {
+		diags.AddAttributeError(path.Root("dhcp_relay_server_ips"), "Missing DHCP Relay Servers", "dhcp_relay_server_ips is required when dhcp_mode is 'dhcpRelay'")
+	}
+	if mode != dhcpModeRelay && !data.DhcpRelayServerIps.IsNull() {
+		diags.AddAttributeError(path.Root("dhcp_relay_server_ips"), "Unexpected DHCP Relay Servers", "dhcp_relay_server_ips can only be set when dhcp_mode is 'dhcpRelay'")
+	}
+
+	if mode != dhcpModeServer {
+		for name, value := range map[string]interface{ IsNull() bool }{
+			"dhcp_lease_time":        data.DhcpLeaseTime,
+			"dns_nameservers_option": data.DnsNameserversOption,
+			"dns_custom_nameservers": data.DnsCustomNameservers,
+			"boot_options_enabled":   data.BootOptionsEnabled,
+			"boot_next_server":       data.BootNextServer,
+			"boot_file_name":         data.BootFileName,
+			"dhcp_options":           data.DhcpOptions,
+			"reserved_ip_ranges":     data.ReservedIpRanges,
+			"fixed_ip_assignments":   data.FixedIpAssignments,
+		} {
+			if !value.IsNull() {
+				diags.AddAttributeError(path.Root(name), "Unexpected DHCP Server Setting", fmt.Sprintf("%s can only be set when dhcp_mode is 'dhcpServer'", name))
+			}
+		}
+		return diags
+	}
+
+	if utils.IsKnown(data.DnsNameserversOption) {
+		custom := data.DnsNameserversOption.ValueString() == "custom"
+		if custom && data.DnsCustomNameservers.IsNull() {
+			diags.AddAttributeError(path.Root("dns_custom_nameservers"), "Missing DNS Nameservers", "dns_custom_nameservers is required when dns_nameservers_option is 'custom'")
+		}
+		if !custom && !data.DnsCustomNameservers.IsNull() {
+			diags.AddAttributeError(path.Root("dns_custom_nameservers"), "Unexpected DNS Nameservers", "dns_custom_nameservers can only be set when dns_nameservers_option is 'custom'")
+		}
+	}
+
+	if !data.BootOptionsEnabled.IsUnknown() && !data.BootOptionsEnabled.ValueBool() {
+		for name, value := range map[string]types.String{"boot_next_server": data.BootNextServer, "boot_file_name": data.BootFileName} {
+			if !value.IsNull() {
+				diags.AddAttributeError(path.Root(name), "Unexpected Boot Option", fmt.Sprintf("%s can only be set when boot_options_enabled is true", name))
+			}
+		}
+	}
+
+	if !data.ReservedIpRanges.IsNull() && !data.ReservedIpRanges.IsUnknown() {
+		var ranges []reservedIpRangeModel
+		diags.Append(data.ReservedIpRanges.ElementsAs(ctx, &ranges, false)...)
+		for i, ipRange := range ranges {
+			if !utils.IsKnown(ipRange.Start) || !utils.IsKnown(ipRange.End) {
+				continue
+			}
+			start, startErr := netip.ParseAddr(ipRange.Start.ValueString())
+			end, endErr := netip.ParseAddr(ipRange.End.ValueString())
+			if startErr == nil && endErr == nil && end.Less(start) {
+				diags.AddAttributeError(path.Root("reserved_ip_ranges").AtListIndex(i).AtName("end"), "Invalid Reserved IP Range",
+					fmt.Sprintf("end %s is before start %s", end, start))
+			}
+		}
+	}
+
+	if !data.FixedIpAssignments.IsNull() && !data.FixedIpAssignments.IsUnknown() {
+		var assignments []fixedIpAssignmentModel
+		diags.Append(data.FixedIpAssignments.ElementsAs(ctx, &assignments, false)...)
+		for i, assignment := range assignments {
+			if !utils.IsKnown(assignment.Mac) {
+				continue
+			}
+			if _, err := utils.NormalizeMAC(assignment.Mac.ValueString()); err != nil {
+				diags.AddAttributeError(path.Root("fixed_ip_assignments").AtListIndex(i).AtName("mac"), "Invalid MAC Address", err.Error())
+			}
+		}
+	}
+
+	return diags
+}
+
+func nonEmptyString(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+func sameMAC(a, b string) bool {
+	normalizedA, errA := utils.NormalizeMAC(a)
+	normalizedB, errB := utils.NormalizeMAC(b)
+	return errA == nil && errB == nil && normalizedA == normalizedB
+}
+
+func stringList(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+func objectList[T any](ctx context.Context, attrTypes map[string]attr.Type, values []T) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: attrTypes}), nil
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: attrTypes}, values)
+}
@@ -0,0 +1,192 @@
+package dhcp
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testDhcpModel(mode string) dhcpModel {
+	return dhcpModel{
+		DhcpMode:             types.StringValue(mode),
+		DhcpRelayServerIps:   types.ListNull(types.StringType),
+		DhcpLeaseTime:        types.StringUnknown(),
+		DnsNameserversOption: types.StringUnknown(),
+		DnsCustomNameservers: types.ListNull(types.StringType),
+		BootOptionsEnabled:   types.BoolUnknown(),
+		BootNextServer:       types.StringNull(),
+		BootFileName:         types.StringNull(),
+		DhcpOptions:          types.ListNull(types.ObjectType{AttrTypes: dhcpOptionAttrTypes()}),
+		ReservedIpRanges:     types.ListNull(types.ObjectType{AttrTypes: reservedIpRangeAttrTypes()}),
+		FixedIpAssignments:   types.ListNull(types.ObjectType{AttrTypes: fixedIpAssignmentAttrTypes()}),
+	}
+}
+
+func testFixedIpAssignments(mac string) types.List {
+	return types.ListValueMust(types.ObjectType{AttrTypes: fixedIpAssignmentAttrTypes()}, []attr.Value{
+		types.ObjectValueMust(fixedIpAssignmentAttrTypes(), map[string]attr.Value{
+			"name": types.StringValue("printer"),
+			"mac":  types.StringValue(mac),
+			"ip":   types.StringValue("192.168.1.10"),
+		}),
+	})
+}
+
+func TestDhcpPayload(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: A DHCP server sends its settings and clears lists that are not configured
+	data := testDhcpModel(dhcpModeServer)
+	data.DhcpLeaseTime = types.StringValue("1 day")
+	data.FixedIpAssignments = testFixedIpAssignments("00-11-22-33-44-55")
+
+	payload, diags := dhcpPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, dhcpModeServer, payload.GetDhcpMode())
+	assert.Equal(t, "1 day", payload.GetDhcpLeaseTime())
+	assert.False(t, payload.HasDnsNameserversOption())
+	assert.False(t, payload.HasBootOptionsEnabled())
+	assert.Nil(t, payload.DhcpRelayServerIps)
+	require.Len(t, payload.FixedIpAssignments, 1)
+	assert.Equal(t, "00-11-22-33-44-55", payload.FixedIpAssignments[0].GetMac())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"dhcpOptions":[]`)
+	assert.Contains(t, string(body), `"reservedIpRanges":[]`)
+
+	// Test case: A DHCP relay only sends its relay servers
+	data = testDhcpModel(dhcpModeRelay)
+	data.DhcpRelayServerIps = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.1")})
+
+	payload, diags = dhcpPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, []string{"10.0.0.1"}, payload.DhcpRelayServerIps)
+	assert.False(t, payload.HasDhcpLeaseTime())
+	assert.Nil(t, payload.FixedIpAssignments)
+}
+
+func TestReadDhcp(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Empty lists and strings are read as null and configured MAC addresses keep their format
+	data := testDhcpModel(dhcpModeServer)
+	data.FixedIpAssignments = testFixedIpAssignments("00-11-22-33-44-55")
+	response := map[string]interface{}{
+		"dhcpMode":             "dhcpServer",
+		"dhcpLeaseTime":        "1 day",
+		"dnsNameserversOption": "googlePublicDns",
+		"dnsCustomNameservers": []interface{}{},
+		"bootOptionsEnabled":   false,
+		"bootNextServer":       "",
+		"dhcpOptions":          []interface{}{},
+		"reservedIpRanges": []interface{}{
+			map[string]interface{}{"start": "192.168.1.20", "end": "192.168.1.30"},
+		},
+		"fixedIpAssignments": []interface{}{
+			map[string]interface{}{"name": "printer", "mac": "00:11:22:33:44:55", "ip": "192.168.1.10"},
+		},
+	}
+	require.False(t, readDhcp(ctx, &data, response).HasError())
+
+	assert.Equal(t, "1 day", data.DhcpLeaseTime.ValueString())
+	assert.Equal(t, "googlePublicDns", data.DnsNameserversOption.ValueString())
+	assert.True(t, data.DnsCustomNameservers.IsNull())
+	assert.True(t, data.BootNextServer.IsNull())
+	assert.True(t, data.DhcpOptions.IsNull())
+	assert.True(t, data.DhcpRelayServerIps.IsNull())
+
+	var ranges []reservedIpRangeModel
+	require.False(t, data.ReservedIpRanges.ElementsAs(ctx, &ranges, false).HasError())
+	require.Len(t, ranges, 1)
+	assert.True(t, ranges[0].Comment.IsNull())
+
+	var assignments []fixedIpAssignmentModel
+	require.False(t, data.FixedIpAssignments.ElementsAs(ctx, &assignments, false).HasError())
+	require.Len(t, assignments, 1)
+	assert.Equal(t, "00-11-22-33-44-55", assignments[0].Mac.ValueString())
+}
+
+func TestValidateDhcp(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		modify func(data *dhcpModel)
+		err    string
+	}{
+		{
+			name:   "relay without servers",
+			modify: func(data *dhcpModel) { data.DhcpMode = types.StringValue(dhcpModeRelay) },
+			err:    "Missing DHCP Relay Servers",
+		},
+		{
+			name: "server setting when disabled",
+			modify: func(data *dhcpModel) {
+				data.DhcpMode = types.StringValue(dhcpModeDisabled)
+				data.DhcpLeaseTime = types.StringValue("1 day")
+			},
+			err: "Unexpected DHCP Server Setting",
+		},
+		{
+			name:   "custom nameservers without option",
+			modify: func(data *dhcpModel) { data.DnsNameserversOption = types.StringValue("custom") },
+			err:    "Missing DNS Nameservers",
+		},
+		{
+			name:   "boot file without boot options",
+			modify: func(data *dhcpModel) { data.BootFileName = types.StringValue("pxelinux.0") },
+			err:    "Unexpected Boot Option",
+		},
+		{
+			name: "reversed reserved range",
+			modify: func(data *dhcpModel) {
+				data.ReservedIpRanges = types.ListValueMust(types.ObjectType{AttrTypes: reservedIpRangeAttrTypes()}, []attr.Value{
+					types.ObjectValueMust(reservedIpRangeAttrTypes(), map[string]attr.Value{
+						"start":   types.StringValue("192.168.1.30"),
+						"end":     types.StringValue("192.168.1.20"),
+						"comment": types.StringNull(),
+					}),
+				})
+			},
+			err: "Invalid Reserved IP Range",
+		},
+		{
+			name:   "invalid MAC address",
+			modify: func(data *dhcpModel) { data.FixedIpAssignments = testFixedIpAssignments("00:11:22:33:44") },
+			err:    "Invalid MAC Address",
+		},
+		{
+			name: "valid server",
+			modify: func(data *dhcpModel) {
+				data.BootOptionsEnabled = types.BoolValue(true)
+				data.BootFileName = types.StringValue("pxelinux.0")
+				data.FixedIpAssignments = testFixedIpAssignments("00:11:22:33:44:55")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testDhcpModel(dhcpModeServer)
+			data.DhcpLeaseTime = types.StringNull()
+			data.DnsNameserversOption = types.StringNull()
+			data.BootOptionsEnabled = types.BoolNull()
+			tt.modify(&data)
+
+			diags := validateDhcp(ctx, &data)
+			if tt.err == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, tt.err, diags.Errors()[0].Summary())
+		})
+	}
+}
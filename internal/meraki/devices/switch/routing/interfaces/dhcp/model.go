@@ -0,0 +1,124 @@
+package dhcp
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dhcpModel describes the routing interface DHCP attributes shared by switches and switch stacks.
+type dhcpModel struct {
+	DhcpMode             types.String `tfsdk:"dhcp_mode"`
+	DhcpRelayServerIps   types.List   `tfsdk:"dhcp_relay_server_ips"`
+	DhcpLeaseTime        types.String `tfsdk:"dhcp_lease_time"`
+	DnsNameserversOption types.String `tfsdk:"dns_nameservers_option"`
+	DnsCustomNameservers types.List   `tfsdk:"dns_custom_nameservers"`
+	BootOptionsEnabled   types.Bool   `tfsdk:"boot_options_enabled"`
+	BootNextServer       types.String `tfsdk:"boot_next_server"`
+	BootFileName         types.String `tfsdk:"boot_file_name"`
+	DhcpOptions          types.List   `tfsdk:"dhcp_options"`
+	ReservedIpRanges     types.List   `tfsdk:"reserved_ip_ranges"`
+	FixedIpAssignments   types.List   `tfsdk:"fixed_ip_assignments"`
+}
+
+// resourceModel describes the data model of the DHCP settings of a switch routing interface.
+type resourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Serial      types.String `tfsdk:"serial"`
+	InterfaceId types.String `tfsdk:"interface_id"`
+	dhcpModel
+}
+
+// stackResourceModel describes the data model of the DHCP settings of a switch stack routing interface.
+type stackResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	NetworkId     types.String `tfsdk:"network_id"`
+	SwitchStackId types.String `tfsdk:"switch_stack_id"`
+	InterfaceId   types.String `tfsdk:"interface_id"`
+	dhcpModel
+}
+
+func (m *resourceModel) dhcpSettings() *dhcpModel {
+	return &m.dhcpModel
+}
+
+func (m *resourceModel) setId(id string) {
+	m.Id = types.StringValue(id)
+}
+
+func (m *stackResourceModel) dhcpSettings() *dhcpModel {
+	return &m.dhcpModel
+}
+
+func (m *stackResourceModel) setId(id string) {
+	m.Id = types.StringValue(id)
+}
+
+type dhcpOptionModel struct {
+	Code  types.String `tfsdk:"code"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+func dhcpOptionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"code":  types.StringType,
+		"type":  types.StringType,
+		"value": types.StringType,
+	}
+}
+
+type reservedIpRangeModel struct {
+	Start   types.String `tfsdk:"start"`
+	End     types.String `tfsdk:"end"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func reservedIpRangeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start":   types.StringType,
+		"end":     types.StringType,
+		"comment": types.StringType,
+	}
+}
+
+type fixedIpAssignmentModel struct {
+	Name types.String `tfsdk:"name"`
+	Mac  types.String `tfsdk:"mac"`
+	Ip   types.String `tfsdk:"ip"`
+}
+
+func fixedIpAssignmentAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+		"mac":  types.StringType,
+		"ip":   types.StringType,
+	}
+}
+
+// apiDhcp is the DHCP configuration of a routing interface in the format of the Dashboard API. The generated client
+// returns a map for switches and a struct for switch stacks, which are both converted to this type.
+type apiDhcp struct {
+	DhcpMode             *string  `json:"dhcpMode,omitempty"`
+	DhcpRelayServerIps   []string `json:"dhcpRelayServerIps,omitempty"`
+	DhcpLeaseTime        *string  `json:"dhcpLeaseTime,omitempty"`
+	DnsNameserversOption *string  `json:"dnsNameserversOption,omitempty"`
+	DnsCustomNameservers []string `json:"dnsCustomNameservers,omitempty"`
+	BootOptionsEnabled   *bool    `json:"bootOptionsEnabled,omitempty"`
+	BootNextServer       *string  `json:"bootNextServer,omitempty"`
+	BootFileName         *string  `json:"bootFileName,omitempty"`
+	DhcpOptions          []struct {
+		Code  string `json:"code"`
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"dhcpOptions,omitempty"`
+	ReservedIpRanges []struct {
+		Start   string  `json:"start"`
+		End     string  `json:"end"`
+		Comment *string `json:"comment,omitempty"`
+	} `json:"reservedIpRanges,omitempty"`
+	FixedIpAssignments []struct {
+		Name string `json:"name"`
+		Mac  string `json:"mac"`
+		Ip   string `json:"ip"`
+	} `json:"fixedIpAssignments,omitempty"`
+}
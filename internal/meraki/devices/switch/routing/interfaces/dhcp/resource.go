@@ -0,0 +1,43 @@
+package dhcp
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &Resource{}
+	_ resource.ResourceWithConfigure      = &Resource{}
+	_ resource.ResourceWithImportState    = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		dhcpResource: dhcpResource[*resourceModel]{
+			api: deviceApi,
+		},
+	}
+}
+
+// Resource defines the switch routing interface DHCP resource implementation.
+type Resource struct {
+	dhcpResource[*resourceModel]
+}
+
+// deviceApi makes the Dashboard API calls for the routing interface DHCP settings of a switch.
+var deviceApi = dhcpApi[*resourceModel]{
+	typeName: "_devices_switch_routing_interface_dhcp",
+	get: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel) (interface{}, *http.Response, error) {
+		return client.SwitchApi.GetDeviceSwitchRoutingInterfaceDhcp(ctx, data.Serial.ValueString(), data.InterfaceId.ValueString()).Execute()
+	},
+	update: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel, payload dhcpRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.UpdateDeviceSwitchRoutingInterfaceDhcp(ctx, data.Serial.ValueString(), data.InterfaceId.ValueString()).UpdateDeviceSwitchRoutingInterfaceDhcpRequest(payload).Execute()
+	},
+	id: func(data *resourceModel) string {
+		return data.Serial.ValueString() + "," + data.InterfaceId.ValueString()
+	},
+}
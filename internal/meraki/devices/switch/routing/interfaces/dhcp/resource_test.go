@@ -0,0 +1,106 @@
+package dhcp_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDevicesSwitchRoutingInterfaceDhcpResource(t *testing.T) {
+	serial := os.Getenv("TF_ACC_MERAKI_MS_SERIAL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+			if serial == "" {
+				t.Skip("TF_ACC_MERAKI_MS_SERIAL must be set to test switch routing interface DHCP")
+			}
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_devices_switch_routing_interface_dhcp"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_devices_switch_routing_interface_dhcp"),
+			},
+
+			// Create and Read DHCP Server
+			{
+				Config: DevicesSwitchRoutingInterfaceDhcpResourceConfig(serial, `
+    dhcp_mode = "dhcpServer"
+    dhcp_lease_time = "1 day"
+    dns_nameservers_option = "custom"
+    dns_custom_nameservers = ["8.8.8.8"]
+    reserved_ip_ranges = [{
+        start = "192.168.100.10"
+        end = "192.168.100.20"
+        comment = "printers"
+    }]
+    fixed_ip_assignments = [{
+        name = "printer"
+        mac = "00:11:22:33:44:55"
+        ip = "192.168.100.50"
+    }]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "dhcp_mode", "dhcpServer"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "dhcp_lease_time", "1 day"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "dns_custom_nameservers.#", "1"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "reserved_ip_ranges.#", "1"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "fixed_ip_assignments.0.mac", "00:11:22:33:44:55"),
+				),
+			},
+
+			// Update and Read DHCP Relay
+			{
+				Config: DevicesSwitchRoutingInterfaceDhcpResourceConfig(serial, `
+    dhcp_mode = "dhcpRelay"
+    dhcp_relay_server_ips = ["192.168.100.5"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "dhcp_mode", "dhcpRelay"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "dhcp_relay_server_ips.0", "192.168.100.5"),
+					resource.TestCheckNoResourceAttr("meraki_devices_switch_routing_interface_dhcp.test", "fixed_ip_assignments"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_devices_switch_routing_interface_dhcp.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func DevicesSwitchRoutingInterfaceDhcpResourceConfig(serial, settings string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_devices_claim" "test" {
+    network_id = resource.meraki_network.test.network_id
+    serials = ["%s"]
+}
+
+resource "meraki_devices_switch_routing_interface" "test" {
+    depends_on = [resource.meraki_networks_devices_claim.test]
+    serial = "%s"
+    name = "test_acc_dhcp"
+    vlan_id = 100
+    subnet = "192.168.100.0/24"
+    interface_ip = "192.168.100.2"
+    default_gateway = "192.168.100.1"
+}
+
+resource "meraki_devices_switch_routing_interface_dhcp" "test" {
+    serial = "%s"
+    interface_id = resource.meraki_devices_switch_routing_interface.test.interface_id
+%s
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_devices_switch_routing_interface_dhcp"),
+		serial, serial, serial, settings,
+	)
+}
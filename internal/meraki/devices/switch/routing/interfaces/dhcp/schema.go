@@ -0,0 +1,219 @@
+package dhcp
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := dhcpAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The serial and interface ID, separated by a comma",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["serial"] = schema.StringAttribute{
+		MarkdownDescription: "The serial of the switch",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			utils.SerialValidator(),
+		},
+	}
+	attributes["interface_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the routing interface",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the DHCP settings of a switch layer 3 routing interface. Deleting this resource disables DHCP on the interface.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *StackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := dhcpAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The network ID, switch stack ID and interface ID, separated by commas",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["network_id"] = schema.StringAttribute{
+		MarkdownDescription: "Network ID",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["switch_stack_id"] = schema.StringAttribute{
+		MarkdownDescription: "Switch stack ID",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["interface_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the routing interface",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the DHCP settings of a switch stack layer 3 routing interface. Deleting this resource disables DHCP on the interface.",
+		Attributes:          attributes,
+	}
+}
+
+// dhcpAttributes returns the routing interface DHCP attributes shared by switches and switch stacks.
+func dhcpAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dhcp_mode": schema.StringAttribute{
+			MarkdownDescription: "The DHCP mode options for the switch interface. Options are: 'dhcpDisabled', 'dhcpRelay' or 'dhcpServer'.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(dhcpModeDisabled, dhcpModeRelay, dhcpModeServer),
+			},
+		},
+		"dhcp_relay_server_ips": schema.ListAttribute{
+			MarkdownDescription: "The DHCP relay server IPs to which DHCP packets would get relayed. Required if `dhcp_mode` is 'dhcpRelay'.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(utils.IPv4AddressValidator()),
+			},
+		},
+		"dhcp_lease_time": schema.StringAttribute{
+			MarkdownDescription: "The DHCP lease time config for the DHCP server running on the switch interface. Options are: '30 minutes', '1 hour', '4 hours', '12 hours', '1 day' or '1 week'. Only used if `dhcp_mode` is 'dhcpServer'.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("30 minutes", "1 hour", "4 hours", "12 hours", "1 day", "1 week"),
+			},
+		},
+		"dns_nameservers_option": schema.StringAttribute{
+			MarkdownDescription: "The DHCP name server option for the DHCP server running on the switch interface. Options are: 'googlePublicDns', 'openDns' or 'custom'. Only used if `dhcp_mode` is 'dhcpServer'.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("googlePublicDns", "openDns", "custom"),
+			},
+		},
+		"dns_custom_nameservers": schema.ListAttribute{
+			MarkdownDescription: "The DHCP name server IPs. Required if `dns_nameservers_option` is 'custom'.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(utils.IPv4AddressValidator()),
+			},
+		},
+		"boot_options_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Enable DHCP boot options to provide PXE boot options configs for the DHCP server running on the switch interface. Only used if `dhcp_mode` is 'dhcpServer'.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"boot_next_server": schema.StringAttribute{
+			MarkdownDescription: "The PXE boot server IP for the DHCP server running on the switch interface. Requires `boot_options_enabled`.",
+			Optional:            true,
+			Validators: []validator.String{
+				utils.IPv4AddressValidator(),
+			},
+		},
+		"boot_file_name": schema.StringAttribute{
+			MarkdownDescription: "The PXE boot server filename for the DHCP server running on the switch interface. Requires `boot_options_enabled`.",
+			Optional:            true,
+		},
+		"dhcp_options": schema.ListNestedAttribute{
+			MarkdownDescription: "Array of DHCP options consisting of code, type and value for the DHCP server running on the switch interface",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"code": schema.StringAttribute{
+						MarkdownDescription: "The code for DHCP option which should be from 2 to 254",
+						Required:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the DHCP option which should be one of ('text', 'ip', 'integer' or 'hex')",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("text", "ip", "integer", "hex"),
+						},
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "The value of the DHCP option",
+						Required:            true,
+					},
+				},
+			},
+		},
+		"reserved_ip_ranges": schema.ListNestedAttribute{
+			MarkdownDescription: "Array of DHCP reserved IP assignments for the DHCP server running on the switch interface",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						MarkdownDescription: "The starting IP address of the reserved IP range",
+						Required:            true,
+						Validators: []validator.String{
+							utils.IPv4AddressValidator(),
+						},
+					},
+					"end": schema.StringAttribute{
+						MarkdownDescription: "The ending IP address of the reserved IP range",
+						Required:            true,
+						Validators: []validator.String{
+							utils.IPv4AddressValidator(),
+						},
+					},
+					"comment": schema.StringAttribute{
+						MarkdownDescription: "The comment for the reserved IP range",
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"fixed_ip_assignments": schema.ListNestedAttribute{
+			MarkdownDescription: "Array of DHCP fixed IP assignments for the DHCP server running on the switch interface",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the client which has fixed IP address",
+						Required:            true,
+					},
+					"mac": schema.StringAttribute{
+						MarkdownDescription: "The MAC address of the client which has fixed IP address",
+						Required:            true,
+					},
+					"ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address of the client which has fixed IP address assigned to it",
+						Required:            true,
+						Validators: []validator.String{
+							utils.IPv4AddressValidator(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package dhcp
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &StackResource{}
+	_ resource.ResourceWithConfigure      = &StackResource{}
+	_ resource.ResourceWithImportState    = &StackResource{}
+	_ resource.ResourceWithValidateConfig = &StackResource{}
+)
+
+func NewStackResource() resource.Resource {
+	return &StackResource{
+		dhcpResource: dhcpResource[*stackResourceModel]{
+			api: stackApi,
+		},
+	}
+}
+
+// StackResource defines the switch stack routing interface DHCP resource implementation.
+type StackResource struct {
+	dhcpResource[*stackResourceModel]
+}
+
+// stackApi makes the Dashboard API calls for the routing interface DHCP settings of a switch stack.
+var stackApi = dhcpApi[*stackResourceModel]{
+	typeName: "_networks_switch_stack_routing_interface_dhcp",
+	get: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel) (interface{}, *http.Response, error) {
+		return client.SwitchApi.GetNetworkSwitchStackRoutingInterfaceDhcp(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.InterfaceId.ValueString()).Execute()
+	},
+	update: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel, payload dhcpRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.UpdateNetworkSwitchStackRoutingInterfaceDhcp(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.InterfaceId.ValueString()).UpdateNetworkSwitchStackRoutingInterfaceDhcpRequest(openApiClient.UpdateNetworkSwitchStackRoutingInterfaceDhcpRequest(payload)).Execute()
+	},
+	id: func(data *stackResourceModel) string {
+		return strings.Join([]string{data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.InterfaceId.ValueString()}, ",")
+	},
+}
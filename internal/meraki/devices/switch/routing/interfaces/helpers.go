@@ -0,0 +1,182 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"net/netip"
+	"strings"
+)
+
+// interfacePayload returns the routing interface payload for the planned data.
+func interfacePayload(ctx context.Context, data *interfaceModel) (apiInterface, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := apiInterface{
+		Name:             data.Name.ValueStringPointer(),
+		Subnet:           data.Subnet.ValueStringPointer(),
+		InterfaceIp:      data.InterfaceIp.ValueStringPointer(),
+		VlanId:           data.VlanId.ValueInt64Pointer(),
+		MulticastRouting: knownString(data.MulticastRouting),
+		DefaultGateway:   knownString(data.DefaultGateway),
+	}
+
+	if !data.OspfSettings.IsNull() && !data.OspfSettings.IsUnknown() {
+		var ospf ospfSettingsModel
+		diags.Append(data.OspfSettings.As(ctx, &ospf, basetypes.ObjectAsOptions{})...)
+
+		payload.OspfSettings = &apiOspfSettings{
+			Area: knownString(ospf.Area),
+		}
+		if !ospf.Cost.IsNull() && !ospf.Cost.IsUnknown() {
+			payload.OspfSettings.Cost = ospf.Cost.ValueInt64Pointer()
+		}
+		if !ospf.IsPassiveEnabled.IsNull() && !ospf.IsPassiveEnabled.IsUnknown() {
+			payload.OspfSettings.IsPassiveEnabled = ospf.IsPassiveEnabled.ValueBoolPointer()
+		}
+	}
+
+	if !data.Ipv6.IsNull() && !data.Ipv6.IsUnknown() {
+		var ipv6 ipv6Model
+		diags.Append(data.Ipv6.As(ctx, &ipv6, basetypes.ObjectAsOptions{})...)
+
+		payload.Ipv6 = &apiIpv6{
+			AssignmentMode: knownString(ipv6.AssignmentMode),
+			Prefix:         knownString(ipv6.Prefix),
+			Address:        knownString(ipv6.Address),
+			Gateway:        knownString(ipv6.Gateway),
+		}
+	}
+
+	return payload, diags
+}
+
+// interfaceRequest converts the routing interface payload for the planned data into a request type of the API client.
+func interfaceRequest(ctx context.Context, data *interfaceModel, request interface{}) diag.Diagnostics {
+	payload, diags := interfacePayload(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := utils.ConvertJSON(payload, request); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not build the routing interface payload: %s", err))
+	}
+	return diags
+}
+
+// readInterface sets data from a routing interface returned by the Dashboard API.
+func readInterface(ctx context.Context, data *interfaceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var routingInterface apiInterface
+	if err := utils.ConvertJSON(response, &routingInterface); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the routing interface: %s", err))
+		return diags
+	}
+
+	data.InterfaceId = types.StringPointerValue(routingInterface.InterfaceId)
+	data.Name = types.StringPointerValue(routingInterface.Name)
+	data.VlanId = types.Int64PointerValue(routingInterface.VlanId)
+	data.Subnet = types.StringPointerValue(routingInterface.Subnet)
+	data.InterfaceIp = types.StringPointerValue(routingInterface.InterfaceIp)
+	data.MulticastRouting = types.StringPointerValue(routingInterface.MulticastRouting)
+	data.DefaultGateway = types.StringPointerValue(routingInterface.DefaultGateway)
+
+	data.OspfSettings = types.ObjectNull(ospfSettingsAttrTypes())
+	if ospf := routingInterface.OspfSettings; ospf != nil {
+		var objectDiags diag.Diagnostics
+		data.OspfSettings, objectDiags = types.ObjectValueFrom(ctx, ospfSettingsAttrTypes(), ospfSettingsModel{
+			Area:             types.StringPointerValue(ospf.Area),
+			Cost:             types.Int64PointerValue(ospf.Cost),
+			IsPassiveEnabled: types.BoolPointerValue(ospf.IsPassiveEnabled),
+		})
+		diags.Append(objectDiags...)
+	}
+
+	// Interfaces without IPv6 are returned with an empty ipv6 object
+	data.Ipv6 = types.ObjectNull(ipv6AttrTypes())
+	if ipv6 := routingInterface.Ipv6; ipv6 != nil && ipv6.Prefix != nil && *ipv6.Prefix != "" {
+		var objectDiags diag.Diagnostics
+		data.Ipv6, objectDiags = types.ObjectValueFrom(ctx, ipv6AttrTypes(), ipv6Model{
+			AssignmentMode: types.StringPointerValue(ipv6.AssignmentMode),
+			Prefix:         types.StringPointerValue(ipv6.Prefix),
+			Address:        types.StringPointerValue(ipv6.Address),
+			Gateway:        types.StringPointerValue(ipv6.Gateway),
+		})
+		diags.Append(objectDiags...)
+	}
+
+	return diags
+}
+
+// validateInterface checks at plan time that the interface IP is a usable address within the subnet and that the
+// IPv6 settings match the assignment mode.
+func validateInterface(ctx context.Context, data *interfaceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if utils.IsKnown(data.Subnet) && utils.IsKnown(data.InterfaceIp) {
+		if err := utils.ValidateInterfaceIP(data.Subnet.ValueString(), data.InterfaceIp.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("interface_ip"), "Invalid Interface IP", err.Error())
+		}
+	}
+
+	if data.Ipv6.IsNull() || data.Ipv6.IsUnknown() {
+		return diags
+	}
+
+	var ipv6 ipv6Model
+	diags.Append(data.Ipv6.As(ctx, &ipv6, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	addressPath := path.Root("ipv6").AtName("address")
+	switch ipv6.AssignmentMode.ValueString() {
+	case "static":
+		if ipv6.Address.IsNull() {
+			diags.AddAttributeError(addressPath, "Missing IPv6 Address", "address is required when assignment_mode is 'static'")
+		}
+	case "eui-64":
+		if !ipv6.Address.IsNull() {
+			diags.AddAttributeError(addressPath, "Unexpected IPv6 Address", "address cannot be set when assignment_mode is 'eui-64', since the switch derives it from its MAC address")
+		}
+	}
+
+	if utils.IsKnown(ipv6.Prefix) && utils.IsKnown(ipv6.Address) {
+		if err := validateIPv6Address(ipv6.Prefix.ValueString(), ipv6.Address.ValueString()); err != nil {
+			diags.AddAttributeError(addressPath, "Invalid IPv6 Address", err.Error())
+		}
+	}
+
+	return diags
+}
+
+// validateIPv6Address checks that address is an IPv6 address within prefix.
+func validateIPv6Address(prefix, address string) error {
+	parsedPrefix, err := netip.ParsePrefix(strings.TrimSpace(prefix))
+	if err != nil || !parsedPrefix.Addr().Is6() {
+		return fmt.Errorf("%q is not an IPv6 prefix", prefix)
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(address))
+	if err != nil || !addr.Is6() {
+		return fmt.Errorf("%q is not an IPv6 address", address)
+	}
+
+	if !parsedPrefix.Masked().Contains(addr) {
+		return fmt.Errorf("IPv6 address %s is not within prefix %s", addr, parsedPrefix.Masked())
+	}
+	return nil
+}
+
+// knownString returns nil for null and unknown values, so that they are left out of payloads.
+func knownString(value types.String) *string {
+	if !utils.IsKnown(value) {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
@@ -0,0 +1,147 @@
+package interfaces
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testInterfaceModel(ipv6 types.Object) interfaceModel {
+	return interfaceModel{
+		InterfaceId:      types.StringUnknown(),
+		Name:             types.StringValue("L3 interface"),
+		VlanId:           types.Int64Value(100),
+		Subnet:           types.StringValue("192.168.1.0/24"),
+		InterfaceIp:      types.StringValue("192.168.1.2"),
+		MulticastRouting: types.StringUnknown(),
+		DefaultGateway:   types.StringValue("192.168.1.1"),
+		OspfSettings: types.ObjectValueMust(ospfSettingsAttrTypes(), map[string]attr.Value{
+			"area":               types.StringValue("0"),
+			"cost":               types.Int64Unknown(),
+			"is_passive_enabled": types.BoolValue(true),
+		}),
+		Ipv6: ipv6,
+	}
+}
+
+func testIpv6(mode string, address types.String) types.Object {
+	return types.ObjectValueMust(ipv6AttrTypes(), map[string]attr.Value{
+		"assignment_mode": types.StringValue(mode),
+		"prefix":          types.StringValue("2001:db8::/64"),
+		"address":         address,
+		"gateway":         types.StringUnknown(),
+	})
+}
+
+func TestInterfaceRequest(t *testing.T) {
+	ctx := context.Background()
+	data := testInterfaceModel(testIpv6("static", types.StringValue("2001:db8::1")))
+
+	// Test case: The payload converts to the switch stack request, leaving out unknown values
+	var payload openApiClient.CreateNetworkSwitchStackRoutingInterfaceRequest
+	require.False(t, interfaceRequest(ctx, &data, &payload).HasError())
+
+	assert.Equal(t, "L3 interface", payload.GetName())
+	assert.Equal(t, int32(100), payload.GetVlanId())
+	assert.Equal(t, "192.168.1.2", payload.GetInterfaceIp())
+	assert.False(t, payload.HasMulticastRouting())
+	assert.Equal(t, "0", payload.OspfSettings.GetArea())
+	assert.False(t, payload.OspfSettings.HasCost())
+	assert.True(t, payload.OspfSettings.GetIsPassiveEnabled())
+	assert.Equal(t, "2001:db8::1", payload.Ipv6.GetAddress())
+	assert.False(t, payload.Ipv6.HasGateway())
+}
+
+func TestReadInterface(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: A switch stack response, which the API client returns as a map, sets every attribute
+	data := testInterfaceModel(types.ObjectNull(ipv6AttrTypes()))
+	response := map[string]interface{}{
+		"interfaceId":      "1234",
+		"name":             "L3 interface",
+		"subnet":           "192.168.1.0/24",
+		"interfaceIp":      "192.168.1.2",
+		"multicastRouting": "disabled",
+		"vlanId":           float64(100),
+		"defaultGateway":   "192.168.1.1",
+		"ospfSettings":     map[string]interface{}{"area": "0", "cost": float64(1), "isPassiveEnabled": true},
+		"ipv6":             map[string]interface{}{},
+	}
+	require.False(t, readInterface(ctx, &data, response).HasError())
+
+	assert.Equal(t, "1234", data.InterfaceId.ValueString())
+	assert.Equal(t, int64(100), data.VlanId.ValueInt64())
+	assert.Equal(t, "disabled", data.MulticastRouting.ValueString())
+
+	var ospf ospfSettingsModel
+	require.False(t, data.OspfSettings.As(ctx, &ospf, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, int64(1), ospf.Cost.ValueInt64())
+
+	// An empty ipv6 object means IPv6 is not configured
+	assert.True(t, data.Ipv6.IsNull())
+}
+
+func TestValidateInterface(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		data interfaceModel
+		err  string
+	}{
+		{
+			name: "valid",
+			data: testInterfaceModel(testIpv6("static", types.StringValue("2001:db8::1"))),
+		},
+		{
+			name: "interface IP outside of subnet",
+			data: func() interfaceModel {
+				data := testInterfaceModel(types.ObjectNull(ipv6AttrTypes()))
+				data.InterfaceIp = types.StringValue("192.168.2.2")
+				return data
+			}(),
+			err: "Invalid Interface IP",
+		},
+		{
+			name: "unknown subnet",
+			data: func() interfaceModel {
+				data := testInterfaceModel(types.ObjectNull(ipv6AttrTypes()))
+				data.Subnet = types.StringUnknown()
+				return data
+			}(),
+		},
+		{
+			name: "static without address",
+			data: testInterfaceModel(testIpv6("static", types.StringNull())),
+			err:  "Missing IPv6 Address",
+		},
+		{
+			name: "eui-64 with address",
+			data: testInterfaceModel(testIpv6("eui-64", types.StringValue("2001:db8::1"))),
+			err:  "Unexpected IPv6 Address",
+		},
+		{
+			name: "address outside of prefix",
+			data: testInterfaceModel(testIpv6("static", types.StringValue("2001:db9::1"))),
+			err:  "Invalid IPv6 Address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateInterface(ctx, &tt.data)
+			if tt.err == "" {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, tt.err, diags.Errors()[0].Summary())
+		})
+	}
+}
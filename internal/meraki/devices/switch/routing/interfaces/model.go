@@ -0,0 +1,107 @@
+package interfaces
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// interfaceModel describes the routing interface attributes shared by switches and switch stacks.
+type interfaceModel struct {
+	InterfaceId      types.String `tfsdk:"interface_id"`
+	Name             types.String `tfsdk:"name"`
+	VlanId           types.Int64  `tfsdk:"vlan_id"`
+	Subnet           types.String `tfsdk:"subnet"`
+	InterfaceIp      types.String `tfsdk:"interface_ip"`
+	MulticastRouting types.String `tfsdk:"multicast_routing"`
+	DefaultGateway   types.String `tfsdk:"default_gateway"`
+	OspfSettings     types.Object `tfsdk:"ospf_settings"`
+	Ipv6             types.Object `tfsdk:"ipv6"`
+}
+
+// resourceModel describes the data model of a switch routing interface.
+type resourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Serial types.String `tfsdk:"serial"`
+	interfaceModel
+}
+
+// stackResourceModel describes the data model of a switch stack routing interface.
+type stackResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	NetworkId     types.String `tfsdk:"network_id"`
+	SwitchStackId types.String `tfsdk:"switch_stack_id"`
+	interfaceModel
+}
+
+func (m *resourceModel) routingInterface() *interfaceModel {
+	return &m.interfaceModel
+}
+
+func (m *resourceModel) setId(id string) {
+	m.Id = types.StringValue(id)
+}
+
+func (m *stackResourceModel) routingInterface() *interfaceModel {
+	return &m.interfaceModel
+}
+
+func (m *stackResourceModel) setId(id string) {
+	m.Id = types.StringValue(id)
+}
+
+type ospfSettingsModel struct {
+	Area             types.String `tfsdk:"area"`
+	Cost             types.Int64  `tfsdk:"cost"`
+	IsPassiveEnabled types.Bool   `tfsdk:"is_passive_enabled"`
+}
+
+func ospfSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"area":               types.StringType,
+		"cost":               types.Int64Type,
+		"is_passive_enabled": types.BoolType,
+	}
+}
+
+type ipv6Model struct {
+	AssignmentMode types.String `tfsdk:"assignment_mode"`
+	Prefix         types.String `tfsdk:"prefix"`
+	Address        types.String `tfsdk:"address"`
+	Gateway        types.String `tfsdk:"gateway"`
+}
+
+func ipv6AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"assignment_mode": types.StringType,
+		"prefix":          types.StringType,
+		"address":         types.StringType,
+		"gateway":         types.StringType,
+	}
+}
+
+// apiInterface is a routing interface in the format of the Dashboard API. The generated client has different
+// request and response types for switches and switch stacks, which are converted to and from this type.
+type apiInterface struct {
+	InterfaceId      *string          `json:"interfaceId,omitempty"`
+	Name             *string          `json:"name,omitempty"`
+	Subnet           *string          `json:"subnet,omitempty"`
+	InterfaceIp      *string          `json:"interfaceIp,omitempty"`
+	MulticastRouting *string          `json:"multicastRouting,omitempty"`
+	VlanId           *int64           `json:"vlanId,omitempty"`
+	DefaultGateway   *string          `json:"defaultGateway,omitempty"`
+	OspfSettings     *apiOspfSettings `json:"ospfSettings,omitempty"`
+	Ipv6             *apiIpv6         `json:"ipv6,omitempty"`
+}
+
+type apiOspfSettings struct {
+	Area             *string `json:"area,omitempty"`
+	Cost             *int64  `json:"cost,omitempty"`
+	IsPassiveEnabled *bool   `json:"isPassiveEnabled,omitempty"`
+}
+
+type apiIpv6 struct {
+	AssignmentMode *string `json:"assignmentMode,omitempty"`
+	Prefix         *string `json:"prefix,omitempty"`
+	Address        *string `json:"address,omitempty"`
+	Gateway        *string `json:"gateway,omitempty"`
+}
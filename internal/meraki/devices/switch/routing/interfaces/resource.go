@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &Resource{}
+	_ resource.ResourceWithConfigure      = &Resource{}
+	_ resource.ResourceWithImportState    = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		routingResource: routingResource[*resourceModel, deviceRequest, deviceRequest]{
+			api: deviceApi,
+		},
+	}
+}
+
+// Resource defines the switch routing interface resource implementation.
+type Resource struct {
+	routingResource[*resourceModel, deviceRequest, deviceRequest]
+}
+
+// deviceRequest is the request type of both the create and the update endpoint of switch routing interfaces.
+type deviceRequest = openApiClient.CreateDeviceSwitchRoutingInterfaceRequest
+
+// deviceApi makes the Dashboard API calls for the routing interfaces of a switch.
+var deviceApi = routingApi[*resourceModel, deviceRequest, deviceRequest]{
+	typeName: "_devices_switch_routing_interface",
+	create: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel, payload deviceRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.CreateDeviceSwitchRoutingInterface(ctx, data.Serial.ValueString()).CreateDeviceSwitchRoutingInterfaceRequest(payload).Execute()
+	},
+	get: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel) (interface{}, *http.Response, error) {
+		return client.SwitchApi.GetDeviceSwitchRoutingInterface(ctx, data.Serial.ValueString(), data.InterfaceId.ValueString()).Execute()
+	},
+	update: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel, payload deviceRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.UpdateDeviceSwitchRoutingInterface(ctx, data.Serial.ValueString(), data.InterfaceId.ValueString()).CreateDeviceSwitchRoutingInterfaceRequest(payload).Execute()
+	},
+	delete: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel) (*http.Response, error) {
+		return client.SwitchApi.DeleteDeviceSwitchRoutingInterface(ctx, data.Serial.ValueString(), data.InterfaceId.ValueString()).Execute()
+	},
+	id: func(data *resourceModel) string {
+		return data.Serial.ValueString() + "," + data.InterfaceId.ValueString()
+	},
+}
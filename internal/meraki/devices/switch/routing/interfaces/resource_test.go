@@ -0,0 +1,86 @@
+package interfaces_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDevicesSwitchRoutingInterfaceResource(t *testing.T) {
+	serial := os.Getenv("TF_ACC_MERAKI_MS_SERIAL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+			if serial == "" {
+				t.Skip("TF_ACC_MERAKI_MS_SERIAL must be set to test switch routing interfaces")
+			}
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_devices_switch_routing_interface"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_devices_switch_routing_interface"),
+			},
+
+			// Create and Read Routing Interface
+			{
+				Config: DevicesSwitchRoutingInterfaceResourceConfig(serial, "test_acc_interface", "192.168.100.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface.test", "name", "test_acc_interface"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface.test", "vlan_id", "100"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface.test", "interface_ip", "192.168.100.2"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface.test", "ospf_settings.area", "disabled"),
+					resource.TestCheckResourceAttrSet("meraki_devices_switch_routing_interface.test", "interface_id"),
+				),
+			},
+
+			// Update and Read Routing Interface
+			{
+				Config: DevicesSwitchRoutingInterfaceResourceConfig(serial, "test_acc_interface_updated", "192.168.100.3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface.test", "name", "test_acc_interface_updated"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_interface.test", "interface_ip", "192.168.100.3"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_devices_switch_routing_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func DevicesSwitchRoutingInterfaceResourceConfig(serial, name, interfaceIp string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_devices_claim" "test" {
+    network_id = resource.meraki_network.test.network_id
+    serials = ["%s"]
+}
+
+resource "meraki_devices_switch_routing_interface" "test" {
+    depends_on = [resource.meraki_networks_devices_claim.test]
+    serial = "%s"
+    name = "%s"
+    vlan_id = 100
+    subnet = "192.168.100.0/24"
+    interface_ip = "%s"
+    default_gateway = "192.168.100.1"
+    ospf_settings = {
+        area = "disabled"
+    }
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_devices_switch_routing_interface"),
+		serial, serial, name, interfaceIp,
+	)
+}
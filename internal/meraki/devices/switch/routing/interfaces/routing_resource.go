@@ -0,0 +1,212 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// routingModel is implemented by the resource models of switch and switch stack routing interfaces.
+type routingModel interface {
+	// routingInterface returns the routing interface attributes shared by switches and switch stacks.
+	routingInterface() *interfaceModel
+
+	// setId sets the ID of the resource.
+	setId(id string)
+}
+
+// routingApi makes the Dashboard API calls for the routing interfaces of either switches or switch stacks. The
+// generated client has different request types for each, C for create and U for update calls.
+type routingApi[M routingModel, C, U any] struct {
+	// typeName is the resource type name without the provider prefix.
+	typeName string
+
+	create func(ctx context.Context, client *openApiClient.APIClient, data M, payload C) (interface{}, *http.Response, error)
+	get    func(ctx context.Context, client *openApiClient.APIClient, data M) (interface{}, *http.Response, error)
+	update func(ctx context.Context, client *openApiClient.APIClient, data M, payload U) (interface{}, *http.Response, error)
+	delete func(ctx context.Context, client *openApiClient.APIClient, data M) (*http.Response, error)
+
+	// id builds the resource ID from the identifiers of the switch or switch stack and the interface.
+	id func(data M) string
+}
+
+// routingResource implements the routing interface resource operations shared by switches and switch stacks.
+type routingResource[M routingModel, C, U any] struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+	api    routingApi[M, C, U]
+}
+
+func (r *routingResource[M, C, U]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.api.typeName
+}
+
+func (r *routingResource[M, C, U]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+// ValidateConfig checks that the interface IP is a usable address within the subnet.
+func (r *routingResource[M, C, U]) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data M
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateInterface(ctx, data.routingInterface())...)
+}
+
+func (r *routingResource[M, C, U]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var payload C
+	resp.Diagnostics.Append(interfaceRequest(ctx, data.routingInterface(), &payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (interface{}, *http.Response, error) {
+		return r.api.create(ctx, r.client, data, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Create Failure", httpResp, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.readResponse(ctx, data, inlineResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created resource")
+}
+
+func (r *routingResource[M, C, U]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data M
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (interface{}, *http.Response, error) {
+		return r.api.get(ctx, r.client, data)
+	})
+
+	// The interface was deleted outside of Terraform
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.readResponse(ctx, data, inlineResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *routingResource[M, C, U]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data M
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var payload U
+	resp.Diagnostics.Append(interfaceRequest(ctx, data.routingInterface(), &payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (interface{}, *http.Response, error) {
+		return r.api.update(ctx, r.client, data, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Update Failure", httpResp, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.readResponse(ctx, data, inlineResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+func (r *routingResource[M, C, U]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (interface{}, *http.Response, error) {
+		httpResp, err := r.api.delete(ctx, r.client, data)
+		return nil, httpResp, err
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Delete Failure", httpResp, err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
+
+// readResponse sets data from the routing interface returned by the Dashboard API.
+func (r *routingResource[M, C, U]) readResponse(ctx context.Context, data M, response interface{}) diag.Diagnostics {
+	diags := readInterface(ctx, data.routingInterface(), response)
+	data.setId(r.api.id(data))
+	return diags
+}
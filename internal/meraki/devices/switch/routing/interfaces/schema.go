@@ -0,0 +1,207 @@
+package interfaces
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := interfaceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The serial and interface ID, separated by a comma",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["serial"] = schema.StringAttribute{
+		MarkdownDescription: "The serial of the switch",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			utils.SerialValidator(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a layer 3 routing interface of a switch",
+		Attributes:          attributes,
+	}
+}
+
+func (r *StackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := interfaceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The network ID, switch stack ID and interface ID, separated by commas",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["network_id"] = schema.StringAttribute{
+		MarkdownDescription: "Network ID",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["switch_stack_id"] = schema.StringAttribute{
+		MarkdownDescription: "Switch stack ID",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a layer 3 routing interface of a switch stack",
+		Attributes:          attributes,
+	}
+}
+
+// interfaceAttributes returns the routing interface attributes shared by switches and switch stacks.
+func interfaceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"interface_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the routing interface",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "A friendly name or description for the interface or VLAN",
+			Required:            true,
+		},
+		"vlan_id": schema.Int64Attribute{
+			MarkdownDescription: "The VLAN this routed interface is on. VLAN must be between 1 and 4094.",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+		},
+		"subnet": schema.StringAttribute{
+			MarkdownDescription: "The network that this routed interface is on, in CIDR notation (ex. 10.1.1.0/24)",
+			Required:            true,
+			Validators: []validator.String{
+				utils.IPv4SubnetValidator(),
+			},
+		},
+		"interface_ip": schema.StringAttribute{
+			MarkdownDescription: "The IP address this switch will use for layer 3 routing on this VLAN or subnet. It must be a usable address within `subnet` and cannot be the same as the switch's management IP.",
+			Required:            true,
+			Validators: []validator.String{
+				utils.IPv4AddressValidator(),
+			},
+		},
+		"multicast_routing": schema.StringAttribute{
+			MarkdownDescription: "Enable multicast support if multicast routing between VLANs is required. Options are: 'disabled', 'enabled' or 'IGMP snooping querier'.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf("disabled", "enabled", "IGMP snooping querier"),
+			},
+		},
+		"default_gateway": schema.StringAttribute{
+			MarkdownDescription: "The next hop for any traffic that isn't going to a directly connected subnet or over a static route. This IP address must exist in a subnet with a routed interface. Required if this is the first IPv4 interface.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				utils.IPv4AddressValidator(),
+			},
+		},
+		"ospf_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "The OSPF routing settings of the interface",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.UseStateForUnknown(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"area": schema.StringAttribute{
+					MarkdownDescription: "The OSPF area to which this interface should belong. Can be either 'disabled' or the identifier of an existing OSPF area.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"cost": schema.Int64Attribute{
+					MarkdownDescription: "The path cost for this interface. Can be increased up to 65535 to give lower priority.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"is_passive_enabled": schema.BoolAttribute{
+					MarkdownDescription: "When enabled, OSPF will not run on the interface, but the subnet will still be advertised",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+		"ipv6": schema.SingleNestedAttribute{
+			MarkdownDescription: "The IPv6 settings of the interface. The Dashboard API cannot remove IPv6 from an interface, so removing this replaces the interface.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplaceIf(ipv6Removed, "Removing ipv6 replaces the interface", "Removing `ipv6` replaces the interface"),
+			},
+			Attributes: map[string]schema.Attribute{
+				"assignment_mode": schema.StringAttribute{
+					MarkdownDescription: "The IPv6 assignment mode for the interface. Can be either 'eui-64' or 'static'.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("eui-64", "static"),
+					},
+				},
+				"prefix": schema.StringAttribute{
+					MarkdownDescription: "The IPv6 prefix of the interface",
+					Required:            true,
+				},
+				"address": schema.StringAttribute{
+					MarkdownDescription: "The IPv6 address of the interface. Required if `assignment_mode` is 'static' and must not be set if it is 'eui-64', in which case the switch derives it from its MAC address.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"gateway": schema.StringAttribute{
+					MarkdownDescription: "The IPv6 default gateway of the interface. Required if this is the first interface with IPv6 configured for the switch.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// ipv6Removed requires replacement when IPv6 is removed from an interface that has it.
+func ipv6Removed(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+}
@@ -0,0 +1,55 @@
+package interfaces
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &StackResource{}
+	_ resource.ResourceWithConfigure      = &StackResource{}
+	_ resource.ResourceWithImportState    = &StackResource{}
+	_ resource.ResourceWithValidateConfig = &StackResource{}
+)
+
+func NewStackResource() resource.Resource {
+	return &StackResource{
+		routingResource: routingResource[*stackResourceModel, stackCreateRequest, stackUpdateRequest]{
+			api: stackApi,
+		},
+	}
+}
+
+// StackResource defines the switch stack routing interface resource implementation.
+type StackResource struct {
+	routingResource[*stackResourceModel, stackCreateRequest, stackUpdateRequest]
+}
+
+type (
+	stackCreateRequest = openApiClient.CreateNetworkSwitchStackRoutingInterfaceRequest
+	stackUpdateRequest = openApiClient.UpdateNetworkSwitchStackRoutingInterfaceRequest
+)
+
+// stackApi makes the Dashboard API calls for the routing interfaces of a switch stack.
+var stackApi = routingApi[*stackResourceModel, stackCreateRequest, stackUpdateRequest]{
+	typeName: "_networks_switch_stack_routing_interface",
+	create: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel, payload stackCreateRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.CreateNetworkSwitchStackRoutingInterface(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString()).CreateNetworkSwitchStackRoutingInterfaceRequest(payload).Execute()
+	},
+	get: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel) (interface{}, *http.Response, error) {
+		return client.SwitchApi.GetNetworkSwitchStackRoutingInterface(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.InterfaceId.ValueString()).Execute()
+	},
+	update: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel, payload stackUpdateRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.UpdateNetworkSwitchStackRoutingInterface(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.InterfaceId.ValueString()).UpdateNetworkSwitchStackRoutingInterfaceRequest(payload).Execute()
+	},
+	delete: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel) (*http.Response, error) {
+		return client.SwitchApi.DeleteNetworkSwitchStackRoutingInterface(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.InterfaceId.ValueString()).Execute()
+	},
+	id: func(data *stackResourceModel) string {
+		return strings.Join([]string{data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.InterfaceId.ValueString()}, ",")
+	},
+}
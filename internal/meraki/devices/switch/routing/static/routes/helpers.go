@@ -0,0 +1,93 @@
+package routes
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/netip"
+)
+
+// createPayload returns the create request for the planned static route.
+func createPayload(data *routeModel) openApiClient.CreateDeviceSwitchRoutingStaticRouteRequest {
+	payload := *openApiClient.NewCreateDeviceSwitchRoutingStaticRouteRequest(data.Subnet.ValueString(), data.NextHopIp.ValueString())
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		payload.SetName(data.Name.ValueString())
+	}
+	if !data.AdvertiseViaOspfEnabled.IsNull() && !data.AdvertiseViaOspfEnabled.IsUnknown() {
+		payload.SetAdvertiseViaOspfEnabled(data.AdvertiseViaOspfEnabled.ValueBool())
+	}
+	if !data.PreferOverOspfRoutesEnabled.IsNull() && !data.PreferOverOspfRoutesEnabled.IsUnknown() {
+		payload.SetPreferOverOspfRoutesEnabled(data.PreferOverOspfRoutesEnabled.ValueBool())
+	}
+	return payload
+}
+
+// updatePayload returns the update request for the planned static route. A removed name is sent empty so that the
+// Dashboard API clears it.
+func updatePayload(data *routeModel) openApiClient.UpdateDeviceSwitchRoutingStaticRouteRequest {
+	payload := *openApiClient.NewUpdateDeviceSwitchRoutingStaticRouteRequest()
+	payload.SetName(data.Name.ValueString())
+	payload.SetSubnet(data.Subnet.ValueString())
+	payload.SetNextHopIp(data.NextHopIp.ValueString())
+	if !data.AdvertiseViaOspfEnabled.IsNull() && !data.AdvertiseViaOspfEnabled.IsUnknown() {
+		payload.SetAdvertiseViaOspfEnabled(data.AdvertiseViaOspfEnabled.ValueBool())
+	}
+	if !data.PreferOverOspfRoutesEnabled.IsNull() && !data.PreferOverOspfRoutesEnabled.IsUnknown() {
+		payload.SetPreferOverOspfRoutesEnabled(data.PreferOverOspfRoutesEnabled.ValueBool())
+	}
+	return payload
+}
+
+// readRoute sets data from the static route returned by the Dashboard API.
+func readRoute(data *routeModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var route apiStaticRoute
+	if err := utils.ConvertJSON(response, &route); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the static route: %s", err))
+		return diags
+	}
+
+	data.StaticRouteId = types.StringPointerValue(route.StaticRouteId)
+	data.Subnet = types.StringPointerValue(route.Subnet)
+	data.NextHopIp = types.StringPointerValue(route.NextHopIp)
+	data.AdvertiseViaOspfEnabled = types.BoolPointerValue(route.AdvertiseViaOspfEnabled)
+	data.PreferOverOspfRoutesEnabled = types.BoolPointerValue(route.PreferOverOspfRoutesEnabled)
+
+	data.Name = types.StringNull()
+	if route.Name != nil && *route.Name != "" {
+		data.Name = types.StringValue(*route.Name)
+	}
+
+	return diags
+}
+
+// validateRoute checks that the next hop is not within the destination subnet of the route, other than for the
+// default route.
+func validateRoute(data *routeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Subnet.IsNull() || data.Subnet.IsUnknown() || data.NextHopIp.IsNull() || data.NextHopIp.IsUnknown() {
+		return diags
+	}
+
+	// Invalid values are reported by the attribute validators
+	subnet, err := utils.ParseIPv4Subnet(data.Subnet.ValueString())
+	if err != nil || subnet.Bits() == 0 {
+		return diags
+	}
+	nextHop, err := netip.ParseAddr(data.NextHopIp.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	if subnet.Contains(nextHop) {
+		diags.AddAttributeError(path.Root("next_hop_ip"), "Invalid Next Hop IP",
+			fmt.Sprintf("next hop IP %s is within the destination subnet %s of the route", nextHop, subnet))
+	}
+
+	return diags
+}
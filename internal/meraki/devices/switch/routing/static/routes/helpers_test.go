@@ -0,0 +1,74 @@
+package routes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testRouteModel(subnet, nextHop string) routeModel {
+	return routeModel{
+		StaticRouteId:               types.StringUnknown(),
+		Name:                        types.StringNull(),
+		Subnet:                      types.StringValue(subnet),
+		NextHopIp:                   types.StringValue(nextHop),
+		AdvertiseViaOspfEnabled:     types.BoolValue(true),
+		PreferOverOspfRoutesEnabled: types.BoolUnknown(),
+	}
+}
+
+func TestPayloads(t *testing.T) {
+	data := testRouteModel("192.168.10.0/24", "192.168.1.1")
+
+	// Test case: Unknown and unset values are left out of the create request
+	create := createPayload(&data)
+	assert.Equal(t, "192.168.10.0/24", create.GetSubnet())
+	assert.False(t, create.HasName())
+	assert.True(t, create.GetAdvertiseViaOspfEnabled())
+	assert.False(t, create.HasPreferOverOspfRoutesEnabled())
+
+	// Test case: A removed name is cleared on update
+	update := updatePayload(&data)
+	assert.True(t, update.HasName())
+	assert.Equal(t, "", update.GetName())
+	assert.Equal(t, "192.168.1.1", update.GetNextHopIp())
+}
+
+func TestReadRoute(t *testing.T) {
+	data := testRouteModel("192.168.10.0/24", "192.168.1.1")
+	response := map[string]interface{}{
+		"staticRouteId":               "1234",
+		"name":                        "",
+		"subnet":                      "192.168.10.0/24",
+		"nextHopIp":                   "192.168.1.1",
+		"advertiseViaOspfEnabled":     true,
+		"preferOverOspfRoutesEnabled": false,
+	}
+	require.False(t, readRoute(&data, response).HasError())
+
+	assert.Equal(t, "1234", data.StaticRouteId.ValueString())
+	assert.True(t, data.Name.IsNull())
+	assert.False(t, data.PreferOverOspfRoutesEnabled.ValueBool())
+}
+
+func TestValidateRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		subnet  string
+		nextHop string
+		err     bool
+	}{
+		{name: "next hop outside subnet", subnet: "192.168.10.0/24", nextHop: "192.168.1.1"},
+		{name: "default route", subnet: "0.0.0.0/0", nextHop: "192.168.1.1"},
+		{name: "next hop inside subnet", subnet: "192.168.10.0/24", nextHop: "192.168.10.1", err: true},
+		{name: "invalid subnet is left to the attribute validator", subnet: "192.168.10.1/24", nextHop: "192.168.10.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testRouteModel(tt.subnet, tt.nextHop)
+			assert.Equal(t, tt.err, validateRoute(&data).HasError())
+		})
+	}
+}
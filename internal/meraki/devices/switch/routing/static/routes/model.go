@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// routeModel describes the static route attributes shared by switches and switch stacks.
+type routeModel struct {
+	StaticRouteId               types.String `tfsdk:"static_route_id"`
+	Name                        types.String `tfsdk:"name"`
+	Subnet                      types.String `tfsdk:"subnet"`
+	NextHopIp                   types.String `tfsdk:"next_hop_ip"`
+	AdvertiseViaOspfEnabled     types.Bool   `tfsdk:"advertise_via_ospf_enabled"`
+	PreferOverOspfRoutesEnabled types.Bool   `tfsdk:"prefer_over_ospf_routes_enabled"`
+}
+
+// resourceModel describes the data model of a switch static route.
+type resourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Serial types.String `tfsdk:"serial"`
+	routeModel
+}
+
+// stackResourceModel describes the data model of a switch stack static route.
+type stackResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	NetworkId     types.String `tfsdk:"network_id"`
+	SwitchStackId types.String `tfsdk:"switch_stack_id"`
+	routeModel
+}
+
+func (m *resourceModel) staticRoute() *routeModel {
+	return &m.routeModel
+}
+
+func (m *resourceModel) setId(id string) {
+	m.Id = types.StringValue(id)
+}
+
+func (m *stackResourceModel) staticRoute() *routeModel {
+	return &m.routeModel
+}
+
+func (m *stackResourceModel) setId(id string) {
+	m.Id = types.StringValue(id)
+}
+
+// apiStaticRoute is a static route in the format of the Dashboard API. The generated client returns maps and
+// structs for the different endpoints, which are all converted to this type.
+type apiStaticRoute struct {
+	StaticRouteId               *string `json:"staticRouteId,omitempty"`
+	Name                        *string `json:"name,omitempty"`
+	Subnet                      *string `json:"subnet,omitempty"`
+	NextHopIp                   *string `json:"nextHopIp,omitempty"`
+	AdvertiseViaOspfEnabled     *bool   `json:"advertiseViaOspfEnabled,omitempty"`
+	PreferOverOspfRoutesEnabled *bool   `json:"preferOverOspfRoutesEnabled,omitempty"`
+}
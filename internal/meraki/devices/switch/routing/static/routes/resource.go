@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &Resource{}
+	_ resource.ResourceWithConfigure      = &Resource{}
+	_ resource.ResourceWithImportState    = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		routeResource: routeResource[*resourceModel]{
+			api: deviceApi,
+		},
+	}
+}
+
+// Resource defines the switch static route resource implementation.
+type Resource struct {
+	routeResource[*resourceModel]
+}
+
+// deviceApi makes the Dashboard API calls for the static routes of a switch.
+var deviceApi = routeApi[*resourceModel]{
+	typeName: "_devices_switch_routing_static_route",
+	create: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel, payload openApiClient.CreateDeviceSwitchRoutingStaticRouteRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.CreateDeviceSwitchRoutingStaticRoute(ctx, data.Serial.ValueString()).CreateDeviceSwitchRoutingStaticRouteRequest(payload).Execute()
+	},
+	get: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel) (interface{}, *http.Response, error) {
+		return client.SwitchApi.GetDeviceSwitchRoutingStaticRoute(ctx, data.Serial.ValueString(), data.StaticRouteId.ValueString()).Execute()
+	},
+	update: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel, payload openApiClient.UpdateDeviceSwitchRoutingStaticRouteRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.UpdateDeviceSwitchRoutingStaticRoute(ctx, data.Serial.ValueString(), data.StaticRouteId.ValueString()).UpdateDeviceSwitchRoutingStaticRouteRequest(payload).Execute()
+	},
+	delete: func(ctx context.Context, client *openApiClient.APIClient, data *resourceModel) (*http.Response, error) {
+		return client.SwitchApi.DeleteDeviceSwitchRoutingStaticRoute(ctx, data.Serial.ValueString(), data.StaticRouteId.ValueString()).Execute()
+	},
+	id: func(data *resourceModel) string {
+		return data.Serial.ValueString() + "," + data.StaticRouteId.ValueString()
+	},
+}
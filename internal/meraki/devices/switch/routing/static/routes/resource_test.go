@@ -0,0 +1,90 @@
+package routes_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDevicesSwitchRoutingStaticRouteResource(t *testing.T) {
+	serial := os.Getenv("TF_ACC_MERAKI_MS_SERIAL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+			if serial == "" {
+				t.Skip("TF_ACC_MERAKI_MS_SERIAL must be set to test switch static routes")
+			}
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_devices_switch_routing_static_route"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_devices_switch_routing_static_route"),
+			},
+
+			// Create and Read Static Route
+			{
+				Config: DevicesSwitchRoutingStaticRouteResourceConfig(serial, "test_acc_route", "192.168.100.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_static_route.test", "name", "test_acc_route"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_static_route.test", "subnet", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_static_route.test", "next_hop_ip", "192.168.100.10"),
+					resource.TestCheckResourceAttrSet("meraki_devices_switch_routing_static_route.test", "static_route_id"),
+				),
+			},
+
+			// Update and Read Static Route
+			{
+				Config: DevicesSwitchRoutingStaticRouteResourceConfig(serial, "test_acc_route_updated", "192.168.100.11"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_static_route.test", "name", "test_acc_route_updated"),
+					resource.TestCheckResourceAttr("meraki_devices_switch_routing_static_route.test", "next_hop_ip", "192.168.100.11"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_devices_switch_routing_static_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func DevicesSwitchRoutingStaticRouteResourceConfig(serial, name, nextHopIp string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_devices_claim" "test" {
+    network_id = resource.meraki_network.test.network_id
+    serials = ["%s"]
+}
+
+resource "meraki_devices_switch_routing_interface" "test" {
+    depends_on = [resource.meraki_networks_devices_claim.test]
+    serial = "%s"
+    name = "test_acc_static_route"
+    vlan_id = 100
+    subnet = "192.168.100.0/24"
+    interface_ip = "192.168.100.2"
+    default_gateway = "192.168.100.1"
+}
+
+resource "meraki_devices_switch_routing_static_route" "test" {
+    depends_on = [resource.meraki_devices_switch_routing_interface.test]
+    serial = "%s"
+    name = "%s"
+    subnet = "10.10.0.0/16"
+    next_hop_ip = "%s"
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_devices_switch_routing_static_route"),
+		serial, serial, serial, name, nextHopIp,
+	)
+}
@@ -0,0 +1,115 @@
+package routes
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := routeAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The serial and static route ID, separated by a comma",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["serial"] = schema.StringAttribute{
+		MarkdownDescription: "The serial of the switch",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			utils.SerialValidator(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a layer 3 static route of a switch",
+		Attributes:          attributes,
+	}
+}
+
+func (r *StackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := routeAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The network ID, switch stack ID and static route ID, separated by commas",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["network_id"] = schema.StringAttribute{
+		MarkdownDescription: "Network ID",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["switch_stack_id"] = schema.StringAttribute{
+		MarkdownDescription: "Switch stack ID",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a layer 3 static route of a switch stack",
+		Attributes:          attributes,
+	}
+}
+
+// routeAttributes returns the static route attributes shared by switches and switch stacks.
+func routeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"static_route_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the static route",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name or description for layer 3 static route",
+			Optional:            true,
+		},
+		"subnet": schema.StringAttribute{
+			MarkdownDescription: "The subnet which is routed via this static route, in CIDR notation (ex. 192.168.1.0/24)",
+			Required:            true,
+			Validators: []validator.String{
+				utils.IPv4SubnetValidator(),
+			},
+		},
+		"next_hop_ip": schema.StringAttribute{
+			MarkdownDescription: "IP address of the next hop device to which the device sends its traffic for the subnet",
+			Required:            true,
+			Validators: []validator.String{
+				utils.IPv4AddressValidator(),
+			},
+		},
+		"advertise_via_ospf_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Option to advertise static route via OSPF",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"prefer_over_ospf_routes_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Option to prefer static route over OSPF routes",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
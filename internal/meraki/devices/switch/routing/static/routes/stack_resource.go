@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &StackResource{}
+	_ resource.ResourceWithConfigure      = &StackResource{}
+	_ resource.ResourceWithImportState    = &StackResource{}
+	_ resource.ResourceWithValidateConfig = &StackResource{}
+)
+
+func NewStackResource() resource.Resource {
+	return &StackResource{
+		routeResource: routeResource[*stackResourceModel]{
+			api: stackApi,
+		},
+	}
+}
+
+// StackResource defines the switch stack static route resource implementation.
+type StackResource struct {
+	routeResource[*stackResourceModel]
+}
+
+// stackApi makes the Dashboard API calls for the static routes of a switch stack.
+var stackApi = routeApi[*stackResourceModel]{
+	typeName: "_networks_switch_stack_routing_static_route",
+	create: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel, payload openApiClient.CreateDeviceSwitchRoutingStaticRouteRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.CreateNetworkSwitchStackRoutingStaticRoute(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString()).CreateDeviceSwitchRoutingStaticRouteRequest(payload).Execute()
+	},
+	get: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel) (interface{}, *http.Response, error) {
+		return client.SwitchApi.GetNetworkSwitchStackRoutingStaticRoute(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.StaticRouteId.ValueString()).Execute()
+	},
+	update: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel, payload openApiClient.UpdateDeviceSwitchRoutingStaticRouteRequest) (interface{}, *http.Response, error) {
+		return client.SwitchApi.UpdateNetworkSwitchStackRoutingStaticRoute(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.StaticRouteId.ValueString()).UpdateDeviceSwitchRoutingStaticRouteRequest(payload).Execute()
+	},
+	delete: func(ctx context.Context, client *openApiClient.APIClient, data *stackResourceModel) (*http.Response, error) {
+		return client.SwitchApi.DeleteNetworkSwitchStackRoutingStaticRoute(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.StaticRouteId.ValueString()).Execute()
+	},
+	id: func(data *stackResourceModel) string {
+		return strings.Join([]string{data.NetworkId.ValueString(), data.SwitchStackId.ValueString(), data.StaticRouteId.ValueString()}, ",")
+	},
+}
@@ -0,0 +1,49 @@
+package categories_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceContentFilteringCategoriesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_content_filtering_categories"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_content_filtering_categories"),
+			},
+
+			// Read Content Filtering Categories
+			{
+				Config: NetworksApplianceContentFilteringCategoriesDataSourceConfigRead(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.meraki_networks_appliance_content_filtering_categories.test", "categories.0.id"),
+					resource.TestCheckResourceAttrSet("data.meraki_networks_appliance_content_filtering_categories.test", "categories.0.name"),
+					resource.TestCheckResourceAttrSet("data.meraki_networks_appliance_content_filtering_categories.test", "category_ids.%"),
+				),
+			},
+		},
+	})
+}
+
+func NetworksApplianceContentFilteringCategoriesDataSourceConfigRead() string {
+	return fmt.Sprintf(`
+	%s
+
+data "meraki_networks_appliance_content_filtering_categories" "test" {
+	network_id = resource.meraki_network.test.network_id
+}
+	`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_content_filtering_categories"),
+	)
+}
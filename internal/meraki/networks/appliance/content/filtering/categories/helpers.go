@@ -0,0 +1,37 @@
+package categories
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// readCategories sets data from the content filtering categories returned by the Dashboard API.
+func readCategories(ctx context.Context, data *dataSourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var categories apiCategories
+	if err := utils.ConvertJSON(response, &categories); err != nil {
+		diags.AddError("Data Source Response Error", fmt.Sprintf("Could not read the content filtering categories: %s", err))
+		return diags
+	}
+
+	data.Id = data.NetworkId
+
+	list := []categoryModel{}
+	ids := map[string]string{}
+	for _, category := range categories.Categories {
+		list = append(list, categoryModel{Id: types.StringValue(category.Id), Name: types.StringValue(category.Name)})
+		ids[category.Name] = category.Id
+	}
+
+	var valueDiags diag.Diagnostics
+	data.Categories, valueDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: categoryAttrTypes()}, list)
+	diags.Append(valueDiags...)
+	data.CategoryIds, valueDiags = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(valueDiags...)
+
+	return diags
+}
@@ -0,0 +1,27 @@
+package categories
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReadCategories(t *testing.T) {
+	ctx := context.Background()
+
+	data := dataSourceModel{NetworkId: types.StringValue("N_1")}
+	response := map[string]interface{}{
+		"categories": []interface{}{
+			map[string]interface{}{"id": "meraki:contentFiltering/category/1", "name": "Real Estate"},
+			map[string]interface{}{"id": "meraki:contentFiltering/category/7", "name": "Gambling"},
+		},
+	}
+	require.False(t, readCategories(ctx, &data, response).HasError())
+
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	require.Len(t, data.Categories.Elements(), 2)
+	assert.Equal(t, types.StringValue("Gambling"), data.Categories.Elements()[1].(types.Object).Attributes()["name"])
+	assert.Equal(t, types.StringValue("meraki:contentFiltering/category/7"), data.CategoryIds.Elements()["Gambling"])
+}
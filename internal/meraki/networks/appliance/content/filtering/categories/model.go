@@ -0,0 +1,34 @@
+package categories
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dataSourceModel describes the data model of the content filtering categories available to a network.
+type dataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	NetworkId   types.String `tfsdk:"network_id"`
+	Categories  types.List   `tfsdk:"categories"`
+	CategoryIds types.Map    `tfsdk:"category_ids"`
+}
+
+type categoryModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func categoryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}
+}
+
+// apiCategories is the content filtering categories of a network in the format of the Dashboard API.
+type apiCategories struct {
+	Categories []struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"categories"`
+}
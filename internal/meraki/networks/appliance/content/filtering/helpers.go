@@ -0,0 +1,85 @@
+package filtering
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// contentFilteringPayload returns the content filtering payload for the planned data. The lists are always sent, so
+// that entries removed from the configuration are removed from the Dashboard.
+func contentFilteringPayload(ctx context.Context, data *resourceModel) (openApiClient.UpdateNetworkApplianceContentFilteringRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateNetworkApplianceContentFilteringRequest()
+	payload.AllowedUrlPatterns = []string{}
+	payload.BlockedUrlPatterns = []string{}
+	payload.BlockedUrlCategories = []string{}
+
+	diags.Append(data.AllowedUrlPatterns.ElementsAs(ctx, &payload.AllowedUrlPatterns, false)...)
+	diags.Append(data.BlockedUrlPatterns.ElementsAs(ctx, &payload.BlockedUrlPatterns, false)...)
+	diags.Append(data.BlockedUrlCategories.ElementsAs(ctx, &payload.BlockedUrlCategories, false)...)
+
+	if utils.IsKnown(data.UrlCategoryListSize) {
+		payload.SetUrlCategoryListSize(data.UrlCategoryListSize.ValueString())
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that restores the default content filtering settings of a network: nothing
+// allowed or blocked and the top sites category list.
+func resetPayload() openApiClient.UpdateNetworkApplianceContentFilteringRequest {
+	payload := *openApiClient.NewUpdateNetworkApplianceContentFilteringRequest()
+	payload.AllowedUrlPatterns = []string{}
+	payload.BlockedUrlPatterns = []string{}
+	payload.BlockedUrlCategories = []string{}
+	payload.SetUrlCategoryListSize("topSites")
+	return payload
+}
+
+// readContentFiltering sets data from the content filtering settings returned by the Dashboard API.
+func readContentFiltering(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var contentFiltering apiContentFiltering
+	if err := utils.ConvertJSON(response, &contentFiltering); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the content filtering settings: %s", err))
+		return diags
+	}
+
+	categories := []string{}
+	for _, category := range contentFiltering.BlockedUrlCategories {
+		switch value := category.(type) {
+		case string:
+			categories = append(categories, value)
+		case map[string]interface{}:
+			if id, ok := value["id"].(string); ok {
+				categories = append(categories, id)
+			}
+		}
+	}
+
+	data.Id = data.NetworkId
+	data.UrlCategoryListSize = types.StringValue(contentFiltering.UrlCategoryListSize)
+
+	var setDiags diag.Diagnostics
+	data.AllowedUrlPatterns, setDiags = stringSet(ctx, contentFiltering.AllowedUrlPatterns)
+	diags.Append(setDiags...)
+	data.BlockedUrlPatterns, setDiags = stringSet(ctx, contentFiltering.BlockedUrlPatterns)
+	diags.Append(setDiags...)
+	data.BlockedUrlCategories, setDiags = stringSet(ctx, categories)
+	diags.Append(setDiags...)
+
+	return diags
+}
+
+func stringSet(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
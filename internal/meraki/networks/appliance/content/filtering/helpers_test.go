@@ -0,0 +1,59 @@
+package filtering
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testSet(values ...string) types.Set {
+	elements := []attr.Value{}
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestContentFilteringPayload(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: An unknown list size is left out and empty lists are sent to remove the entries in the Dashboard
+	data := resourceModel{
+		NetworkId:            types.StringValue("N_1"),
+		AllowedUrlPatterns:   testSet("http://www.example.org"),
+		BlockedUrlPatterns:   testSet(),
+		BlockedUrlCategories: testSet("meraki:contentFiltering/category/1"),
+		UrlCategoryListSize:  types.StringUnknown(),
+	}
+	payload, diags := contentFilteringPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"allowedUrlPatterns": ["http://www.example.org"], "blockedUrlPatterns": [], "blockedUrlCategories": ["meraki:contentFiltering/category/1"]}`, string(body))
+}
+
+func TestReadContentFiltering(t *testing.T) {
+	ctx := context.Background()
+
+	data := resourceModel{NetworkId: types.StringValue("N_1")}
+	response := map[string]interface{}{
+		"allowedUrlPatterns": []interface{}{"http://www.example.org"},
+		"blockedUrlCategories": []interface{}{
+			map[string]interface{}{"id": "meraki:contentFiltering/category/1", "name": "Real Estate"},
+			"meraki:contentFiltering/category/7",
+		},
+		"urlCategoryListSize": "fullList",
+	}
+	require.False(t, readContentFiltering(ctx, &data, response).HasError())
+
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	assert.Equal(t, testSet("http://www.example.org"), data.AllowedUrlPatterns)
+	assert.Equal(t, testSet(), data.BlockedUrlPatterns)
+	assert.Equal(t, testSet("meraki:contentFiltering/category/1", "meraki:contentFiltering/category/7"), data.BlockedUrlCategories)
+	assert.Equal(t, "fullList", data.UrlCategoryListSize.ValueString())
+}
@@ -0,0 +1,24 @@
+package filtering
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the content filtering settings of a network.
+type resourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	NetworkId            types.String `tfsdk:"network_id"`
+	AllowedUrlPatterns   types.Set    `tfsdk:"allowed_url_patterns"`
+	BlockedUrlPatterns   types.Set    `tfsdk:"blocked_url_patterns"`
+	BlockedUrlCategories types.Set    `tfsdk:"blocked_url_categories"`
+	UrlCategoryListSize  types.String `tfsdk:"url_category_list_size"`
+}
+
+// apiContentFiltering is the content filtering settings of a network in the format of the Dashboard API. The blocked
+// categories are returned as objects with an id and a name, but are sent as a list of IDs.
+type apiContentFiltering struct {
+	AllowedUrlPatterns   []string      `json:"allowedUrlPatterns"`
+	BlockedUrlPatterns   []string      `json:"blockedUrlPatterns"`
+	BlockedUrlCategories []interface{} `json:"blockedUrlCategories"`
+	UrlCategoryListSize  string        `json:"urlCategoryListSize"`
+}
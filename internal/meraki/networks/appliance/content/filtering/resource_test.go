@@ -0,0 +1,73 @@
+package filtering_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceContentFilteringResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_content_filtering"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_content_filtering"),
+			},
+
+			// Create and Read Content Filtering
+			{
+				Config: NetworksApplianceContentFilteringResourceConfig("topSites"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_appliance_content_filtering.test", "allowed_url_patterns.#", "1"),
+					resource.TestCheckTypeSetElemAttr("meraki_networks_appliance_content_filtering.test", "blocked_url_patterns.*", "http://www.example.com"),
+					resource.TestCheckResourceAttr("meraki_networks_appliance_content_filtering.test", "blocked_url_categories.#", "1"),
+					resource.TestCheckResourceAttr("meraki_networks_appliance_content_filtering.test", "url_category_list_size", "topSites"),
+				),
+			},
+
+			// Update and Read Content Filtering
+			{
+				Config: NetworksApplianceContentFilteringResourceConfig("fullList"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_appliance_content_filtering.test", "url_category_list_size", "fullList"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_appliance_content_filtering.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceContentFilteringResourceConfig(urlCategoryListSize string) string {
+	return fmt.Sprintf(`
+	%s
+data "meraki_networks_appliance_content_filtering_categories" "test" {
+    network_id = resource.meraki_network.test.network_id
+}
+
+resource "meraki_networks_appliance_content_filtering" "test" {
+    network_id = resource.meraki_network.test.network_id
+    allowed_url_patterns = ["http://www.example.org"]
+    blocked_url_patterns = ["http://www.example.com"]
+    blocked_url_categories = [data.meraki_networks_appliance_content_filtering_categories.test.categories[0].id]
+    url_category_list_size = "%s"
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_content_filtering"),
+		urlCategoryListSize,
+	)
+}
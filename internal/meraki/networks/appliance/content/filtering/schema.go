@@ -0,0 +1,70 @@
+package filtering
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptySet := setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{}))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the content filtering settings of a network appliance. Deleting this resource removes the allowed and blocked URL patterns and categories. The category IDs can be looked up by name with the `meraki_networks_appliance_content_filtering_categories` data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_url_patterns": schema.SetAttribute{
+				MarkdownDescription: "The URL patterns that are allowed. Defaults to no patterns.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+			"blocked_url_patterns": schema.SetAttribute{
+				MarkdownDescription: "The URL patterns that are blocked. Defaults to no patterns.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+			"blocked_url_categories": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the URL categories that are blocked, e.g. `meraki:contentFiltering/category/1`. Defaults to no categories.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             emptySet,
+			},
+			"url_category_list_size": schema.StringAttribute{
+				MarkdownDescription: "URL category list size which is either 'topSites' or 'fullList'",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("topSites", "fullList"),
+				},
+			},
+		},
+	}
+}
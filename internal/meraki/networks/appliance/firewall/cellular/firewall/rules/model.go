@@ -0,0 +1,26 @@
+package rules
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+)
+
+// CellularFirewallRulesModel describes the resource data model. The cellular firewall has no syslog_default_rule
+// setting.
+type CellularFirewallRulesModel struct {
+	Id        jsontypes.String          `tfsdk:"id"`
+	NetworkId jsontypes.String          `tfsdk:"network_id"`
+	Rules     []utils.FirewallRuleModel `tfsdk:"rules"`
+}
+
+// readRules sets the rules of data from a response of the Dashboard API.
+func readRules(response interface{}, data *CellularFirewallRulesModel) error {
+	rules, err := utils.ReadFirewallRules(response, data.Rules)
+	if err != nil {
+		return err
+	}
+
+	data.Id = jsontypes.StringValue(data.NetworkId.ValueString())
+	data.Rules = rules.Rules
+	return nil
+}
@@ -0,0 +1,32 @@
+package rules
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var ResourceSchema = schema.Schema{
+	MarkdownDescription: "Manage the cellular firewall rules of a network appliance. Cellular rules apply to traffic over the cellular uplink of an appliance.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+			CustomType:          jsontypes.StringType,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+			CustomType:          jsontypes.StringType,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rules": utils.FirewallRulesSchema,
+	},
+}
@@ -0,0 +1,31 @@
+package rules
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+)
+
+// InboundFirewallRulesModel describes the resource data model.
+type InboundFirewallRulesModel struct {
+	Id                jsontypes.String          `tfsdk:"id"`
+	NetworkId         jsontypes.String          `tfsdk:"network_id"`
+	SyslogDefaultRule jsontypes.Bool            `tfsdk:"syslog_default_rule"`
+	Rules             []utils.FirewallRuleModel `tfsdk:"rules"`
+}
+
+// readRules sets the rules and syslog_default_rule of data from a response of the Dashboard API. The Dashboard leaves
+// syslogDefaultRule out when it is disabled.
+func readRules(response interface{}, data *InboundFirewallRulesModel) error {
+	rules, err := utils.ReadFirewallRules(response, data.Rules)
+	if err != nil {
+		return err
+	}
+
+	data.Id = jsontypes.StringValue(data.NetworkId.ValueString())
+	data.Rules = rules.Rules
+	data.SyslogDefaultRule = rules.SyslogDefaultRule
+	if data.SyslogDefaultRule.IsNull() {
+		data.SyslogDefaultRule = jsontypes.BoolValue(false)
+	}
+	return nil
+}
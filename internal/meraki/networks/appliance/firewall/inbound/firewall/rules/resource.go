@@ -0,0 +1,190 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithConfigure   = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_appliance_firewall_inbound_firewall_rules"
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InboundFirewallRulesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "create resource")
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InboundFirewallRulesModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetNetworkApplianceFirewallInboundFirewallRules200Response, *http.Response, error) {
+		return r.client.ApplianceApi.GetNetworkApplianceFirewallInboundFirewallRules(ctx, data.NetworkId.ValueString()).Execute()
+	})
+
+	// The network was deleted outside of Terraform
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return
+	}
+
+	if err = readRules(inlineResp, data); err != nil {
+		resp.Diagnostics.AddError("JSON decoding error", fmt.Sprintf("%v\n", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InboundFirewallRulesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+// Delete removes every inbound firewall rule of the network and stops logging the default rule.
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InboundFirewallRulesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := *openApiClient.NewUpdateNetworkApplianceFirewallInboundFirewallRulesRequest()
+	payload.SetRules([]openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner{})
+	payload.SetSyslogDefaultRule(false)
+
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetNetworkApplianceFirewallInboundFirewallRules200Response, *http.Response, error) {
+		return r.client.ApplianceApi.UpdateNetworkApplianceFirewallInboundFirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallInboundFirewallRulesRequest(payload).Execute()
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Delete Failure", httpResp, err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+// update replaces the inbound firewall rules of the network with the planned rules.
+func (r *Resource) update(ctx context.Context, data *InboundFirewallRulesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateNetworkApplianceFirewallInboundFirewallRulesRequest()
+	payload.SetRules(utils.FirewallRulesPayload(data.Rules))
+	if !data.SyslogDefaultRule.IsNull() && !data.SyslogDefaultRule.IsUnknown() {
+		payload.SetSyslogDefaultRule(data.SyslogDefaultRule.ValueBool())
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetNetworkApplianceFirewallInboundFirewallRules200Response, *http.Response, error) {
+		return r.client.ApplianceApi.UpdateNetworkApplianceFirewallInboundFirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallInboundFirewallRulesRequest(payload).Execute()
+	})
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic("HTTP Client Update Failure", httpResp, err))
+		return diags
+	}
+
+	if err = readRules(inlineResp, data); err != nil {
+		diags.AddError("JSON decoding error", fmt.Sprintf("%v\n", err.Error()))
+	}
+	return diags
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var ResourceSchema = schema.Schema{
+	MarkdownDescription: "Manage the inbound firewall rules of a network appliance. Inbound rules apply to traffic from the WAN to the LAN of an appliance in routed mode.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+			CustomType:          jsontypes.StringType,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+			CustomType:          jsontypes.StringType,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"syslog_default_rule": utils.SyslogDefaultRuleSchema,
+		"rules":               utils.FirewallRulesSchema,
+	},
+}
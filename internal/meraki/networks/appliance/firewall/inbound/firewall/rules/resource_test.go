@@ -0,0 +1,89 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworksApplianceFirewallInboundFirewallRulesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_inbound_firewall_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_firewall_inbound_firewall_rules"),
+			},
+
+			// Create and Read Inbound Firewall Rules.
+			{
+				Config: NetworksApplianceInboundFirewallRulesResourceConfig("allow"),
+				Check:  NetworksApplianceInboundFirewallRulesResourceConfigChecks("allow"),
+			},
+
+			// Update and Read Inbound Firewall Rules.
+			{
+				Config: NetworksApplianceInboundFirewallRulesResourceConfig("deny"),
+				Check:  NetworksApplianceInboundFirewallRulesResourceConfigChecks("deny"),
+			},
+
+			{
+				ResourceName:      "meraki_networks_appliance_firewall_inbound_firewall_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceInboundFirewallRulesResourceConfig(policy string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_firewall_inbound_firewall_rules" "test" {
+    depends_on = [resource.meraki_network.test]
+    network_id = resource.meraki_network.test.network_id
+    syslog_default_rule = false
+    rules = [
+    {
+        comment =  "Allow TCP traffic to subnet with HTTP servers."
+        policy = "%s"
+        protocol = "tcp"
+        dest_port = "443"
+        dest_cidr = "192.168.1.0/24"
+        src_port = "Any"
+        src_cidr = "Any"
+        syslog_enabled = false
+    }
+    ]
+}
+	`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_inbound_firewall_rules"),
+		policy,
+	)
+}
+
+// NetworksApplianceInboundFirewallRulesResourceConfigChecks returns the test check functions for NetworksApplianceInboundFirewallRulesResourceConfig
+func NetworksApplianceInboundFirewallRulesResourceConfigChecks(policy string) resource.TestCheckFunc {
+	expectedAttrs := map[string]string{
+		"syslog_default_rule":    "false",
+		"rules.#":                "1",
+		"rules.0.comment":        "Allow TCP traffic to subnet with HTTP servers.",
+		"rules.0.policy":         policy,
+		"rules.0.protocol":       "tcp",
+		"rules.0.dest_port":      "443",
+		"rules.0.dest_cidr":      "192.168.1.0/24",
+		"rules.0.src_port":       "Any",
+		"rules.0.src_cidr":       "Any",
+		"rules.0.syslog_enabled": "false",
+	}
+	return utils.ResourceTestCheck("meraki_networks_appliance_firewall_inbound_firewall_rules.test", expectedAttrs)
+}
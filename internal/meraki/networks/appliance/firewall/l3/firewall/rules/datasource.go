@@ -2,7 +2,6 @@ package rules
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
@@ -37,17 +36,17 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -61,12 +60,9 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	_, httpResp, err := d.client.ApplianceApi.GetNetworkApplianceFirewallL3FirewallRules(context.Background(), data.NetworkId.ValueString()).Execute()
+	inlineResp, httpResp, err := d.client.ApplianceApi.GetNetworkApplianceFirewallL3FirewallRules(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -85,7 +81,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	}
 
 	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
+	if err = readRules(inlineResp, data); err != nil {
 		resp.Diagnostics.AddError(
 			"JSON decoding error",
 			fmt.Sprintf("%v\n", err.Error()),
@@ -93,11 +89,6 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	// Check if the default rule is nil
-	if data.SyslogDefaultRule.IsNull() {
-		data.SyslogDefaultRule = jsontypes.BoolValue(false)
-	}
-
 	data.Id = jsontypes.StringValue(data.NetworkId.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
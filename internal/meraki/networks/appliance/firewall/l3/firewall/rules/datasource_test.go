@@ -86,6 +86,7 @@ data "meraki_networks_appliance_firewall_l3_firewall_rules" "test" {
 // NetworksApplianceFirewallL3FirewallRulesDataSourceConfigReadChecks returns the test check functions for NetworksApplianceFirewallL3FirewallRulesDataSourceConfigRead
 func NetworksApplianceFirewallL3FirewallRulesDataSourceConfigReadChecks() resource.TestCheckFunc {
 	expectedAttrs := map[string]string{
+		"rules.#":                "1",
 		"rules.0.comment":        "Allow TCP traffic to subnet with HTTP servers.",
 		"rules.0.policy":         "allow",
 		"rules.0.protocol":       "tcp",
@@ -95,5 +96,13 @@ func NetworksApplianceFirewallL3FirewallRulesDataSourceConfigReadChecks() resour
 		"rules.0.src_cidr":       "Any",
 		"rules.0.syslog_enabled": "false",
 	}
-	return utils.ResourceTestCheck("data.meraki_networks_appliance_firewall_l3_firewall_rules.test", expectedAttrs)
+	return resource.ComposeAggregateTestCheckFunc(
+		utils.ResourceTestCheck("data.meraki_networks_appliance_firewall_l3_firewall_rules.test", expectedAttrs),
+
+		// The resource declares the default rule, so it keeps it in its state
+		utils.ResourceTestCheck("meraki_networks_appliance_firewall_l3_firewall_rules.test", map[string]string{
+			"rules.#":         "2",
+			"rules.1.comment": "Default rule",
+		}),
+	)
 }
@@ -1,22 +1,30 @@
 package rules
 
-import "github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+)
 
 // L3FirewallRulesModel describes the resource data model.
 type L3FirewallRulesModel struct {
-	Id                jsontypes.String           `tfsdk:"id"`
-	NetworkId         jsontypes.String           `tfsdk:"network_id" json:"network_id"`
-	SyslogDefaultRule jsontypes.Bool             `tfsdk:"syslog_default_rule"`
-	Rules             []L3FirewallRulesRuleModel `tfsdk:"rules" json:"rules"`
+	Id                jsontypes.String          `tfsdk:"id"`
+	NetworkId         jsontypes.String          `tfsdk:"network_id" json:"network_id"`
+	SyslogDefaultRule jsontypes.Bool            `tfsdk:"syslog_default_rule"`
+	Rules             []utils.FirewallRuleModel `tfsdk:"rules" json:"rules"`
 }
 
-type L3FirewallRulesRuleModel struct {
-	Comment       jsontypes.String `tfsdk:"comment"`
-	DestCidr      jsontypes.String `tfsdk:"dest_cidr"`
-	DestPort      jsontypes.String `tfsdk:"dest_port"`
-	Policy        jsontypes.String `tfsdk:"policy"`
-	Protocol      jsontypes.String `tfsdk:"protocol"`
-	SrcPort       jsontypes.String `tfsdk:"src_port"`
-	SrcCidr       jsontypes.String `tfsdk:"src_cidr"`
-	SysLogEnabled jsontypes.Bool   `tfsdk:"syslog_enabled"`
+// readRules sets the rules and syslog_default_rule of data from a response of the Dashboard API. The Dashboard leaves
+// syslogDefaultRule out when it is disabled.
+func readRules(response interface{}, data *L3FirewallRulesModel) error {
+	rules, err := utils.ReadFirewallRules(response, data.Rules)
+	if err != nil {
+		return err
+	}
+
+	data.Rules = rules.Rules
+	data.SyslogDefaultRule = rules.SyslogDefaultRule
+	if data.SyslogDefaultRule.IsNull() {
+		data.SyslogDefaultRule = jsontypes.BoolValue(false)
+	}
+	return nil
 }
@@ -2,7 +2,6 @@ package rules
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
@@ -39,18 +38,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -65,33 +64,14 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	updateNetworkApplianceFirewallL3FirewallRules := *openApiClient.NewUpdateNetworkApplianceFirewallL3FirewallRulesRequest()
-	var rules []openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner
-
-	if len(data.Rules) > 0 {
-		for _, attribute := range data.Rules {
-			var rule openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner
-			if attribute.Comment != jsontypes.StringValue("Default rule") {
-				rule.SetComment(attribute.Comment.ValueString())
-				rule.SetDestCidr(attribute.DestCidr.ValueString())
-				rule.SetDestPort(attribute.DestPort.ValueString())
-				rule.SetSrcCidr(attribute.SrcCidr.ValueString())
-				rule.SetSrcPort(attribute.SrcPort.ValueString())
-				rule.SetPolicy(attribute.Policy.ValueString())
-				rule.SetProtocol(attribute.Protocol.ValueString())
-				rule.SetSyslogEnabled(attribute.SysLogEnabled.ValueBool())
-				rules = append(rules, rule)
-			}
-		}
+	updateNetworkApplianceFirewallL3FirewallRules.SetRules(utils.FirewallRulesPayload(data.Rules))
+	if !data.SyslogDefaultRule.IsNull() && !data.SyslogDefaultRule.IsUnknown() {
+		updateNetworkApplianceFirewallL3FirewallRules.SetSyslogDefaultRule(data.SyslogDefaultRule.ValueBool())
 	}
 
-	updateNetworkApplianceFirewallL3FirewallRules.SetRules(rules)
-
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL3FirewallRules(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL3FirewallRulesRequest(updateNetworkApplianceFirewallL3FirewallRules).Execute()
+	inlineResp, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL3FirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL3FirewallRulesRequest(updateNetworkApplianceFirewallL3FirewallRules).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -109,7 +89,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
+	if err = readRules(inlineResp, data); err != nil {
 		resp.Diagnostics.AddError(
 			"JSON decoding error",
 			fmt.Sprintf("%v\n", err.Error()),
@@ -135,12 +115,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceFirewallL3FirewallRules(context.Background(), data.NetworkId.ValueString()).Execute()
+	inlineResp, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceFirewallL3FirewallRules(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -159,7 +136,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	}
 
 	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
+	if err = readRules(inlineResp, data); err != nil {
 		resp.Diagnostics.AddError(
 			"JSON decoding error",
 			fmt.Sprintf("%v\n", err.Error()),
@@ -167,11 +144,6 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	// Check if the default rule is nil
-	if data.SyslogDefaultRule.IsNull() {
-		data.SyslogDefaultRule = jsontypes.BoolValue(false)
-	}
-
 	data.Id = jsontypes.StringValue(data.NetworkId.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -192,33 +164,14 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 
 	updateNetworkApplianceFirewallL3FirewallRules := *openApiClient.NewUpdateNetworkApplianceFirewallL3FirewallRulesRequest()
-	var rules []openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner
-
-	if len(data.Rules) > 0 {
-		for _, attribute := range data.Rules {
-			if attribute.Comment != jsontypes.StringValue("Default rule") {
-				var rule openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner
-				rule.SetComment(attribute.Comment.ValueString())
-				rule.SetDestCidr(attribute.DestCidr.ValueString())
-				rule.SetDestPort(attribute.DestPort.ValueString())
-				rule.SetSrcCidr(attribute.SrcCidr.ValueString())
-				rule.SetSrcPort(attribute.SrcPort.ValueString())
-				rule.SetPolicy(attribute.Policy.ValueString())
-				rule.SetProtocol(attribute.Protocol.ValueString())
-				rule.SetSyslogEnabled(attribute.SysLogEnabled.ValueBool())
-				rules = append(rules, rule)
-			}
-		}
+	updateNetworkApplianceFirewallL3FirewallRules.SetRules(utils.FirewallRulesPayload(data.Rules))
+	if !data.SyslogDefaultRule.IsNull() && !data.SyslogDefaultRule.IsUnknown() {
+		updateNetworkApplianceFirewallL3FirewallRules.SetSyslogDefaultRule(data.SyslogDefaultRule.ValueBool())
 	}
 
-	updateNetworkApplianceFirewallL3FirewallRules.SetRules(rules)
-
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL3FirewallRules(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL3FirewallRulesRequest(updateNetworkApplianceFirewallL3FirewallRules).Execute()
+	inlineResp, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL3FirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL3FirewallRulesRequest(updateNetworkApplianceFirewallL3FirewallRules).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -236,7 +189,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 
 	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
+	if err = readRules(inlineResp, data); err != nil {
 		resp.Diagnostics.AddError(
 			"JSON decoding error",
 			fmt.Sprintf("%v\n", err.Error()),
@@ -268,12 +221,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	updateNetworkApplianceFirewallL3FirewallRules.Rules = nil
 	updateNetworkApplianceFirewallL3FirewallRules.SetSyslogDefaultRule(false)
 
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL3FirewallRules(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL3FirewallRulesRequest(updateNetworkApplianceFirewallL3FirewallRules).Execute()
+	inlineResp, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL3FirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL3FirewallRulesRequest(updateNetworkApplianceFirewallL3FirewallRules).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -291,7 +241,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 
 	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
+	if err = readRules(inlineResp, data); err != nil {
 		resp.Diagnostics.AddError(
 			"JSON decoding error",
 			fmt.Sprintf("%v\n", err.Error()),
@@ -86,6 +86,7 @@ resource "meraki_networks_appliance_firewall_l3_firewall_rules" "test" {
 // NetworksApplianceL3FirewallRulesResourceConfigCreateChecks returns the test check functions for NetworksApplianceL3FirewallRulesResourceConfigCreate
 func NetworksApplianceL3FirewallRulesResourceConfigCreateChecks() resource.TestCheckFunc {
 	expectedAttrs := map[string]string{
+		"rules.#":                "2",
 		"rules.0.comment":        "Allow TCP traffic to subnet with HTTP servers.",
 		"rules.0.policy":         "allow",
 		"rules.0.protocol":       "tcp",
@@ -94,6 +95,8 @@ func NetworksApplianceL3FirewallRulesResourceConfigCreateChecks() resource.TestC
 		"rules.0.src_port":       "Any",
 		"rules.0.src_cidr":       "Any",
 		"rules.0.syslog_enabled": "false",
+		"rules.1.comment":        "Default rule",
+		"rules.1.policy":         "allow",
 	}
 	return utils.ResourceTestCheck("meraki_networks_appliance_firewall_l3_firewall_rules.test", expectedAttrs)
 }
@@ -115,16 +118,6 @@ resource "meraki_networks_appliance_firewall_l3_firewall_rules" "test" {
         src_port = "Any"
         src_cidr = "Any"
         syslog_enabled = false
-    },
-    {
-        comment =  "Default rule"
-        policy = "allow"
-        protocol = "Any"
-        dest_port = "Any"
-        dest_cidr = "Any"
-        src_port = "Any"
-        src_cidr = "Any"
-        syslog_enabled = false
     }
 
     ]
@@ -139,6 +132,7 @@ resource "meraki_networks_appliance_firewall_l3_firewall_rules" "test" {
 // NetworksApplianceL3FirewallRulesResourceConfigUpdateChecks returns the test check functions for NetworksApplianceL3FirewallRulesResourceConfigUpdate
 func NetworksApplianceL3FirewallRulesResourceConfigUpdateChecks() resource.TestCheckFunc {
 	expectedAttrs := map[string]string{
+		"rules.#":                "1",
 		"rules.0.comment":        "Allow TCP traffic to subnet with HTTP servers.",
 		"rules.0.policy":         "deny",
 		"rules.0.protocol":       "tcp",
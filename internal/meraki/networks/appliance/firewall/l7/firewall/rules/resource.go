@@ -35,18 +35,18 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Configure(ctx context
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *NetworksApplianceFirewallL7FirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -75,12 +75,9 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Create(ctx context.Co
 
 	updateNetworkApplianceFirewallL7FirewallRules.SetRules(rules)
 
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL7FirewallRulesRequest(updateNetworkApplianceFirewallL7FirewallRules).Execute()
+	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL7FirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL7FirewallRulesRequest(updateNetworkApplianceFirewallL7FirewallRules).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -124,12 +121,9 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Read(ctx context.Cont
 		return
 	}
 
-	_, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString()).Execute()
+	_, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceFirewallL7FirewallRules(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -190,12 +184,9 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Update(ctx context.Co
 
 	updateNetworkApplianceFirewallL7FirewallRules.SetRules(rules)
 
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL7FirewallRulesRequest(updateNetworkApplianceFirewallL7FirewallRules).Execute()
+	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL7FirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL7FirewallRulesRequest(updateNetworkApplianceFirewallL7FirewallRules).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -244,12 +235,9 @@ func (r *NetworksApplianceFirewallL7FirewallRulesResource) Delete(ctx context.Co
 	updateNetworkApplianceFirewallL7FirewallRules := *openApiClient.NewUpdateNetworkApplianceFirewallL7FirewallRulesRequest()
 	updateNetworkApplianceFirewallL7FirewallRules.SetRules(rules)
 
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL7FirewallRulesRequest(updateNetworkApplianceFirewallL7FirewallRules).Execute()
+	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceFirewallL7FirewallRules(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallL7FirewallRulesRequest(updateNetworkApplianceFirewallL7FirewallRules).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var DatasourceSchema = schema.Schema{
+	MarkdownDescription: "Get the 1:Many NAT rules of a network appliance",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The 1:Many NAT rules, in order",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"public_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address that is used to access the internal resource from the WAN",
+						Computed:            true,
+					},
+					"uplink": schema.StringAttribute{
+						MarkdownDescription: "The physical WAN interface on which the traffic will arrive ('internet1' or 'internet2')",
+						Computed:            true,
+					},
+					"port_rules": schema.ListNestedAttribute{
+						MarkdownDescription: "An array of associated forwarding rules",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "A description of the rule",
+									Computed:            true,
+								},
+								"protocol": schema.StringAttribute{
+									MarkdownDescription: "'tcp' or 'udp'",
+									Computed:            true,
+								},
+								"public_port": schema.StringAttribute{
+									MarkdownDescription: "Destination port of the traffic that is arriving on the WAN",
+									Computed:            true,
+								},
+								"local_ip": schema.StringAttribute{
+									MarkdownDescription: "Local IP address to which traffic is forwarded",
+									Computed:            true,
+								},
+								"local_port": schema.StringAttribute{
+									MarkdownDescription: "Destination port of the forwarded traffic that is sent from the MX to the specified host on the LAN",
+									Computed:            true,
+								},
+								"allowed_ips": schema.ListAttribute{
+									MarkdownDescription: "Remote IP addresses or CIDRs that are allowed to make inbound connections, or 'any'",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
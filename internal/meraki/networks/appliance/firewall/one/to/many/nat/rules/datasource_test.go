@@ -0,0 +1,52 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworksApplianceFirewallOneToManyNatRulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_one_to_many_nat_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_firewall_one_to_many_nat_rules"),
+			},
+
+			// Read 1:Many NAT Rules
+			{
+				Config: NetworksApplianceFirewallOneToManyNatRulesDataSourceConfigRead(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_many_nat_rules.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_many_nat_rules.test", "rules.0.public_ip", "146.11.11.13"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_many_nat_rules.test", "rules.0.port_rules.#", "2"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_many_nat_rules.test", "rules.0.port_rules.0.public_port", "80"),
+				),
+			},
+		},
+	})
+}
+
+func NetworksApplianceFirewallOneToManyNatRulesDataSourceConfigRead() string {
+	return fmt.Sprintf(`
+	%s
+
+data "meraki_networks_appliance_firewall_one_to_many_nat_rules" "test" {
+	depends_on = [resource.meraki_networks_appliance_firewall_one_to_many_nat_rules.test]
+	network_id = resource.meraki_network.test.network_id
+}
+	`,
+		NetworksApplianceOneToManyNatRulesResourceConfig("80"),
+	)
+}
@@ -0,0 +1,210 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// rulesPayload creates the request payload that replaces the 1:Many NAT rules of a network.
+func rulesPayload(ctx context.Context, data *OneToManyNatRulesModel) (openApiClient.UpdateNetworkApplianceFirewallOneToManyNatRulesRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := *openApiClient.NewUpdateNetworkApplianceFirewallOneToManyNatRulesRequest([]openApiClient.UpdateNetworkApplianceFirewallOneToManyNatRulesRequestRulesInner{})
+
+	var rules []OneToManyNatRuleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	apiRules := apiOneToManyNatRules{Rules: []apiOneToManyNatRule{}}
+	for _, rule := range rules {
+		var portRules []PortRuleModel
+		diags.Append(rule.PortRules.ElementsAs(ctx, &portRules, false)...)
+
+		apiRule := apiOneToManyNatRule{
+			PublicIp:  rule.PublicIp.ValueString(),
+			Uplink:    rule.Uplink.ValueString(),
+			PortRules: []apiPortRule{},
+		}
+		for _, portRule := range portRules {
+			allowedIps := []string{}
+			diags.Append(portRule.AllowedIps.ElementsAs(ctx, &allowedIps, false)...)
+
+			apiRule.PortRules = append(apiRule.PortRules, apiPortRule{
+				Name:       portRule.Name.ValueString(),
+				Protocol:   portRule.Protocol.ValueString(),
+				PublicPort: portRule.PublicPort.ValueString(),
+				LocalIp:    portRule.LocalIp.ValueString(),
+				LocalPort:  portRule.LocalPort.ValueString(),
+				AllowedIps: allowedIps,
+			})
+		}
+		apiRules.Rules = append(apiRules.Rules, apiRule)
+	}
+
+	if err := utils.ConvertJSON(apiRules, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the 1:Many NAT rules payload: %s", err))
+	}
+	return payload, diags
+}
+
+// readRules sets data from the 1:Many NAT rules returned by the Dashboard API.
+func readRules(ctx context.Context, data *OneToManyNatRulesModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiRules apiOneToManyNatRules
+	if err := utils.ConvertJSON(response, &apiRules); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the 1:Many NAT rules: %s", err))
+		return diags
+	}
+
+	rules := make([]OneToManyNatRuleModel, 0, len(apiRules.Rules))
+	for _, rule := range apiRules.Rules {
+		portRules := make([]PortRuleModel, 0, len(rule.PortRules))
+		for _, portRule := range rule.PortRules {
+			allowedIps := portRule.AllowedIps
+			if allowedIps == nil {
+				allowedIps = []string{}
+			}
+			allowedIpsList, listDiags := types.ListValueFrom(ctx, types.StringType, allowedIps)
+			diags.Append(listDiags...)
+
+			portRules = append(portRules, PortRuleModel{
+				Name:       types.StringValue(portRule.Name),
+				Protocol:   types.StringValue(portRule.Protocol),
+				PublicPort: types.StringValue(portRule.PublicPort),
+				LocalIp:    types.StringValue(portRule.LocalIp),
+				LocalPort:  types.StringValue(portRule.LocalPort),
+				AllowedIps: allowedIpsList,
+			})
+		}
+
+		portRulesList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: portRuleAttrTypes()}, portRules)
+		diags.Append(listDiags...)
+
+		rules = append(rules, OneToManyNatRuleModel{
+			PublicIp:  types.StringValue(rule.PublicIp),
+			Uplink:    types.StringValue(rule.Uplink),
+			PortRules: portRulesList,
+		})
+	}
+
+	var listDiags diag.Diagnostics
+	data.Rules, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	diags.Append(listDiags...)
+	data.Id = types.StringValue(data.NetworkId.ValueString())
+
+	return diags
+}
+
+// validateRules checks that each public IP of an uplink is mapped by a single rule, and that the port rules of each
+// mapping forward single, distinct public ports.
+func validateRules(ctx context.Context, data *OneToManyNatRulesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return diags
+	}
+
+	var rules []OneToManyNatRuleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	publicIps := map[string]int{}
+
+	for i, rule := range rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		if !rule.PublicIp.IsUnknown() && !rule.Uplink.IsUnknown() {
+			key := rule.Uplink.ValueString() + "/" + rule.PublicIp.ValueString()
+			if j, ok := publicIps[key]; ok {
+				diags.AddAttributeError(rulePath.AtName("public_ip"), "Conflicting 1:Many NAT Rules",
+					fmt.Sprintf("public IP %s of uplink %s is already mapped by rules[%d]", rule.PublicIp.ValueString(), rule.Uplink.ValueString(), j))
+			} else {
+				publicIps[key] = i
+			}
+		}
+
+		if rule.PortRules.IsNull() || rule.PortRules.IsUnknown() {
+			continue
+		}
+
+		var portRules []PortRuleModel
+		diags.Append(rule.PortRules.ElementsAs(ctx, &portRules, false)...)
+		diags.Append(validatePortRules(ctx, rulePath.AtName("port_rules"), portRules)...)
+	}
+
+	return diags
+}
+
+// validatePortRules checks the ports and allowed IPs of the port rules of a mapping, and that no two of them forward
+// the same public port.
+func validatePortRules(ctx context.Context, portRulesPath path.Path, portRules []PortRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	publicPorts := map[string]int{}
+
+	for i, portRule := range portRules {
+		portRulePath := portRulesPath.AtListIndex(i)
+
+		if !portRule.AllowedIps.IsNull() && !portRule.AllowedIps.IsUnknown() {
+			var allowedIps []types.String
+			diags.Append(portRule.AllowedIps.ElementsAs(ctx, &allowedIps, false)...)
+			for j, allowedIp := range allowedIps {
+				if allowedIp.IsUnknown() {
+					continue
+				}
+				if err := utils.ValidateAllowedIp(allowedIp.ValueString()); err != nil {
+					diags.AddAttributeError(portRulePath.AtName("allowed_ips").AtListIndex(j), "Invalid Allowed IP", err.Error())
+				}
+			}
+		}
+
+		if !portRule.LocalPort.IsUnknown() {
+			if _, err := parseSinglePort(portRule.LocalPort.ValueString()); err != nil {
+				diags.AddAttributeError(portRulePath.AtName("local_port"), "Invalid 1:Many NAT Rule", err.Error())
+			}
+		}
+
+		if portRule.PublicPort.IsUnknown() {
+			continue
+		}
+		publicPort, err := parseSinglePort(portRule.PublicPort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(portRulePath.AtName("public_port"), "Invalid 1:Many NAT Rule", err.Error())
+			continue
+		}
+
+		if portRule.Protocol.IsUnknown() {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", portRule.Protocol.ValueString(), publicPort)
+		if j, ok := publicPorts[key]; ok {
+			diags.AddAttributeError(portRulePath.AtName("public_port"), "Conflicting 1:Many NAT Rules",
+				fmt.Sprintf("%s port %s is already forwarded by port_rules[%d]", portRule.Protocol.ValueString(), portRule.PublicPort.ValueString(), j))
+		} else {
+			publicPorts[key] = i
+		}
+	}
+
+	return diags
+}
+
+// parseSinglePort parses a port, rejecting the port ranges that 1:Many NAT does not support.
+func parseSinglePort(port string) (int64, error) {
+	from, to, err := utils.ParsePortRange(port)
+	if err != nil {
+		return 0, err
+	}
+	if from != to {
+		return 0, fmt.Errorf("%q is a port range: 1:Many NAT port rules forward a single port", port)
+	}
+	return from, nil
+}
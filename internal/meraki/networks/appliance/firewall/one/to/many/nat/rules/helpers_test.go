@@ -0,0 +1,139 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testPortRule(protocol, publicPort, localPort string, allowedIps ...string) PortRuleModel {
+	if len(allowedIps) == 0 {
+		allowedIps = []string{"any"}
+	}
+	ips, _ := types.ListValueFrom(context.Background(), types.StringType, allowedIps)
+
+	return PortRuleModel{
+		Name:       types.StringValue("rule"),
+		Protocol:   types.StringValue(protocol),
+		PublicPort: types.StringValue(publicPort),
+		LocalIp:    types.StringValue("192.168.128.1"),
+		LocalPort:  types.StringValue(localPort),
+		AllowedIps: ips,
+	}
+}
+
+func testRule(publicIp, uplink string, portRules ...PortRuleModel) OneToManyNatRuleModel {
+	list, _ := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: portRuleAttrTypes()}, portRules)
+
+	return OneToManyNatRuleModel{
+		PublicIp:  types.StringValue(publicIp),
+		Uplink:    types.StringValue(uplink),
+		PortRules: list,
+	}
+}
+
+func testRules(t *testing.T, rules ...OneToManyNatRuleModel) *OneToManyNatRulesModel {
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	require.False(t, diags.HasError(), diags)
+
+	return &OneToManyNatRulesModel{
+		NetworkId: types.StringValue("N_123"),
+		Rules:     list,
+	}
+}
+
+func TestReadRules(t *testing.T) {
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"rules": [{
+			"publicIp": "146.11.11.13",
+			"uplink": "internet1",
+			"portRules": [
+				{"name": "Rule 1", "protocol": "tcp", "publicPort": "9443", "localIp": "192.168.128.1", "localPort": "443", "allowedIps": ["any"]},
+				{"name": "Rule 2", "protocol": "udp", "publicPort": "9000", "localIp": "192.168.128.2", "localPort": "9000"}
+			]
+		}]
+	}`), &response))
+
+	data := &OneToManyNatRulesModel{NetworkId: types.StringValue("N_123")}
+	diags := readRules(context.Background(), data, response)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_123", data.Id.ValueString())
+
+	var rules []OneToManyNatRuleModel
+	require.False(t, data.Rules.ElementsAs(context.Background(), &rules, false).HasError())
+	require.Len(t, rules, 1)
+	assert.Equal(t, "146.11.11.13", rules[0].PublicIp.ValueString())
+
+	var portRules []PortRuleModel
+	require.False(t, rules[0].PortRules.ElementsAs(context.Background(), &portRules, false).HasError())
+	require.Len(t, portRules, 2)
+	assert.Equal(t, "9443", portRules[0].PublicPort.ValueString())
+	assert.False(t, portRules[1].AllowedIps.IsNull())
+	assert.Empty(t, portRules[1].AllowedIps.Elements())
+}
+
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []OneToManyNatRuleModel
+		summary string
+	}{
+		{
+			name: "valid",
+			rules: []OneToManyNatRuleModel{
+				testRule("146.11.11.13", "internet1",
+					testPortRule("tcp", "80", "8080"),
+					testPortRule("udp", "80", "8080"),
+				),
+				testRule("146.11.11.13", "internet2", testPortRule("tcp", "80", "80", "10.0.0.0/8")),
+			},
+		},
+		{
+			name: "duplicate public ip",
+			rules: []OneToManyNatRuleModel{
+				testRule("146.11.11.13", "internet1", testPortRule("tcp", "80", "80")),
+				testRule("146.11.11.13", "internet1", testPortRule("tcp", "81", "81")),
+			},
+			summary: "Conflicting 1:Many NAT Rules",
+		},
+		{
+			name: "duplicate public port",
+			rules: []OneToManyNatRuleModel{
+				testRule("146.11.11.13", "internet1", testPortRule("tcp", "80", "80"), testPortRule("tcp", "080", "8080")),
+			},
+			summary: "Conflicting 1:Many NAT Rules",
+		},
+		{
+			name:    "public port range",
+			rules:   []OneToManyNatRuleModel{testRule("146.11.11.13", "internet1", testPortRule("tcp", "80-81", "80"))},
+			summary: "Invalid 1:Many NAT Rule",
+		},
+		{
+			name:    "invalid local port",
+			rules:   []OneToManyNatRuleModel{testRule("146.11.11.13", "internet1", testPortRule("tcp", "80", "0"))},
+			summary: "Invalid 1:Many NAT Rule",
+		},
+		{
+			name:    "invalid allowed ip",
+			rules:   []OneToManyNatRuleModel{testRule("146.11.11.13", "internet1", testPortRule("tcp", "80", "80", "10.0.0.0/33"))},
+			summary: "Invalid Allowed IP",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := validateRules(context.Background(), testRules(t, test.rules...))
+			if test.summary == "" {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, test.summary, diags.Errors()[0].Summary())
+		})
+	}
+}
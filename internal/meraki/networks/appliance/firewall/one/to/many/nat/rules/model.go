@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OneToManyNatRulesModel describes the resource and data source data model.
+type OneToManyNatRulesModel struct {
+	Id        types.String `tfsdk:"id"`
+	NetworkId types.String `tfsdk:"network_id"`
+	Rules     types.List   `tfsdk:"rules"`
+}
+
+type OneToManyNatRuleModel struct {
+	PublicIp  types.String `tfsdk:"public_ip"`
+	Uplink    types.String `tfsdk:"uplink"`
+	PortRules types.List   `tfsdk:"port_rules"`
+}
+
+type PortRuleModel struct {
+	Name       types.String `tfsdk:"name"`
+	Protocol   types.String `tfsdk:"protocol"`
+	PublicPort types.String `tfsdk:"public_port"`
+	LocalIp    types.String `tfsdk:"local_ip"`
+	LocalPort  types.String `tfsdk:"local_port"`
+	AllowedIps types.List   `tfsdk:"allowed_ips"`
+}
+
+func portRuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"protocol":    types.StringType,
+		"public_port": types.StringType,
+		"local_ip":    types.StringType,
+		"local_port":  types.StringType,
+		"allowed_ips": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func ruleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"public_ip":  types.StringType,
+		"uplink":     types.StringType,
+		"port_rules": types.ListType{ElemType: types.ObjectType{AttrTypes: portRuleAttrTypes()}},
+	}
+}
+
+// apiOneToManyNatRules is the 1:Many NAT rules of a network in the format of the Dashboard API.
+type apiOneToManyNatRules struct {
+	Rules []apiOneToManyNatRule `json:"rules"`
+}
+
+type apiOneToManyNatRule struct {
+	PublicIp  string        `json:"publicIp"`
+	Uplink    string        `json:"uplink"`
+	PortRules []apiPortRule `json:"portRules"`
+}
+
+type apiPortRule struct {
+	Name       string   `json:"name,omitempty"`
+	Protocol   string   `json:"protocol"`
+	PublicPort string   `json:"publicPort"`
+	LocalIp    string   `json:"localIp"`
+	LocalPort  string   `json:"localPort"`
+	AllowedIps []string `json:"allowedIps"`
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ResourceSchema = schema.Schema{
+	MarkdownDescription: "Manage the 1:Many NAT rules of a network appliance. The rules replace any 1:Many NAT rules configured in the Dashboard.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The 1:Many NAT rules, in order",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"public_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address that will be used to access the internal resource from the WAN",
+						Required:            true,
+						Validators: []validator.String{
+							utils.IPv4AddressValidator(),
+						},
+					},
+					"uplink": schema.StringAttribute{
+						MarkdownDescription: "The physical WAN interface on which the traffic will arrive ('internet1' or, if available, 'internet2')",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("internet1", "internet2"),
+						},
+					},
+					"port_rules": schema.ListNestedAttribute{
+						MarkdownDescription: "An array of associated forwarding rules",
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "A description of the rule",
+									Optional:            true,
+									Computed:            true,
+								},
+								"protocol": schema.StringAttribute{
+									MarkdownDescription: "'tcp' or 'udp'",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("tcp", "udp"),
+									},
+								},
+								"public_port": schema.StringAttribute{
+									MarkdownDescription: "Destination port of the traffic that is arriving on the WAN",
+									Required:            true,
+								},
+								"local_ip": schema.StringAttribute{
+									MarkdownDescription: "Local IP address to which traffic will be forwarded",
+									Required:            true,
+									Validators: []validator.String{
+										utils.IPv4AddressValidator(),
+									},
+								},
+								"local_port": schema.StringAttribute{
+									MarkdownDescription: "Destination port of the forwarded traffic that will be sent from the MX to the specified host on the LAN",
+									Required:            true,
+								},
+								"allowed_ips": schema.ListAttribute{
+									MarkdownDescription: "Remote IP addresses or CIDRs that are allowed to make inbound connections, or 'any'. Defaults to `[\"any\"]`.",
+									ElementType:         types.StringType,
+									Optional:            true,
+									Computed:            true,
+									Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("any")})),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
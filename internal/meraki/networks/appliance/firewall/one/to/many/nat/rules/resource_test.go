@@ -0,0 +1,101 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworksApplianceFirewallOneToManyNatRulesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_one_to_many_nat_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_firewall_one_to_many_nat_rules"),
+			},
+
+			// Create and Read 1:Many NAT Rules.
+			{
+				Config: NetworksApplianceOneToManyNatRulesResourceConfig("80"),
+				Check:  NetworksApplianceOneToManyNatRulesResourceConfigChecks("80"),
+			},
+
+			// Update and Read 1:Many NAT Rules.
+			{
+				Config: NetworksApplianceOneToManyNatRulesResourceConfig("8080"),
+				Check:  NetworksApplianceOneToManyNatRulesResourceConfigChecks("8080"),
+			},
+
+			{
+				ResourceName:      "meraki_networks_appliance_firewall_one_to_many_nat_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceOneToManyNatRulesResourceConfig(publicPort string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_firewall_one_to_many_nat_rules" "test" {
+    depends_on = [resource.meraki_network.test]
+    network_id = resource.meraki_network.test.network_id
+    rules = [
+    {
+        public_ip = "146.11.11.13"
+        uplink = "internet1"
+        port_rules = [
+        {
+            name = "Rule 1"
+            protocol = "tcp"
+            public_port = "%s"
+            local_ip = "192.168.128.1"
+            local_port = "80"
+            allowed_ips = ["10.82.112.0/24"]
+        },
+        {
+            name = "Rule 2"
+            protocol = "udp"
+            public_port = "9000"
+            local_ip = "192.168.128.2"
+            local_port = "9000"
+        }
+        ]
+    }
+    ]
+}
+	`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_one_to_many_nat_rules"),
+		publicPort,
+	)
+}
+
+// NetworksApplianceOneToManyNatRulesResourceConfigChecks returns the test check functions for NetworksApplianceOneToManyNatRulesResourceConfig
+func NetworksApplianceOneToManyNatRulesResourceConfigChecks(publicPort string) resource.TestCheckFunc {
+	expectedAttrs := map[string]string{
+		"rules.#":                            "1",
+		"rules.0.public_ip":                  "146.11.11.13",
+		"rules.0.uplink":                     "internet1",
+		"rules.0.port_rules.#":               "2",
+		"rules.0.port_rules.0.name":          "Rule 1",
+		"rules.0.port_rules.0.protocol":      "tcp",
+		"rules.0.port_rules.0.public_port":   publicPort,
+		"rules.0.port_rules.0.local_ip":      "192.168.128.1",
+		"rules.0.port_rules.0.local_port":    "80",
+		"rules.0.port_rules.0.allowed_ips.0": "10.82.112.0/24",
+		"rules.0.port_rules.1.protocol":      "udp",
+		"rules.0.port_rules.1.allowed_ips.0": "any",
+	}
+	return utils.ResourceTestCheck("meraki_networks_appliance_firewall_one_to_many_nat_rules.test", expectedAttrs)
+}
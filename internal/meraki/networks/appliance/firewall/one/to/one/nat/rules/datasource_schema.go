@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var DatasourceSchema = schema.Schema{
+	MarkdownDescription: "Get the 1:1 NAT rules of a network appliance",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The 1:1 NAT rules, in order",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "A descriptive name for the rule",
+						Computed:            true,
+					},
+					"public_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address that is used to access the internal resource from the WAN",
+						Computed:            true,
+					},
+					"lan_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address of the server or device that hosts the internal resource that is made available on the WAN",
+						Computed:            true,
+					},
+					"uplink": schema.StringAttribute{
+						MarkdownDescription: "The physical WAN interface on which the traffic will arrive ('internet1' or 'internet2')",
+						Computed:            true,
+					},
+					"allowed_inbound": schema.ListNestedAttribute{
+						MarkdownDescription: "The ports this mapping provides access on, and the remote IPs that are allowed access to the resource",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"protocol": schema.StringAttribute{
+									MarkdownDescription: "Either of the following: 'tcp', 'udp', 'icmp-ping' or 'any'",
+									Computed:            true,
+								},
+								"destination_ports": schema.ListAttribute{
+									MarkdownDescription: "An array of ports or port ranges that are forwarded to the host on the LAN, or 'any'",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+								"allowed_ips": schema.ListAttribute{
+									MarkdownDescription: "The WAN IP addresses or CIDRs that are allowed to make inbound connections, or 'any'",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
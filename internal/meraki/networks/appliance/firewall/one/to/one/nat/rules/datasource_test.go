@@ -0,0 +1,52 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworksApplianceFirewallOneToOneNatRulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_one_to_one_nat_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_firewall_one_to_one_nat_rules"),
+			},
+
+			// Read 1:1 NAT Rules
+			{
+				Config: NetworksApplianceFirewallOneToOneNatRulesDataSourceConfigRead(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_one_nat_rules.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_one_nat_rules.test", "rules.0.public_ip", "146.11.11.13"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_one_nat_rules.test", "rules.0.allowed_inbound.#", "2"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_one_to_one_nat_rules.test", "rules.0.allowed_inbound.0.destination_ports.0", "80"),
+				),
+			},
+		},
+	})
+}
+
+func NetworksApplianceFirewallOneToOneNatRulesDataSourceConfigRead() string {
+	return fmt.Sprintf(`
+	%s
+
+data "meraki_networks_appliance_firewall_one_to_one_nat_rules" "test" {
+	depends_on = [resource.meraki_networks_appliance_firewall_one_to_one_nat_rules.test]
+	network_id = resource.meraki_network.test.network_id
+}
+	`,
+		NetworksApplianceOneToOneNatRulesResourceConfig("80"),
+	)
+}
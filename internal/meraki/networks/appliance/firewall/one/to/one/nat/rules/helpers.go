@@ -0,0 +1,206 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"strings"
+)
+
+// rulesPayload creates the request payload that replaces the 1:1 NAT rules of a network.
+func rulesPayload(ctx context.Context, data *OneToOneNatRulesModel) (openApiClient.UpdateNetworkApplianceFirewallOneToOneNatRulesRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := *openApiClient.NewUpdateNetworkApplianceFirewallOneToOneNatRulesRequest([]openApiClient.UpdateNetworkApplianceFirewallOneToOneNatRulesRequestRulesInner{})
+
+	var rules []OneToOneNatRuleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	apiRules := apiOneToOneNatRules{Rules: []apiOneToOneNatRule{}}
+	for _, rule := range rules {
+		var allowedInbound []AllowedInboundModel
+		diags.Append(rule.AllowedInbound.ElementsAs(ctx, &allowedInbound, false)...)
+
+		apiRule := apiOneToOneNatRule{
+			Name:           rule.Name.ValueString(),
+			PublicIp:       rule.PublicIp.ValueString(),
+			LanIp:          rule.LanIp.ValueString(),
+			Uplink:         rule.Uplink.ValueString(),
+			AllowedInbound: []apiAllowedInbound{},
+		}
+		for _, inbound := range allowedInbound {
+			destinationPorts := []string{}
+			diags.Append(inbound.DestinationPorts.ElementsAs(ctx, &destinationPorts, false)...)
+			allowedIps := []string{}
+			diags.Append(inbound.AllowedIps.ElementsAs(ctx, &allowedIps, false)...)
+
+			apiRule.AllowedInbound = append(apiRule.AllowedInbound, apiAllowedInbound{
+				Protocol:         inbound.Protocol.ValueString(),
+				DestinationPorts: destinationPorts,
+				AllowedIps:       allowedIps,
+			})
+		}
+		apiRules.Rules = append(apiRules.Rules, apiRule)
+	}
+
+	if err := utils.ConvertJSON(apiRules, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the 1:1 NAT rules payload: %s", err))
+	}
+	return payload, diags
+}
+
+// readRules sets data from the 1:1 NAT rules returned by the Dashboard API.
+func readRules(ctx context.Context, data *OneToOneNatRulesModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiRules apiOneToOneNatRules
+	if err := utils.ConvertJSON(response, &apiRules); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the 1:1 NAT rules: %s", err))
+		return diags
+	}
+
+	rules := make([]OneToOneNatRuleModel, 0, len(apiRules.Rules))
+	for _, rule := range apiRules.Rules {
+		allowedInbound := make([]AllowedInboundModel, 0, len(rule.AllowedInbound))
+		for _, inbound := range rule.AllowedInbound {
+			destinationPorts, listDiags := types.ListValueFrom(ctx, types.StringType, nonNil(inbound.DestinationPorts))
+			diags.Append(listDiags...)
+			allowedIps, listDiags := types.ListValueFrom(ctx, types.StringType, nonNil(inbound.AllowedIps))
+			diags.Append(listDiags...)
+
+			allowedInbound = append(allowedInbound, AllowedInboundModel{
+				Protocol:         types.StringValue(inbound.Protocol),
+				DestinationPorts: destinationPorts,
+				AllowedIps:       allowedIps,
+			})
+		}
+
+		inboundList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: allowedInboundAttrTypes()}, allowedInbound)
+		diags.Append(listDiags...)
+
+		rules = append(rules, OneToOneNatRuleModel{
+			Name:           types.StringValue(rule.Name),
+			PublicIp:       types.StringValue(rule.PublicIp),
+			LanIp:          types.StringValue(rule.LanIp),
+			Uplink:         types.StringValue(rule.Uplink),
+			AllowedInbound: inboundList,
+		})
+	}
+
+	var listDiags diag.Diagnostics
+	data.Rules, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	diags.Append(listDiags...)
+	data.Id = types.StringValue(data.NetworkId.ValueString())
+
+	return diags
+}
+
+// nonNil returns values, or an empty slice when the Dashboard API omitted the list.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// validateRules checks that each public IP and LAN IP is mapped by a single rule, and that the inbound connections
+// each rule allows use ports that are valid for their protocol.
+func validateRules(ctx context.Context, data *OneToOneNatRulesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return diags
+	}
+
+	var rules []OneToOneNatRuleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	publicIps := map[string]int{}
+	lanIps := map[string]int{}
+
+	for i, rule := range rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		if !rule.PublicIp.IsUnknown() && !rule.PublicIp.IsNull() {
+			if j, ok := publicIps[rule.PublicIp.ValueString()]; ok {
+				diags.AddAttributeError(rulePath.AtName("public_ip"), "Conflicting 1:1 NAT Rules",
+					fmt.Sprintf("public IP %s is already mapped by rules[%d]", rule.PublicIp.ValueString(), j))
+			} else {
+				publicIps[rule.PublicIp.ValueString()] = i
+			}
+		}
+		if !rule.LanIp.IsUnknown() && !rule.LanIp.IsNull() {
+			if j, ok := lanIps[rule.LanIp.ValueString()]; ok {
+				diags.AddAttributeError(rulePath.AtName("lan_ip"), "Conflicting 1:1 NAT Rules",
+					fmt.Sprintf("LAN IP %s is already mapped by rules[%d]", rule.LanIp.ValueString(), j))
+			} else {
+				lanIps[rule.LanIp.ValueString()] = i
+			}
+		}
+
+		if rule.AllowedInbound.IsNull() || rule.AllowedInbound.IsUnknown() {
+			continue
+		}
+
+		var allowedInbound []AllowedInboundModel
+		diags.Append(rule.AllowedInbound.ElementsAs(ctx, &allowedInbound, false)...)
+		for j, inbound := range allowedInbound {
+			diags.Append(validateAllowedInbound(ctx, rulePath.AtName("allowed_inbound").AtListIndex(j), inbound)...)
+		}
+	}
+
+	return diags
+}
+
+// validateAllowedInbound checks the destination ports and allowed IPs of an inbound connection. ICMP ping and 'any'
+// protocol connections are not port based, so their destination ports must be 'any'.
+func validateAllowedInbound(ctx context.Context, inboundPath path.Path, inbound AllowedInboundModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !inbound.AllowedIps.IsNull() && !inbound.AllowedIps.IsUnknown() {
+		var allowedIps []types.String
+		diags.Append(inbound.AllowedIps.ElementsAs(ctx, &allowedIps, false)...)
+		for i, allowedIp := range allowedIps {
+			if allowedIp.IsUnknown() {
+				continue
+			}
+			if err := utils.ValidateAllowedIp(allowedIp.ValueString()); err != nil {
+				diags.AddAttributeError(inboundPath.AtName("allowed_ips").AtListIndex(i), "Invalid Allowed IP", err.Error())
+			}
+		}
+	}
+
+	if inbound.Protocol.IsUnknown() || inbound.DestinationPorts.IsNull() || inbound.DestinationPorts.IsUnknown() {
+		return diags
+	}
+
+	var destinationPorts []types.String
+	diags.Append(inbound.DestinationPorts.ElementsAs(ctx, &destinationPorts, false)...)
+	for i, port := range destinationPorts {
+		if port.IsUnknown() || strings.EqualFold(port.ValueString(), "any") {
+			continue
+		}
+
+		portPath := inboundPath.AtName("destination_ports").AtListIndex(i)
+		switch protocol := inbound.Protocol.ValueString(); protocol {
+		case "icmp-ping", "any":
+			diags.AddAttributeError(portPath, "Invalid 1:1 NAT Rule",
+				fmt.Sprintf("destination_ports must be [\"any\"] when protocol is '%s'", protocol))
+		default:
+			if _, _, err := utils.ParsePortRange(port.ValueString()); err != nil {
+				diags.AddAttributeError(portPath, "Invalid 1:1 NAT Rule", err.Error())
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testInbound(protocol string, destinationPorts []string, allowedIps ...string) AllowedInboundModel {
+	if len(allowedIps) == 0 {
+		allowedIps = []string{"any"}
+	}
+	ports, _ := types.ListValueFrom(context.Background(), types.StringType, destinationPorts)
+	ips, _ := types.ListValueFrom(context.Background(), types.StringType, allowedIps)
+
+	return AllowedInboundModel{
+		Protocol:         types.StringValue(protocol),
+		DestinationPorts: ports,
+		AllowedIps:       ips,
+	}
+}
+
+func testRule(publicIp, lanIp string, allowedInbound ...AllowedInboundModel) OneToOneNatRuleModel {
+	inbound, _ := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: allowedInboundAttrTypes()}, allowedInbound)
+
+	return OneToOneNatRuleModel{
+		Name:           types.StringValue("rule"),
+		PublicIp:       types.StringValue(publicIp),
+		LanIp:          types.StringValue(lanIp),
+		Uplink:         types.StringValue("internet1"),
+		AllowedInbound: inbound,
+	}
+}
+
+func testRules(t *testing.T, rules ...OneToOneNatRuleModel) *OneToOneNatRulesModel {
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	require.False(t, diags.HasError(), diags)
+
+	return &OneToOneNatRulesModel{
+		NetworkId: types.StringValue("N_123"),
+		Rules:     list,
+	}
+}
+
+func TestReadRules(t *testing.T) {
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"rules": [
+			{
+				"name": "Service behind NAT",
+				"lanIp": "192.168.128.22",
+				"publicIp": "146.11.11.13",
+				"uplink": "internet1",
+				"allowedInbound": [{"protocol": "icmp-ping", "destinationPorts": ["any"], "allowedIps": ["any"]}]
+			},
+			{"name": "No inbound", "lanIp": "192.168.128.23", "publicIp": "146.11.11.14", "uplink": "internet2"}
+		]
+	}`), &response))
+
+	data := &OneToOneNatRulesModel{NetworkId: types.StringValue("N_123")}
+	diags := readRules(context.Background(), data, response)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_123", data.Id.ValueString())
+
+	var rules []OneToOneNatRuleModel
+	require.False(t, data.Rules.ElementsAs(context.Background(), &rules, false).HasError())
+	require.Len(t, rules, 2)
+	assert.Equal(t, "146.11.11.13", rules[0].PublicIp.ValueString())
+
+	var inbound []AllowedInboundModel
+	require.False(t, rules[0].AllowedInbound.ElementsAs(context.Background(), &inbound, false).HasError())
+	require.Len(t, inbound, 1)
+	assert.Equal(t, "icmp-ping", inbound[0].Protocol.ValueString())
+
+	assert.False(t, rules[1].AllowedInbound.IsNull())
+	assert.Empty(t, rules[1].AllowedInbound.Elements())
+}
+
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []OneToOneNatRuleModel
+		summary string
+	}{
+		{
+			name: "valid",
+			rules: []OneToOneNatRuleModel{
+				testRule("146.11.11.13", "192.168.128.22",
+					testInbound("tcp", []string{"80", "8080-8090"}, "10.82.112.0/24", "any"),
+					testInbound("icmp-ping", []string{"any"}),
+				),
+				testRule("146.11.11.14", "192.168.128.23"),
+			},
+		},
+		{
+			name: "duplicate public ip",
+			rules: []OneToOneNatRuleModel{
+				testRule("146.11.11.13", "192.168.128.22"),
+				testRule("146.11.11.13", "192.168.128.23"),
+			},
+			summary: "Conflicting 1:1 NAT Rules",
+		},
+		{
+			name: "duplicate lan ip",
+			rules: []OneToOneNatRuleModel{
+				testRule("146.11.11.13", "192.168.128.22"),
+				testRule("146.11.11.14", "192.168.128.22"),
+			},
+			summary: "Conflicting 1:1 NAT Rules",
+		},
+		{
+			name:    "ports for icmp",
+			rules:   []OneToOneNatRuleModel{testRule("146.11.11.13", "192.168.128.22", testInbound("icmp-ping", []string{"80"}))},
+			summary: "Invalid 1:1 NAT Rule",
+		},
+		{
+			name:    "invalid port",
+			rules:   []OneToOneNatRuleModel{testRule("146.11.11.13", "192.168.128.22", testInbound("udp", []string{"70000"}))},
+			summary: "Invalid 1:1 NAT Rule",
+		},
+		{
+			name:    "invalid allowed ip",
+			rules:   []OneToOneNatRuleModel{testRule("146.11.11.13", "192.168.128.22", testInbound("tcp", []string{"any"}, "example.com"))},
+			summary: "Invalid Allowed IP",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := validateRules(context.Background(), testRules(t, test.rules...))
+			if test.summary == "" {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, test.summary, diags.Errors()[0].Summary())
+		})
+	}
+}
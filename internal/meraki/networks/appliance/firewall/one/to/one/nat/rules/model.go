@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OneToOneNatRulesModel describes the resource and data source data model.
+type OneToOneNatRulesModel struct {
+	Id        types.String `tfsdk:"id"`
+	NetworkId types.String `tfsdk:"network_id"`
+	Rules     types.List   `tfsdk:"rules"`
+}
+
+type OneToOneNatRuleModel struct {
+	Name           types.String `tfsdk:"name"`
+	PublicIp       types.String `tfsdk:"public_ip"`
+	LanIp          types.String `tfsdk:"lan_ip"`
+	Uplink         types.String `tfsdk:"uplink"`
+	AllowedInbound types.List   `tfsdk:"allowed_inbound"`
+}
+
+type AllowedInboundModel struct {
+	Protocol         types.String `tfsdk:"protocol"`
+	DestinationPorts types.List   `tfsdk:"destination_ports"`
+	AllowedIps       types.List   `tfsdk:"allowed_ips"`
+}
+
+func allowedInboundAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"protocol":          types.StringType,
+		"destination_ports": types.ListType{ElemType: types.StringType},
+		"allowed_ips":       types.ListType{ElemType: types.StringType},
+	}
+}
+
+func ruleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":            types.StringType,
+		"public_ip":       types.StringType,
+		"lan_ip":          types.StringType,
+		"uplink":          types.StringType,
+		"allowed_inbound": types.ListType{ElemType: types.ObjectType{AttrTypes: allowedInboundAttrTypes()}},
+	}
+}
+
+// apiOneToOneNatRules is the 1:1 NAT rules of a network in the format of the Dashboard API.
+type apiOneToOneNatRules struct {
+	Rules []apiOneToOneNatRule `json:"rules"`
+}
+
+type apiOneToOneNatRule struct {
+	Name           string              `json:"name,omitempty"`
+	PublicIp       string              `json:"publicIp"`
+	LanIp          string              `json:"lanIp"`
+	Uplink         string              `json:"uplink"`
+	AllowedInbound []apiAllowedInbound `json:"allowedInbound"`
+}
+
+type apiAllowedInbound struct {
+	Protocol         string   `json:"protocol"`
+	DestinationPorts []string `json:"destinationPorts"`
+	AllowedIps       []string `json:"allowedIps"`
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ResourceSchema = schema.Schema{
+	MarkdownDescription: "Manage the 1:1 NAT rules of a network appliance. The rules replace any 1:1 NAT rules configured in the Dashboard.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The 1:1 NAT rules, in order",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "A descriptive name for the rule",
+						Optional:            true,
+						Computed:            true,
+					},
+					"public_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address that will be used to access the internal resource from the WAN",
+						Required:            true,
+						Validators: []validator.String{
+							utils.IPv4AddressValidator(),
+						},
+					},
+					"lan_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address of the server or device that hosts the internal resource that you wish to make available on the WAN",
+						Required:            true,
+						Validators: []validator.String{
+							utils.IPv4AddressValidator(),
+						},
+					},
+					"uplink": schema.StringAttribute{
+						MarkdownDescription: "The physical WAN interface on which the traffic will arrive ('internet1' or, if available, 'internet2')",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("internet1", "internet2"),
+						},
+					},
+					"allowed_inbound": schema.ListNestedAttribute{
+						MarkdownDescription: "The ports this mapping will provide access on, and the remote IPs that will be allowed access to the resource. Defaults to no inbound access.",
+						Optional:            true,
+						Computed:            true,
+						Default:             listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: allowedInboundAttrTypes()}, []attr.Value{})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"protocol": schema.StringAttribute{
+									MarkdownDescription: "Either of the following: 'tcp', 'udp', 'icmp-ping' or 'any'",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("tcp", "udp", "icmp-ping", "any"),
+									},
+								},
+								"destination_ports": schema.ListAttribute{
+									MarkdownDescription: "An array of ports or port ranges that will be forwarded to the host on the LAN, or 'any'. Must be `[\"any\"]` for the 'icmp-ping' and 'any' protocols. Defaults to `[\"any\"]`.",
+									ElementType:         types.StringType,
+									Optional:            true,
+									Computed:            true,
+									Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("any")})),
+								},
+								"allowed_ips": schema.ListAttribute{
+									MarkdownDescription: "The WAN IP addresses or CIDRs that are allowed to make inbound connections, or 'any'. Defaults to `[\"any\"]`.",
+									ElementType:         types.StringType,
+									Optional:            true,
+									Computed:            true,
+									Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("any")})),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
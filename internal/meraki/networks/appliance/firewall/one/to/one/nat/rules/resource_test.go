@@ -0,0 +1,96 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworksApplianceFirewallOneToOneNatRulesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_one_to_one_nat_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_firewall_one_to_one_nat_rules"),
+			},
+
+			// Create and Read 1:1 NAT Rules.
+			{
+				Config: NetworksApplianceOneToOneNatRulesResourceConfig("80"),
+				Check:  NetworksApplianceOneToOneNatRulesResourceConfigChecks("80"),
+			},
+
+			// Update and Read 1:1 NAT Rules.
+			{
+				Config: NetworksApplianceOneToOneNatRulesResourceConfig("8080-8090"),
+				Check:  NetworksApplianceOneToOneNatRulesResourceConfigChecks("8080-8090"),
+			},
+
+			{
+				ResourceName:      "meraki_networks_appliance_firewall_one_to_one_nat_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceOneToOneNatRulesResourceConfig(destinationPort string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_firewall_one_to_one_nat_rules" "test" {
+    depends_on = [resource.meraki_network.test]
+    network_id = resource.meraki_network.test.network_id
+    rules = [
+    {
+        name = "Service behind NAT"
+        public_ip = "146.11.11.13"
+        lan_ip = "192.168.128.22"
+        uplink = "internet1"
+        allowed_inbound = [
+        {
+            protocol = "tcp"
+            destination_ports = ["%s"]
+            allowed_ips = ["10.82.112.0/24"]
+        },
+        {
+            protocol = "icmp-ping"
+        }
+        ]
+    }
+    ]
+}
+	`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_one_to_one_nat_rules"),
+		destinationPort,
+	)
+}
+
+// NetworksApplianceOneToOneNatRulesResourceConfigChecks returns the test check functions for NetworksApplianceOneToOneNatRulesResourceConfig
+func NetworksApplianceOneToOneNatRulesResourceConfigChecks(destinationPort string) resource.TestCheckFunc {
+	expectedAttrs := map[string]string{
+		"rules.#":                            "1",
+		"rules.0.name":                       "Service behind NAT",
+		"rules.0.public_ip":                  "146.11.11.13",
+		"rules.0.lan_ip":                     "192.168.128.22",
+		"rules.0.uplink":                     "internet1",
+		"rules.0.allowed_inbound.#":          "2",
+		"rules.0.allowed_inbound.0.protocol": "tcp",
+		"rules.0.allowed_inbound.0.destination_ports.0": destinationPort,
+		"rules.0.allowed_inbound.0.allowed_ips.0":       "10.82.112.0/24",
+		"rules.0.allowed_inbound.1.protocol":            "icmp-ping",
+		"rules.0.allowed_inbound.1.destination_ports.0": "any",
+		"rules.0.allowed_inbound.1.allowed_ips.0":       "any",
+	}
+	return utils.ResourceTestCheck("meraki_networks_appliance_firewall_one_to_one_nat_rules.test", expectedAttrs)
+}
@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var DatasourceSchema = schema.Schema{
+	MarkdownDescription: "Get the port forwarding rules of a network appliance",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The port forwarding rules, in order",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "A descriptive name for the rule",
+						Computed:            true,
+					},
+					"lan_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address of the server or device that hosts the internal resource that is made available on the WAN",
+						Computed:            true,
+					},
+					"uplink": schema.StringAttribute{
+						MarkdownDescription: "The physical WAN interface on which the traffic will arrive ('internet1', 'internet2' or 'both')",
+						Computed:            true,
+					},
+					"public_port": schema.StringAttribute{
+						MarkdownDescription: "A port or port range that is forwarded to the host on the LAN",
+						Computed:            true,
+					},
+					"local_port": schema.StringAttribute{
+						MarkdownDescription: "A port or port range that receives the forwarded traffic from the WAN",
+						Computed:            true,
+					},
+					"allowed_ips": schema.ListAttribute{
+						MarkdownDescription: "The WAN IP addresses or CIDRs that are allowed to make inbound connections on the public ports, or 'any'",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "'tcp' or 'udp'",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,52 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworksApplianceFirewallPortForwardingRulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_port_forwarding_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_firewall_port_forwarding_rules"),
+			},
+
+			// Read Port Forwarding Rules
+			{
+				Config: NetworksApplianceFirewallPortForwardingRulesDataSourceConfigRead(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_port_forwarding_rules.test", "rules.#", "2"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_port_forwarding_rules.test", "rules.0.name", "Web server"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_port_forwarding_rules.test", "rules.0.public_port", "443"),
+					resource.TestCheckResourceAttr("data.meraki_networks_appliance_firewall_port_forwarding_rules.test", "rules.1.protocol", "udp"),
+				),
+			},
+		},
+	})
+}
+
+func NetworksApplianceFirewallPortForwardingRulesDataSourceConfigRead() string {
+	return fmt.Sprintf(`
+	%s
+
+data "meraki_networks_appliance_firewall_port_forwarding_rules" "test" {
+	depends_on = [resource.meraki_networks_appliance_firewall_port_forwarding_rules.test]
+	network_id = resource.meraki_network.test.network_id
+}
+	`,
+		NetworksAppliancePortForwardingRulesResourceConfig("443", "any"),
+	)
+}
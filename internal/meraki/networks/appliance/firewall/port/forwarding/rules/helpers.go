@@ -0,0 +1,184 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// rulesPayload creates the request payload that replaces the port forwarding rules of a network.
+func rulesPayload(ctx context.Context, data *PortForwardingRulesModel) (openApiClient.UpdateNetworkApplianceFirewallPortForwardingRulesRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := *openApiClient.NewUpdateNetworkApplianceFirewallPortForwardingRulesRequest([]openApiClient.UpdateNetworkApplianceFirewallPortForwardingRulesRequestRulesInner{})
+
+	var rules []PortForwardingRuleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	apiRules := apiPortForwardingRules{Rules: []apiPortForwardingRule{}}
+	for _, rule := range rules {
+		allowedIps := []string{}
+		diags.Append(rule.AllowedIps.ElementsAs(ctx, &allowedIps, false)...)
+
+		apiRules.Rules = append(apiRules.Rules, apiPortForwardingRule{
+			Name:       rule.Name.ValueString(),
+			LanIp:      rule.LanIp.ValueString(),
+			Uplink:     rule.Uplink.ValueString(),
+			PublicPort: rule.PublicPort.ValueString(),
+			LocalPort:  rule.LocalPort.ValueString(),
+			AllowedIps: allowedIps,
+			Protocol:   rule.Protocol.ValueString(),
+		})
+	}
+
+	if err := utils.ConvertJSON(apiRules, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the port forwarding rules payload: %s", err))
+	}
+	return payload, diags
+}
+
+// readRules sets data from the port forwarding rules returned by the Dashboard API.
+func readRules(ctx context.Context, data *PortForwardingRulesModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiRules apiPortForwardingRules
+	if err := utils.ConvertJSON(response, &apiRules); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the port forwarding rules: %s", err))
+		return diags
+	}
+
+	rules := make([]PortForwardingRuleModel, 0, len(apiRules.Rules))
+	for _, rule := range apiRules.Rules {
+		allowedIps, listDiags := types.ListValueFrom(ctx, types.StringType, rule.AllowedIps)
+		diags.Append(listDiags...)
+
+		rules = append(rules, PortForwardingRuleModel{
+			Name:       types.StringValue(rule.Name),
+			LanIp:      types.StringValue(rule.LanIp),
+			Uplink:     types.StringValue(rule.Uplink),
+			PublicPort: types.StringValue(rule.PublicPort),
+			LocalPort:  types.StringValue(rule.LocalPort),
+			AllowedIps: allowedIps,
+			Protocol:   types.StringValue(rule.Protocol),
+		})
+	}
+
+	var listDiags diag.Diagnostics
+	data.Rules, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	diags.Append(listDiags...)
+	data.Id = types.StringValue(data.NetworkId.ValueString())
+
+	return diags
+}
+
+// forwardedPorts is the uplinks, protocol and public ports a port forwarding rule receives traffic on.
+type forwardedPorts struct {
+	uplinks  map[string]bool
+	protocol string
+	from, to int64
+}
+
+// overlaps reports whether two rules would receive the same traffic.
+func (p forwardedPorts) overlaps(other forwardedPorts) bool {
+	if p.protocol != other.protocol || p.from > other.to || other.from > p.to {
+		return false
+	}
+	for uplink := range p.uplinks {
+		if other.uplinks[uplink] {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleUplinks returns the uplinks a rule receives traffic on. Rules arrive on both uplinks unless one is set.
+func ruleUplinks(uplink types.String) map[string]bool {
+	switch uplink.ValueString() {
+	case "internet1", "internet2":
+		return map[string]bool{uplink.ValueString(): true}
+	default:
+		return map[string]bool{"internet1": true, "internet2": true}
+	}
+}
+
+// validateRules checks the port ranges and allowed IPs of each rule, and that no two rules forward the same public
+// ports of an uplink.
+func validateRules(ctx context.Context, data *PortForwardingRulesModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return diags
+	}
+
+	var rules []PortForwardingRuleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var forwarded []forwardedPorts
+	var forwardedRules []int
+
+	for i, rule := range rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		if !rule.AllowedIps.IsNull() && !rule.AllowedIps.IsUnknown() {
+			var allowedIps []types.String
+			diags.Append(rule.AllowedIps.ElementsAs(ctx, &allowedIps, false)...)
+			for j, allowedIp := range allowedIps {
+				if allowedIp.IsUnknown() {
+					continue
+				}
+				if err := utils.ValidateAllowedIp(allowedIp.ValueString()); err != nil {
+					diags.AddAttributeError(rulePath.AtName("allowed_ips").AtListIndex(j), "Invalid Allowed IP", err.Error())
+				}
+			}
+		}
+
+		if rule.PublicPort.IsUnknown() || rule.LocalPort.IsUnknown() {
+			continue
+		}
+
+		publicFrom, publicTo, err := utils.ParsePortRange(rule.PublicPort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(rulePath.AtName("public_port"), "Invalid Port Forwarding Rule", err.Error())
+			continue
+		}
+		localFrom, localTo, err := utils.ParsePortRange(rule.LocalPort.ValueString())
+		if err != nil {
+			diags.AddAttributeError(rulePath.AtName("local_port"), "Invalid Port Forwarding Rule", err.Error())
+			continue
+		}
+		if publicTo-publicFrom != localTo-localFrom {
+			diags.AddAttributeError(rulePath.AtName("local_port"), "Invalid Port Forwarding Rule",
+				fmt.Sprintf("public_port %s and local_port %s must span the same number of ports", rule.PublicPort.ValueString(), rule.LocalPort.ValueString()))
+		}
+
+		if rule.Protocol.IsUnknown() || rule.Uplink.IsUnknown() {
+			continue
+		}
+
+		ports := forwardedPorts{
+			uplinks:  ruleUplinks(rule.Uplink),
+			protocol: rule.Protocol.ValueString(),
+			from:     publicFrom,
+			to:       publicTo,
+		}
+		for j, other := range forwarded {
+			if ports.overlaps(other) {
+				diags.AddAttributeError(rulePath.AtName("public_port"), "Conflicting Port Forwarding Rules",
+					fmt.Sprintf("rules[%d] already forwards %s port %s on the same uplink", forwardedRules[j], ports.protocol, rules[forwardedRules[j]].PublicPort.ValueString()))
+			}
+		}
+		forwarded = append(forwarded, ports)
+		forwardedRules = append(forwardedRules, i)
+	}
+
+	return diags
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testRule(publicPort, localPort, protocol, uplink string, allowedIps ...string) PortForwardingRuleModel {
+	if len(allowedIps) == 0 {
+		allowedIps = []string{"any"}
+	}
+	ips, _ := types.ListValueFrom(context.Background(), types.StringType, allowedIps)
+
+	return PortForwardingRuleModel{
+		Name:       types.StringValue("rule"),
+		LanIp:      types.StringValue("192.168.128.10"),
+		Uplink:     types.StringValue(uplink),
+		PublicPort: types.StringValue(publicPort),
+		LocalPort:  types.StringValue(localPort),
+		AllowedIps: ips,
+		Protocol:   types.StringValue(protocol),
+	}
+}
+
+func testRules(t *testing.T, rules ...PortForwardingRuleModel) *PortForwardingRulesModel {
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	require.False(t, diags.HasError(), diags)
+
+	return &PortForwardingRulesModel{
+		NetworkId: types.StringValue("N_123"),
+		Rules:     list,
+	}
+}
+
+func TestReadRules(t *testing.T) {
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"rules": [{
+			"lanIp": "192.168.128.10",
+			"name": "Web server",
+			"uplink": "internet1",
+			"publicPort": "443",
+			"localPort": "8443",
+			"allowedIps": ["any"],
+			"protocol": "tcp"
+		}]
+	}`), &response))
+
+	data := &PortForwardingRulesModel{NetworkId: types.StringValue("N_123")}
+	diags := readRules(context.Background(), data, response)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_123", data.Id.ValueString())
+
+	var rules []PortForwardingRuleModel
+	require.False(t, data.Rules.ElementsAs(context.Background(), &rules, false).HasError())
+	require.Len(t, rules, 1)
+	assert.Equal(t, "Web server", rules[0].Name.ValueString())
+	assert.Equal(t, "internet1", rules[0].Uplink.ValueString())
+	assert.Equal(t, "8443", rules[0].LocalPort.ValueString())
+	assert.Equal(t, 1, len(rules[0].AllowedIps.Elements()))
+}
+
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []PortForwardingRuleModel
+		summary string
+	}{
+		{
+			name: "valid",
+			rules: []PortForwardingRuleModel{
+				testRule("80", "8080", "tcp", "internet1"),
+				testRule("80", "8080", "udp", "internet1"),
+				testRule("80", "8080", "tcp", "internet2"),
+				testRule("81-90", "81-90", "tcp", "both", "10.0.0.0/8"),
+			},
+		},
+		{
+			name:    "invalid allowed ip",
+			rules:   []PortForwardingRuleModel{testRule("80", "80", "tcp", "both", "10.0.0.300")},
+			summary: "Invalid Allowed IP",
+		},
+		{
+			name:    "invalid port",
+			rules:   []PortForwardingRuleModel{testRule("80-70", "80", "tcp", "both")},
+			summary: "Invalid Port Forwarding Rule",
+		},
+		{
+			name:    "range size mismatch",
+			rules:   []PortForwardingRuleModel{testRule("80-81", "80", "tcp", "both")},
+			summary: "Invalid Port Forwarding Rule",
+		},
+		{
+			name: "overlapping public ports",
+			rules: []PortForwardingRuleModel{
+				testRule("8000-8010", "8000-8010", "tcp", "internet1"),
+				testRule("8010", "80", "tcp", "both"),
+			},
+			summary: "Conflicting Port Forwarding Rules",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := validateRules(context.Background(), testRules(t, test.rules...))
+			if test.summary == "" {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, test.summary, diags.Errors()[0].Summary())
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PortForwardingRulesModel describes the resource and data source data model.
+type PortForwardingRulesModel struct {
+	Id        types.String `tfsdk:"id"`
+	NetworkId types.String `tfsdk:"network_id"`
+	Rules     types.List   `tfsdk:"rules"`
+}
+
+type PortForwardingRuleModel struct {
+	Name       types.String `tfsdk:"name"`
+	LanIp      types.String `tfsdk:"lan_ip"`
+	Uplink     types.String `tfsdk:"uplink"`
+	PublicPort types.String `tfsdk:"public_port"`
+	LocalPort  types.String `tfsdk:"local_port"`
+	AllowedIps types.List   `tfsdk:"allowed_ips"`
+	Protocol   types.String `tfsdk:"protocol"`
+}
+
+func ruleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"lan_ip":      types.StringType,
+		"uplink":      types.StringType,
+		"public_port": types.StringType,
+		"local_port":  types.StringType,
+		"allowed_ips": types.ListType{ElemType: types.StringType},
+		"protocol":    types.StringType,
+	}
+}
+
+// apiPortForwardingRules is the port forwarding rules of a network in the format of the Dashboard API.
+type apiPortForwardingRules struct {
+	Rules []apiPortForwardingRule `json:"rules"`
+}
+
+type apiPortForwardingRule struct {
+	Name       string   `json:"name,omitempty"`
+	LanIp      string   `json:"lanIp"`
+	Uplink     string   `json:"uplink,omitempty"`
+	PublicPort string   `json:"publicPort"`
+	LocalPort  string   `json:"localPort"`
+	AllowedIps []string `json:"allowedIps"`
+	Protocol   string   `json:"protocol"`
+}
@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ResourceSchema = schema.Schema{
+	MarkdownDescription: "Manage the port forwarding rules of a network appliance. The rules replace any port forwarding rules configured in the Dashboard.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The port forwarding rules, in order",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "A descriptive name for the rule",
+						Optional:            true,
+						Computed:            true,
+					},
+					"lan_ip": schema.StringAttribute{
+						MarkdownDescription: "The IP address of the server or device that hosts the internal resource that you wish to make available on the WAN",
+						Required:            true,
+						Validators: []validator.String{
+							utils.IPv4AddressValidator(),
+						},
+					},
+					"uplink": schema.StringAttribute{
+						MarkdownDescription: "The physical WAN interface on which the traffic will arrive ('internet1' or, if available, 'internet2' or 'both')",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("internet1", "internet2", "both"),
+						},
+					},
+					"public_port": schema.StringAttribute{
+						MarkdownDescription: "A port or port range that will be forwarded to the host on the LAN, such as '8080' or '8080-8090'",
+						Required:            true,
+					},
+					"local_port": schema.StringAttribute{
+						MarkdownDescription: "A port or port range that will receive the forwarded traffic from the WAN. A range must span the same number of ports as `public_port`.",
+						Required:            true,
+					},
+					"allowed_ips": schema.ListAttribute{
+						MarkdownDescription: "The WAN IP addresses or CIDRs that are allowed to make inbound connections on the public ports, or 'any'. Defaults to `[\"any\"]`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("any")})),
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "'tcp' or 'udp'",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("tcp", "udp"),
+						},
+					},
+				},
+			},
+		},
+	},
+}
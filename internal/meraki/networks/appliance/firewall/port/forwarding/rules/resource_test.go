@@ -0,0 +1,96 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworksApplianceFirewallPortForwardingRulesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_port_forwarding_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_firewall_port_forwarding_rules"),
+			},
+
+			// Create and Read Port Forwarding Rules.
+			{
+				Config: NetworksAppliancePortForwardingRulesResourceConfig("443", "any"),
+				Check:  NetworksAppliancePortForwardingRulesResourceConfigChecks("443", "any"),
+			},
+
+			// Update and Read Port Forwarding Rules.
+			{
+				Config: NetworksAppliancePortForwardingRulesResourceConfig("8443", "10.0.0.0/8"),
+				Check:  NetworksAppliancePortForwardingRulesResourceConfigChecks("8443", "10.0.0.0/8"),
+			},
+
+			{
+				ResourceName:      "meraki_networks_appliance_firewall_port_forwarding_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksAppliancePortForwardingRulesResourceConfig(publicPort, allowedIp string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_firewall_port_forwarding_rules" "test" {
+    depends_on = [resource.meraki_network.test]
+    network_id = resource.meraki_network.test.network_id
+    rules = [
+    {
+        name = "Web server"
+        lan_ip = "192.168.128.10"
+        uplink = "both"
+        public_port = "%s"
+        local_port = "443"
+        allowed_ips = ["%s"]
+        protocol = "tcp"
+    },
+    {
+        name = "Game server"
+        lan_ip = "192.168.128.11"
+        uplink = "internet1"
+        public_port = "27015-27016"
+        local_port = "27015-27016"
+        protocol = "udp"
+    }
+    ]
+}
+	`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_firewall_port_forwarding_rules"),
+		publicPort, allowedIp,
+	)
+}
+
+// NetworksAppliancePortForwardingRulesResourceConfigChecks returns the test check functions for NetworksAppliancePortForwardingRulesResourceConfig
+func NetworksAppliancePortForwardingRulesResourceConfigChecks(publicPort, allowedIp string) resource.TestCheckFunc {
+	expectedAttrs := map[string]string{
+		"rules.#":               "2",
+		"rules.0.name":          "Web server",
+		"rules.0.lan_ip":        "192.168.128.10",
+		"rules.0.uplink":        "both",
+		"rules.0.public_port":   publicPort,
+		"rules.0.local_port":    "443",
+		"rules.0.allowed_ips.0": allowedIp,
+		"rules.0.protocol":      "tcp",
+		"rules.1.public_port":   "27015-27016",
+		"rules.1.allowed_ips.0": "any",
+		"rules.1.protocol":      "udp",
+	}
+	return utils.ResourceTestCheck("meraki_networks_appliance_firewall_port_forwarding_rules.test", expectedAttrs)
+}
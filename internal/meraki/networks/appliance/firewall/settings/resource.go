@@ -34,18 +34,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -65,12 +65,9 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	spoofingProtection.SetIpSourceGuard(ipSourceGuard)
 	updateNetworksApplianceFirewallSettings.SetSpoofingProtection(spoofingProtection)
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceFirewallSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallSettingsRequest(updateNetworksApplianceFirewallSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceFirewallSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallSettingsRequest(updateNetworksApplianceFirewallSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -115,12 +112,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.SettingsApi.GetNetworkApplianceFirewallSettings(context.Background(), data.NetworkId.ValueString()).Execute()
+	_, httpResp, err := r.client.SettingsApi.GetNetworkApplianceFirewallSettings(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -174,12 +168,9 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	spoofingProtection.SetIpSourceGuard(ipSourceGuard)
 	updateNetworksApplianceFirewallSettings.SetSpoofingProtection(spoofingProtection)
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceFirewallSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallSettingsRequest(updateNetworksApplianceFirewallSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceFirewallSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallSettingsRequest(updateNetworksApplianceFirewallSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -231,12 +222,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	spoofingProtection.SetIpSourceGuard(ipSourceGuard)
 	updateNetworksApplianceFirewallSettings.SetSpoofingProtection(spoofingProtection)
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceFirewallSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallSettingsRequest(updateNetworksApplianceFirewallSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceFirewallSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceFirewallSettingsRequest(updateNetworksApplianceFirewallSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -33,18 +33,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -83,12 +83,9 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		payload.DropUntaggedTraffic = data.Dropuntaggedtraffic.ValueBoolPointer()
 	}
 
-	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkAppliancePort(context.Background(), data.NetworkId.ValueString(), data.PortId.ValueString()).UpdateNetworkAppliancePortRequest(payload).Execute()
+	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkAppliancePort(ctx, data.NetworkId.ValueString(), data.PortId.ValueString()).UpdateNetworkAppliancePortRequest(payload).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -130,12 +127,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	response, httpResp, err := r.client.ApplianceApi.GetNetworkAppliancePort(context.Background(), data.NetworkId.ValueString(), data.PortId.ValueString()).Execute()
+	response, httpResp, err := r.client.ApplianceApi.GetNetworkAppliancePort(ctx, data.NetworkId.ValueString(), data.PortId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 	}
 
 	if httpResp.StatusCode != 200 {
@@ -203,12 +197,9 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		payload.DropUntaggedTraffic = data.Dropuntaggedtraffic.ValueBoolPointer()
 	}
 
-	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkAppliancePort(context.Background(), data.NetworkId.ValueString(), data.PortId.ValueString()).UpdateNetworkAppliancePortRequest(payload).Execute()
+	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkAppliancePort(ctx, data.NetworkId.ValueString(), data.PortId.ValueString()).UpdateNetworkAppliancePortRequest(payload).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -276,12 +267,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		payload.DropUntaggedTraffic = data.Dropuntaggedtraffic.ValueBoolPointer()
 	}
 
-	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkAppliancePort(context.Background(), data.NetworkId.ValueString(), data.PortId.ValueString()).UpdateNetworkAppliancePortRequest(payload).Execute()
+	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkAppliancePort(ctx, data.NetworkId.ValueString(), data.PortId.ValueString()).UpdateNetworkAppliancePortRequest(payload).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
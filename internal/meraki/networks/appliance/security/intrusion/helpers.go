@@ -0,0 +1,177 @@
+package intrusion
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/netip"
+	"strings"
+)
+
+// intrusionPayload returns the intrusion payload for the planned data. Protected networks are only sent when they
+// are configured, since they apply to appliances in passthrough mode only.
+func intrusionPayload(ctx context.Context, data *resourceModel) (openApiClient.UpdateNetworkApplianceSecurityIntrusionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateNetworkApplianceSecurityIntrusionRequest()
+	payload.SetMode(data.Mode.ValueString())
+	if utils.IsKnown(data.IdsRulesets) {
+		payload.SetIdsRulesets(data.IdsRulesets.ValueString())
+	}
+
+	if utils.IsKnown(data.ProtectedNetworks) {
+		var networks protectedNetworksModel
+		diags.Append(data.ProtectedNetworks.As(ctx, &networks, basetypes.ObjectAsOptions{})...)
+
+		protectedNetworks := *openApiClient.NewUpdateNetworkApplianceSecurityIntrusionRequestProtectedNetworks()
+		protectedNetworks.SetUseDefault(networks.UseDefault.ValueBool())
+		if utils.IsKnown(networks.IncludedCidr) {
+			diags.Append(networks.IncludedCidr.ElementsAs(ctx, &protectedNetworks.IncludedCidr, false)...)
+		}
+		if utils.IsKnown(networks.ExcludedCidr) {
+			diags.Append(networks.ExcludedCidr.ElementsAs(ctx, &protectedNetworks.ExcludedCidr, false)...)
+		}
+		payload.SetProtectedNetworks(protectedNetworks)
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that restores the default intrusion settings of a network: intrusion detection
+// disabled with the balanced ruleset, and the default protected networks when they were managed.
+func resetPayload(data *resourceModel) openApiClient.UpdateNetworkApplianceSecurityIntrusionRequest {
+	payload := *openApiClient.NewUpdateNetworkApplianceSecurityIntrusionRequest()
+	payload.SetMode("disabled")
+	payload.SetIdsRulesets("balanced")
+
+	if utils.IsKnown(data.ProtectedNetworks) {
+		protectedNetworks := *openApiClient.NewUpdateNetworkApplianceSecurityIntrusionRequestProtectedNetworks()
+		protectedNetworks.SetUseDefault(true)
+		payload.SetProtectedNetworks(protectedNetworks)
+	}
+
+	return payload
+}
+
+// readIntrusion sets data from the intrusion settings returned by the Dashboard API.
+func readIntrusion(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var intrusion apiIntrusion
+	if err := utils.ConvertJSON(response, &intrusion); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the intrusion settings: %s", err))
+		return diags
+	}
+
+	data.Id = data.NetworkId
+	data.Mode = types.StringValue(intrusion.Mode)
+	data.IdsRulesets = types.StringValue(intrusion.IdsRulesets)
+
+	data.ProtectedNetworks = types.ObjectNull(protectedNetworksAttrTypes())
+	if intrusion.ProtectedNetworks == nil {
+		return diags
+	}
+
+	networks := protectedNetworksModel{
+		UseDefault:   types.BoolPointerValue(intrusion.ProtectedNetworks.UseDefault),
+		IncludedCidr: types.ListNull(types.StringType),
+		ExcludedCidr: types.ListNull(types.StringType),
+	}
+
+	// The custom networks are kept by the Dashboard but ignored while the default networks are used
+	if !networks.UseDefault.ValueBool() {
+		var listDiags diag.Diagnostics
+		networks.IncludedCidr, listDiags = stringList(ctx, intrusion.ProtectedNetworks.IncludedCidr)
+		diags.Append(listDiags...)
+		networks.ExcludedCidr, listDiags = stringList(ctx, intrusion.ProtectedNetworks.ExcludedCidr)
+		diags.Append(listDiags...)
+	}
+
+	var objectDiags diag.Diagnostics
+	data.ProtectedNetworks, objectDiags = types.ObjectValueFrom(ctx, protectedNetworksAttrTypes(), networks)
+	diags.Append(objectDiags...)
+
+	return diags
+}
+
+// validateIntrusion checks at plan time that custom protected networks are only configured without the default
+// networks, and that they are IP addresses or CIDRs.
+func validateIntrusion(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.ProtectedNetworks) {
+		return diags
+	}
+
+	var networks protectedNetworksModel
+	diags.Append(data.ProtectedNetworks.As(ctx, &networks, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || networks.UseDefault.IsUnknown() {
+		return diags
+	}
+
+	networksPath := path.Root("protected_networks")
+	cidrLists := []struct {
+		name  string
+		cidrs types.List
+	}{
+		{"included_cidr", networks.IncludedCidr},
+		{"excluded_cidr", networks.ExcludedCidr},
+	}
+
+	if networks.UseDefault.ValueBool() {
+		for _, list := range cidrLists {
+			if !list.cidrs.IsNull() {
+				diags.AddAttributeError(networksPath.AtName(list.name), "Invalid Protected Networks",
+					fmt.Sprintf("%s is only used when use_default is false", list.name))
+			}
+		}
+		return diags
+	}
+
+	if networks.IncludedCidr.IsNull() || (!networks.IncludedCidr.IsUnknown() && len(networks.IncludedCidr.Elements()) == 0) {
+		diags.AddAttributeError(networksPath.AtName("included_cidr"), "Invalid Protected Networks",
+			"included_cidr must list the protected networks when use_default is false")
+	}
+
+	for _, list := range cidrLists {
+		if !utils.IsKnown(list.cidrs) {
+			continue
+		}
+		var values []types.String
+		diags.Append(list.cidrs.ElementsAs(ctx, &values, false)...)
+		for i, value := range values {
+			if !utils.IsKnown(value) {
+				continue
+			}
+			if err := validateCidr(value.ValueString()); err != nil {
+				diags.AddAttributeError(networksPath.AtName(list.name).AtListIndex(i), "Invalid Protected Networks", err.Error())
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateCidr checks that value is an IPv4 address or CIDR.
+func validateCidr(value string) error {
+	if strings.Contains(value, "/") {
+		if prefix, err := netip.ParsePrefix(value); err == nil && prefix.Addr().Is4() {
+			return nil
+		}
+	} else if addr, err := netip.ParseAddr(value); err == nil && addr.Is4() {
+		return nil
+	}
+	return fmt.Errorf("%q is not an IPv4 address or CIDR", value)
+}
+
+func stringList(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
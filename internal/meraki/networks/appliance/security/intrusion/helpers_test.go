@@ -0,0 +1,158 @@
+package intrusion
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testList(values []string) types.List {
+	if values == nil {
+		return types.ListNull(types.StringType)
+	}
+	var elements []attr.Value
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func testResourceModel(useDefault bool, included, excluded []string) resourceModel {
+	return resourceModel{
+		Id:          types.StringUnknown(),
+		NetworkId:   types.StringValue("N_1"),
+		Mode:        types.StringValue("prevention"),
+		IdsRulesets: types.StringUnknown(),
+		ProtectedNetworks: types.ObjectValueMust(protectedNetworksAttrTypes(), map[string]attr.Value{
+			"use_default":   types.BoolValue(useDefault),
+			"included_cidr": testList(included),
+			"excluded_cidr": testList(excluded),
+		}),
+	}
+}
+
+func TestIntrusionPayload(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: An unknown ruleset is left out and the configured protected networks are sent
+	data := testResourceModel(false, []string{"10.0.0.0/8"}, []string{"10.0.0.1"})
+	payload, diags := intrusionPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"mode": "prevention", "protectedNetworks": {"useDefault": false, "includedCidr": ["10.0.0.0/8"], "excludedCidr": ["10.0.0.1"]}}`, string(body))
+
+	// Test case: Protected networks are left out when they are not configured
+	data.IdsRulesets = types.StringValue("security")
+	data.ProtectedNetworks = types.ObjectUnknown(protectedNetworksAttrTypes())
+	payload, diags = intrusionPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err = json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"mode": "prevention", "idsRulesets": "security"}`, string(body))
+}
+
+func TestResetPayload(t *testing.T) {
+	// Test case: The protected networks of appliances in passthrough mode are reset to the defaults
+	data := testResourceModel(false, []string{"10.0.0.0/8"}, nil)
+	require.NotNil(t, resetPayload(&data).ProtectedNetworks)
+	assert.True(t, resetPayload(&data).ProtectedNetworks.GetUseDefault())
+
+	// Test case: The protected networks of appliances in routed mode are not reset
+	data.ProtectedNetworks = types.ObjectNull(protectedNetworksAttrTypes())
+	assert.Nil(t, resetPayload(&data).ProtectedNetworks)
+}
+
+func TestReadIntrusion(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Custom protected networks
+	data := testResourceModel(false, nil, nil)
+	response := map[string]interface{}{
+		"mode":        "detection",
+		"idsRulesets": "balanced",
+		"protectedNetworks": map[string]interface{}{
+			"useDefault":   false,
+			"includedCidr": []interface{}{"10.0.0.0/8", "192.168.0.0/16"},
+			"excludedCidr": []interface{}{"10.0.0.1"},
+		},
+	}
+	require.False(t, readIntrusion(ctx, &data, response).HasError())
+
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	assert.Equal(t, "detection", data.Mode.ValueString())
+	assert.Equal(t, "balanced", data.IdsRulesets.ValueString())
+	assert.Equal(t, testResourceModel(false, []string{"10.0.0.0/8", "192.168.0.0/16"}, []string{"10.0.0.1"}).ProtectedNetworks, data.ProtectedNetworks)
+
+	// Test case: The custom networks are ignored while the default networks are used
+	response["protectedNetworks"].(map[string]interface{})["useDefault"] = true
+	require.False(t, readIntrusion(ctx, &data, response).HasError())
+	assert.Equal(t, testResourceModel(true, nil, nil).ProtectedNetworks, data.ProtectedNetworks)
+
+	// Test case: Appliances in routed mode have no protected networks
+	delete(response, "protectedNetworks")
+	require.False(t, readIntrusion(ctx, &data, response).HasError())
+	assert.True(t, data.ProtectedNetworks.IsNull())
+}
+
+func TestValidateIntrusion(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		useDefault bool
+		included   []string
+		excluded   []string
+		err        bool
+	}{
+		{
+			name:       "default networks",
+			useDefault: true,
+		},
+		{
+			name:       "custom networks with the default networks",
+			useDefault: true,
+			excluded:   []string{"10.0.0.1"},
+			err:        true,
+		},
+		{
+			name: "no included networks",
+			err:  true,
+		},
+		{
+			name:     "invalid cidr",
+			included: []string{"10.0.0.0/33"},
+			err:      true,
+		},
+		{
+			name:     "ipv6 cidr",
+			included: []string{"2001:db8::/32"},
+			err:      true,
+		},
+		{
+			name:     "custom networks",
+			included: []string{"10.0.0.0/8", "192.168.1.1"},
+			excluded: []string{"10.0.0.1/32"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel(tt.useDefault, tt.included, tt.excluded)
+
+			diags := validateIntrusion(ctx, &data)
+			if !tt.err {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, "Invalid Protected Networks", diags.Errors()[0].Summary())
+		})
+	}
+}
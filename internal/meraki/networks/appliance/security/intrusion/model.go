@@ -0,0 +1,40 @@
+package intrusion
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the intrusion detection and prevention settings of a network.
+type resourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	NetworkId         types.String `tfsdk:"network_id"`
+	Mode              types.String `tfsdk:"mode"`
+	IdsRulesets       types.String `tfsdk:"ids_rulesets"`
+	ProtectedNetworks types.Object `tfsdk:"protected_networks"`
+}
+
+type protectedNetworksModel struct {
+	UseDefault   types.Bool `tfsdk:"use_default"`
+	IncludedCidr types.List `tfsdk:"included_cidr"`
+	ExcludedCidr types.List `tfsdk:"excluded_cidr"`
+}
+
+func protectedNetworksAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"use_default":   types.BoolType,
+		"included_cidr": types.ListType{ElemType: types.StringType},
+		"excluded_cidr": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// apiIntrusion is the intrusion settings of a network in the format of the Dashboard API.
+type apiIntrusion struct {
+	Mode              string `json:"mode"`
+	IdsRulesets       string `json:"idsRulesets"`
+	ProtectedNetworks *struct {
+		UseDefault   *bool    `json:"useDefault,omitempty"`
+		IncludedCidr []string `json:"includedCidr,omitempty"`
+		ExcludedCidr []string `json:"excludedCidr,omitempty"`
+	} `json:"protectedNetworks,omitempty"`
+}
@@ -0,0 +1,67 @@
+package intrusion_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceSecurityIntrusionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_security_intrusion"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_security_intrusion"),
+			},
+
+			// Create and Read Intrusion
+			{
+				Config: NetworksApplianceSecurityIntrusionResourceConfig("detection", "balanced"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_intrusion.test", "mode", "detection"),
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_intrusion.test", "ids_rulesets", "balanced"),
+				),
+			},
+
+			// Update and Read Intrusion
+			{
+				Config: NetworksApplianceSecurityIntrusionResourceConfig("prevention", "security"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_intrusion.test", "mode", "prevention"),
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_intrusion.test", "ids_rulesets", "security"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_appliance_security_intrusion.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceSecurityIntrusionResourceConfig(mode, idsRulesets string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_security_intrusion" "test" {
+    network_id = resource.meraki_network.test.network_id
+    mode = "%s"
+    ids_rulesets = "%s"
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_security_intrusion"),
+		mode,
+		idsRulesets,
+	)
+}
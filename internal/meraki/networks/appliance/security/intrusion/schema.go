@@ -0,0 +1,84 @@
+package intrusion
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the intrusion detection and prevention settings of a network appliance. Deleting this resource disables intrusion detection and restores the balanced ruleset and the default protected networks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Intrusion detection mode: 'disabled', 'detection' or 'prevention'",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("disabled", "detection", "prevention"),
+				},
+			},
+			"ids_rulesets": schema.StringAttribute{
+				MarkdownDescription: "Intrusion detection ruleset: 'connectivity', 'balanced' or 'security'",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("connectivity", "balanced", "security"),
+				},
+			},
+			"protected_networks": schema.SingleNestedAttribute{
+				MarkdownDescription: "The networks included in and excluded from intrusion detection. Only applies to appliances in passthrough mode.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"use_default": schema.BoolAttribute{
+						MarkdownDescription: "Whether the default protected networks are used",
+						Required:            true,
+					},
+					"included_cidr": schema.ListAttribute{
+						MarkdownDescription: "The IPv4 addresses or CIDRs included in intrusion detection. Required when `use_default` is false.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"excluded_cidr": schema.ListAttribute{
+						MarkdownDescription: "The IPv4 addresses or CIDRs excluded from intrusion detection when `use_default` is false",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
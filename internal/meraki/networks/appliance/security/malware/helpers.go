@@ -0,0 +1,118 @@
+package malware
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"strings"
+)
+
+// malwarePayload returns the malware payload for the planned data. The allow lists are always sent, so that entries
+// removed from the configuration are removed from the Dashboard.
+func malwarePayload(ctx context.Context, data *resourceModel) (openApiClient.UpdateNetworkApplianceSecurityMalwareRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateNetworkApplianceSecurityMalwareRequest(data.Mode.ValueString())
+	payload.AllowedUrls = []openApiClient.UpdateNetworkApplianceSecurityMalwareRequestAllowedUrlsInner{}
+	payload.AllowedFiles = []openApiClient.UpdateNetworkApplianceSecurityMalwareRequestAllowedFilesInner{}
+
+	var urls []allowedUrlModel
+	diags.Append(data.AllowedUrls.ElementsAs(ctx, &urls, false)...)
+	for _, url := range urls {
+		payload.AllowedUrls = append(payload.AllowedUrls, *openApiClient.NewUpdateNetworkApplianceSecurityMalwareRequestAllowedUrlsInner(url.Url.ValueString(), url.Comment.ValueString()))
+	}
+
+	var files []allowedFileModel
+	diags.Append(data.AllowedFiles.ElementsAs(ctx, &files, false)...)
+	for _, file := range files {
+		payload.AllowedFiles = append(payload.AllowedFiles, *openApiClient.NewUpdateNetworkApplianceSecurityMalwareRequestAllowedFilesInner(file.Sha256.ValueString(), file.Comment.ValueString()))
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that restores the default malware settings of a network: malware protection
+// disabled and empty allow lists.
+func resetPayload() openApiClient.UpdateNetworkApplianceSecurityMalwareRequest {
+	payload := *openApiClient.NewUpdateNetworkApplianceSecurityMalwareRequest("disabled")
+	payload.AllowedUrls = []openApiClient.UpdateNetworkApplianceSecurityMalwareRequestAllowedUrlsInner{}
+	payload.AllowedFiles = []openApiClient.UpdateNetworkApplianceSecurityMalwareRequestAllowedFilesInner{}
+	return payload
+}
+
+// readMalware sets data from the malware settings returned by the Dashboard API.
+func readMalware(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var malware apiMalware
+	if err := utils.ConvertJSON(response, &malware); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the malware settings: %s", err))
+		return diags
+	}
+
+	data.Id = data.NetworkId
+	data.Mode = types.StringValue(malware.Mode)
+
+	urls := []allowedUrlModel{}
+	for _, url := range malware.AllowedUrls {
+		urls = append(urls, allowedUrlModel{Url: types.StringValue(url.Url), Comment: types.StringValue(url.Comment)})
+	}
+	var listDiags diag.Diagnostics
+	data.AllowedUrls, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: allowedUrlAttrTypes()}, urls)
+	diags.Append(listDiags...)
+
+	files := []allowedFileModel{}
+	for _, file := range malware.AllowedFiles {
+		// The Dashboard may return the hashes in upper case
+		files = append(files, allowedFileModel{Sha256: types.StringValue(strings.ToLower(file.Sha256)), Comment: types.StringValue(file.Comment)})
+	}
+	data.AllowedFiles, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: allowedFileAttrTypes()}, files)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// validateMalware checks at plan time that no URL or file is allowed twice.
+func validateMalware(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if utils.IsKnown(data.AllowedUrls) {
+		var urls []allowedUrlModel
+		diags.Append(data.AllowedUrls.ElementsAs(ctx, &urls, false)...)
+
+		seen := map[string]bool{}
+		for i, url := range urls {
+			if !utils.IsKnown(url.Url) {
+				continue
+			}
+			if seen[url.Url.ValueString()] {
+				diags.AddAttributeError(path.Root("allowed_urls").AtListIndex(i).AtName("url"), "Duplicate Allowed URL",
+					fmt.Sprintf("%q is allowed more than once", url.Url.ValueString()))
+			}
+			seen[url.Url.ValueString()] = true
+		}
+	}
+
+	if utils.IsKnown(data.AllowedFiles) {
+		var files []allowedFileModel
+		diags.Append(data.AllowedFiles.ElementsAs(ctx, &files, false)...)
+
+		seen := map[string]bool{}
+		for i, file := range files {
+			if !utils.IsKnown(file.Sha256) {
+				continue
+			}
+			if seen[file.Sha256.ValueString()] {
+				diags.AddAttributeError(path.Root("allowed_files").AtListIndex(i).AtName("sha256"), "Duplicate Allowed File",
+					fmt.Sprintf("%q is allowed more than once", file.Sha256.ValueString()))
+			}
+			seen[file.Sha256.ValueString()] = true
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,127 @@
+package malware
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+var testSha256 = strings.Repeat("e1", 32)
+
+func testResourceModel(urls []string, files []string) resourceModel {
+	urlValues := []attr.Value{}
+	for _, url := range urls {
+		urlValues = append(urlValues, types.ObjectValueMust(allowedUrlAttrTypes(), map[string]attr.Value{
+			"url":     types.StringValue(url),
+			"comment": types.StringValue(""),
+		}))
+	}
+
+	fileValues := []attr.Value{}
+	for _, file := range files {
+		fileValues = append(fileValues, types.ObjectValueMust(allowedFileAttrTypes(), map[string]attr.Value{
+			"sha256":  types.StringValue(file),
+			"comment": types.StringValue(""),
+		}))
+	}
+
+	return resourceModel{
+		Id:           types.StringUnknown(),
+		NetworkId:    types.StringValue("N_1"),
+		Mode:         types.StringValue("enabled"),
+		AllowedUrls:  types.ListValueMust(types.ObjectType{AttrTypes: allowedUrlAttrTypes()}, urlValues),
+		AllowedFiles: types.ListValueMust(types.ObjectType{AttrTypes: allowedFileAttrTypes()}, fileValues),
+	}
+}
+
+func TestMalwarePayload(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Allow lists
+	data := testResourceModel([]string{"example.org"}, []string{testSha256})
+	payload, diags := malwarePayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"mode": "enabled", "allowedUrls": [{"url": "example.org", "comment": ""}], "allowedFiles": [{"sha256": "`+testSha256+`", "comment": ""}]}`, string(body))
+
+	// Test case: Empty allow lists are sent to remove the entries in the Dashboard
+	data = testResourceModel(nil, nil)
+	payload, diags = malwarePayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err = json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"mode": "enabled", "allowedUrls": [], "allowedFiles": []}`, string(body))
+}
+
+func TestReadMalware(t *testing.T) {
+	ctx := context.Background()
+
+	data := testResourceModel(nil, nil)
+	response := map[string]interface{}{
+		"mode":         "enabled",
+		"allowedUrls":  []interface{}{map[string]interface{}{"url": "example.org", "comment": ""}},
+		"allowedFiles": []interface{}{map[string]interface{}{"sha256": strings.ToUpper(testSha256), "comment": ""}},
+	}
+	require.False(t, readMalware(ctx, &data, response).HasError())
+
+	expected := testResourceModel([]string{"example.org"}, []string{testSha256})
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	assert.Equal(t, expected.AllowedUrls, data.AllowedUrls)
+	assert.Equal(t, expected.AllowedFiles, data.AllowedFiles)
+
+	// Test case: Missing allow lists are read as empty lists
+	response = map[string]interface{}{"mode": "disabled"}
+	require.False(t, readMalware(ctx, &data, response).HasError())
+	assert.Equal(t, "disabled", data.Mode.ValueString())
+	assert.Empty(t, data.AllowedUrls.Elements())
+	assert.Empty(t, data.AllowedFiles.Elements())
+}
+
+func TestValidateMalware(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		urls  []string
+		files []string
+		err   string
+	}{
+		{
+			name: "duplicate url",
+			urls: []string{"example.org", "example.com", "example.org"},
+			err:  "Duplicate Allowed URL",
+		},
+		{
+			name:  "duplicate file",
+			files: []string{testSha256, testSha256},
+			err:   "Duplicate Allowed File",
+		},
+		{
+			name:  "valid",
+			urls:  []string{"example.org", "example.com"},
+			files: []string{testSha256},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel(tt.urls, tt.files)
+
+			diags := validateMalware(ctx, &data)
+			if tt.err == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, tt.err, diags.Errors()[0].Summary())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package malware
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the malware protection settings of a network.
+type resourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	NetworkId    types.String `tfsdk:"network_id"`
+	Mode         types.String `tfsdk:"mode"`
+	AllowedUrls  types.List   `tfsdk:"allowed_urls"`
+	AllowedFiles types.List   `tfsdk:"allowed_files"`
+}
+
+type allowedUrlModel struct {
+	Url     types.String `tfsdk:"url"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+type allowedFileModel struct {
+	Sha256  types.String `tfsdk:"sha256"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func allowedUrlAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url":     types.StringType,
+		"comment": types.StringType,
+	}
+}
+
+func allowedFileAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sha256":  types.StringType,
+		"comment": types.StringType,
+	}
+}
+
+// apiMalware is the malware protection settings of a network in the format of the Dashboard API.
+type apiMalware struct {
+	Mode        string `json:"mode"`
+	AllowedUrls []struct {
+		Url     string `json:"url"`
+		Comment string `json:"comment"`
+	} `json:"allowedUrls"`
+	AllowedFiles []struct {
+		Sha256  string `json:"sha256"`
+		Comment string `json:"comment"`
+	} `json:"allowedFiles"`
+}
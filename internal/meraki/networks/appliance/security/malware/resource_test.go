@@ -0,0 +1,73 @@
+package malware_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceSecurityMalwareResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_security_malware"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_security_malware"),
+			},
+
+			// Create and Read Malware
+			{
+				Config: NetworksApplianceSecurityMalwareResourceConfig("enabled", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_malware.test", "mode", "enabled"),
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_malware.test", "allowed_urls.#", "0"),
+				),
+			},
+
+			// Update and Read Malware
+			{
+				Config: NetworksApplianceSecurityMalwareResourceConfig("disabled", "example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_malware.test", "mode", "disabled"),
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_malware.test", "allowed_urls.#", "1"),
+					resource.TestCheckResourceAttr("meraki_networks_appliance_security_malware.test", "allowed_urls.0.url", "example.org"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_appliance_security_malware.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceSecurityMalwareResourceConfig(mode, allowedUrl string) string {
+	allowedUrls := ""
+	if allowedUrl != "" {
+		allowedUrls = fmt.Sprintf(`allowed_urls = [{ url = "%s", comment = "test" }]`, allowedUrl)
+	}
+
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_security_malware" "test" {
+    network_id = resource.meraki_network.test.network_id
+    mode = "%s"
+    %s
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_security_malware"),
+		mode,
+		allowedUrls,
+	)
+}
@@ -109,18 +109,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -141,12 +141,9 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	v.SetPrefix(data.DynamicDnsPrefix.ValueString())
 	updateNetworksApplianceSettings.SetDynamicDns(v)
 
-	inlineResp, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceSettingsRequest(updateNetworksApplianceSettings).Execute()
+	inlineResp, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceSettingsRequest(updateNetworksApplianceSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -187,12 +184,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	inlineResp, httpResp, err := r.client.SettingsApi.GetNetworkApplianceSettings(context.Background(), data.NetworkId.ValueString()).Execute()
+	inlineResp, httpResp, err := r.client.SettingsApi.GetNetworkApplianceSettings(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -243,12 +237,9 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	v.SetPrefix(data.DynamicDnsPrefix.ValueString())
 	updateNetworksApplianceSettings.SetDynamicDns(v)
 
-	inlineResp, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceSettingsRequest(updateNetworksApplianceSettings).Execute()
+	inlineResp, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceSettingsRequest(updateNetworksApplianceSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -293,12 +284,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	var v openApiClient.UpdateNetworkApplianceSettingsRequestDynamicDns
 	updateNetworksApplianceSettings.SetDynamicDns(v)
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceSettingsRequest(updateNetworksApplianceSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceSettingsRequest(updateNetworksApplianceSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,7 +34,8 @@ func NewResource() resource.Resource {
 
 // Resource defines the resource implementation.
 type Resource struct {
-	client *openApiClient.APIClient
+	client  *openApiClient.APIClient
+	batches *utils.ActionBatcher
 }
 
 // resourceModel describes the resource data model.
@@ -172,17 +174,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.batches = providerData.ActionBatches
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -196,12 +199,21 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	createNetworkApplianceStaticRoutes := *openApiClient.NewCreateNetworkApplianceStaticRouteRequest(data.Name.ValueString(), data.Subnet.ValueString(), data.GatewayIp.ValueString())
 
-	inlineResp, httpResp, err := r.client.ApplianceApi.CreateNetworkApplianceStaticRoute(context.Background(), data.NetworkId.ValueString()).CreateNetworkApplianceStaticRouteRequest(createNetworkApplianceStaticRoutes).Execute()
+	// Queue the create into an action batch when enabled
+	if r.batches != nil {
+		resp.Diagnostics.Append(r.submitActionBatch(ctx, data, utils.BatchOperationCreate, createNetworkApplianceStaticRoutes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		tflog.Trace(ctx, "created resource")
+		return
+	}
+
+	inlineResp, httpResp, err := r.client.ApplianceApi.CreateNetworkApplianceStaticRoute(ctx, data.NetworkId.ValueString()).CreateNetworkApplianceStaticRouteRequest(createNetworkApplianceStaticRoutes).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 
 	}
@@ -287,75 +299,11 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	inlineResp, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceStaticRoute(ctx, data.NetworkId.ValueString(), data.StaticRoutId.ValueString()).Execute()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
-		return
-
-	}
-
-	// Check for API success response code
-	if httpResp.StatusCode != 200 {
-
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			fmt.Sprintf("%v", httpResp.StatusCode),
-		)
-
-	}
-
-	// Check for errors after diagnostics collected
+	resp.Diagnostics.Append(r.read(ctx, data)...)
 	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("Plan Data", fmt.Sprintf("\n%s", data))
-		return
-	}
-
-	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
-		resp.Diagnostics.AddError(
-			"JSON decoding error",
-			fmt.Sprintf("%v\n", err.Error()),
-		)
 		return
 	}
 
-	if reservedIpRangesResponse := inlineResp["reservedIpRanges"]; reservedIpRangesResponse != nil {
-		var reservedIpRanges []ReservedIpRangeResourceModel
-		jsonData, _ := json.Marshal(reservedIpRangesResponse)
-		json.Unmarshal(jsonData, &reservedIpRanges)
-		data.ReservedIpRanges = make([]ReservedIpRangeResourceModel, 0)
-		for _, attribute := range reservedIpRanges {
-			var reservedIpRange ReservedIpRangeResourceModel
-			reservedIpRange.Comment = attribute.Comment
-			reservedIpRange.End = attribute.End
-			reservedIpRange.Start = attribute.Start
-			data.ReservedIpRanges = append(data.ReservedIpRanges, reservedIpRange)
-		}
-	}
-
-	if fixedIpAssignmentsResponse := inlineResp["fixedIpAssignments"]; fixedIpAssignmentsResponse != nil {
-		if macresponse := fixedIpAssignmentsResponse.(map[string]interface{})[data.FixedIpAssignmentsMacAddress.ValueString()]; macresponse != nil {
-			var macData resourceModelMacData
-			jsonData, _ := json.Marshal(fixedIpAssignmentsResponse.(map[string]interface{})[data.FixedIpAssignmentsMacAddress.ValueString()])
-			json.Unmarshal(jsonData, &macData)
-			data.FixedIpAssignmentsMacIpAddress = jsontypes.StringValue(macData.Ip)
-			data.FixedIpAssignmentsMacName = jsontypes.StringValue(macData.Name)
-		} else {
-			data.FixedIpAssignmentsMacIpAddress = jsontypes.StringNull()
-			data.FixedIpAssignmentsMacAddress = jsontypes.StringNull()
-			data.FixedIpAssignmentsMacName = jsontypes.StringNull()
-		}
-	} else {
-		data.FixedIpAssignmentsMacIpAddress = jsontypes.StringNull()
-		data.FixedIpAssignmentsMacAddress = jsontypes.StringNull()
-		data.FixedIpAssignmentsMacName = jsontypes.StringNull()
-	}
-
-	data.Id = types.StringValue("example-id")
-
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
@@ -417,12 +365,21 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		}
 	}
 
-	inlineResp, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceStaticRoute(context.Background(), data.NetworkId.ValueString(), data.StaticRoutId.ValueString()).UpdateNetworkApplianceStaticRouteRequest(updateNetworkApplianceStaticRoutes).Execute()
+	// Queue the update into an action batch when enabled
+	if r.batches != nil {
+		resp.Diagnostics.Append(r.submitActionBatch(ctx, data, utils.BatchOperationUpdate, updateNetworkApplianceStaticRoutes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		tflog.Trace(ctx, "updated resource")
+		return
+	}
+
+	inlineResp, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceStaticRoute(ctx, data.NetworkId.ValueString(), data.StaticRoutId.ValueString()).UpdateNetworkApplianceStaticRouteRequest(updateNetworkApplianceStaticRoutes).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 
 	}
@@ -499,12 +456,21 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	// Read Terraform state data
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	// Queue the delete into an action batch when enabled
+	if r.batches != nil {
+		resp.Diagnostics.Append(r.submitActionBatch(ctx, data, utils.BatchOperationDestroy, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.State.RemoveResource(ctx)
+		tflog.Trace(ctx, "removed resource")
+		return
+	}
+
 	httpResp, err := r.client.ApplianceApi.DeleteNetworkApplianceStaticRoute(ctx, data.NetworkId.ValueString(), data.StaticRoutId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 
 	}
@@ -532,6 +498,115 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 
 }
 
+// read refreshes data from the static route returned by the Dashboard API.
+func (r *Resource) read(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inlineResp, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceStaticRoute(ctx, data.NetworkId.ValueString(), data.StaticRoutId.ValueString()).Execute()
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
+		return diags
+	}
+
+	// Check for API success response code
+	if httpResp.StatusCode != 200 {
+
+		diags.AddError(
+			"Unexpected HTTP Response Status Code",
+			fmt.Sprintf("%v", httpResp.StatusCode),
+		)
+
+	}
+
+	// Check for errors after diagnostics collected
+	if diags.HasError() {
+		diags.AddError("Plan Data", fmt.Sprintf("\n%s", data))
+		return diags
+	}
+
+	// Save data into Terraform state
+	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
+		diags.AddError(
+			"JSON decoding error",
+			fmt.Sprintf("%v\n", err.Error()),
+		)
+		return diags
+	}
+
+	if reservedIpRangesResponse := inlineResp["reservedIpRanges"]; reservedIpRangesResponse != nil {
+		var reservedIpRanges []ReservedIpRangeResourceModel
+		jsonData, _ := json.Marshal(reservedIpRangesResponse)
+		json.Unmarshal(jsonData, &reservedIpRanges)
+		data.ReservedIpRanges = make([]ReservedIpRangeResourceModel, 0)
+		for _, attribute := range reservedIpRanges {
+			var reservedIpRange ReservedIpRangeResourceModel
+			reservedIpRange.Comment = attribute.Comment
+			reservedIpRange.End = attribute.End
+			reservedIpRange.Start = attribute.Start
+			data.ReservedIpRanges = append(data.ReservedIpRanges, reservedIpRange)
+		}
+	}
+
+	if fixedIpAssignmentsResponse := inlineResp["fixedIpAssignments"]; fixedIpAssignmentsResponse != nil {
+		if macresponse := fixedIpAssignmentsResponse.(map[string]interface{})[data.FixedIpAssignmentsMacAddress.ValueString()]; macresponse != nil {
+			var macData resourceModelMacData
+			jsonData, _ := json.Marshal(fixedIpAssignmentsResponse.(map[string]interface{})[data.FixedIpAssignmentsMacAddress.ValueString()])
+			json.Unmarshal(jsonData, &macData)
+			data.FixedIpAssignmentsMacIpAddress = jsontypes.StringValue(macData.Ip)
+			data.FixedIpAssignmentsMacName = jsontypes.StringValue(macData.Name)
+		} else {
+			data.FixedIpAssignmentsMacIpAddress = jsontypes.StringNull()
+			data.FixedIpAssignmentsMacAddress = jsontypes.StringNull()
+			data.FixedIpAssignmentsMacName = jsontypes.StringNull()
+		}
+	} else {
+		data.FixedIpAssignmentsMacIpAddress = jsontypes.StringNull()
+		data.FixedIpAssignmentsMacAddress = jsontypes.StringNull()
+		data.FixedIpAssignmentsMacName = jsontypes.StringNull()
+	}
+
+	data.Id = types.StringValue("example-id")
+
+	return diags
+}
+
+// submitActionBatch submits a create, update or destroy of the static route as part of an action batch. Created
+// and updated routes are read back, since action batches do not return the resources they change.
+func (r *Resource) submitActionBatch(ctx context.Context, data *resourceModel, operation string, payload interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourcePath := fmt.Sprintf("/networks/%s/appliance/staticRoutes", data.NetworkId.ValueString())
+	if operation != utils.BatchOperationCreate {
+		resourcePath += "/" + data.StaticRoutId.ValueString()
+	}
+
+	action, err := utils.NewBatchAction(resourcePath, operation, payload)
+	if err != nil {
+		diags.AddError("Action Batch Failure", err.Error())
+		return diags
+	}
+
+	created, err := r.batches.SubmitForNetwork(ctx, data.NetworkId.ValueString(), action)
+	if err != nil {
+		diags.AddError("Action Batch Failure", fmt.Sprintf("Could not %s static route %s: %s", operation, data.Name.ValueString(), err))
+		return diags
+	}
+
+	if operation == utils.BatchOperationCreate {
+		if len(created) == 0 {
+			diags.AddError("Action Batch Failure", fmt.Sprintf("The action batch did not return the ID of static route %s", data.Name.ValueString()))
+			return diags
+		}
+		data.StaticRoutId = jsontypes.StringValue(created[0].ID)
+	}
+	if operation == utils.BatchOperationDestroy {
+		return diags
+	}
+
+	diags.Append(r.read(ctx, data)...)
+	return diags
+}
+
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 	idParts := strings.Split(req.ID, ",")
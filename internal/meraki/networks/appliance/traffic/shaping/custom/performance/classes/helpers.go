@@ -0,0 +1,46 @@
+package classes
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// performanceClassPayload returns the planned performance class in the format of the Dashboard API. Thresholds that
+// are not configured are left to the Dashboard.
+func performanceClassPayload(data *resourceModel) apiPerformanceClass {
+	payload := apiPerformanceClass{
+		Name: data.Name.ValueStringPointer(),
+	}
+	if !data.MaxLatency.IsUnknown() {
+		payload.MaxLatency = data.MaxLatency.ValueInt64Pointer()
+	}
+	if !data.MaxJitter.IsUnknown() {
+		payload.MaxJitter = data.MaxJitter.ValueInt64Pointer()
+	}
+	if !data.MaxLossPercentage.IsUnknown() {
+		payload.MaxLossPercentage = data.MaxLossPercentage.ValueInt64Pointer()
+	}
+	return payload
+}
+
+// readPerformanceClass sets data from the performance class returned by the Dashboard API.
+func readPerformanceClass(data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var class apiPerformanceClass
+	if err := utils.ConvertJSON(response, &class); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the custom performance class: %s", err))
+		return diags
+	}
+
+	data.CustomPerformanceClassId = types.StringPointerValue(class.CustomPerformanceClassId)
+	data.Name = types.StringPointerValue(class.Name)
+	data.MaxLatency = types.Int64PointerValue(class.MaxLatency)
+	data.MaxJitter = types.Int64PointerValue(class.MaxJitter)
+	data.MaxLossPercentage = types.Int64PointerValue(class.MaxLossPercentage)
+	data.Id = types.StringValue(data.NetworkId.ValueString() + "," + data.CustomPerformanceClassId.ValueString())
+
+	return diags
+}
@@ -0,0 +1,26 @@
+package classes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReadPerformanceClass(t *testing.T) {
+	data := resourceModel{NetworkId: types.StringValue("N_1")}
+	response := map[string]interface{}{
+		"customPerformanceClassId": "123",
+		"name":                     "VoIP",
+		"maxLatency":               100,
+		"maxJitter":                100,
+		"maxLossPercentage":        5,
+	}
+	require.False(t, readPerformanceClass(&data, response).HasError())
+
+	assert.Equal(t, "N_1,123", data.Id.ValueString())
+	assert.Equal(t, "123", data.CustomPerformanceClassId.ValueString())
+	assert.Equal(t, "VoIP", data.Name.ValueString())
+	assert.Equal(t, int64(100), data.MaxJitter.ValueInt64())
+	assert.Equal(t, int64(5), data.MaxLossPercentage.ValueInt64())
+}
@@ -0,0 +1,26 @@
+package classes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of a custom performance class of a network appliance.
+type resourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	NetworkId                types.String `tfsdk:"network_id"`
+	CustomPerformanceClassId types.String `tfsdk:"custom_performance_class_id"`
+	Name                     types.String `tfsdk:"name"`
+	MaxLatency               types.Int64  `tfsdk:"max_latency"`
+	MaxJitter                types.Int64  `tfsdk:"max_jitter"`
+	MaxLossPercentage        types.Int64  `tfsdk:"max_loss_percentage"`
+}
+
+// apiPerformanceClass is a custom performance class in the format of the Dashboard API, which the generated client
+// returns as a map.
+type apiPerformanceClass struct {
+	CustomPerformanceClassId *string `json:"customPerformanceClassId,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+	MaxLatency               *int64  `json:"maxLatency,omitempty"`
+	MaxJitter                *int64  `json:"maxJitter,omitempty"`
+	MaxLossPercentage        *int64  `json:"maxLossPercentage,omitempty"`
+}
@@ -0,0 +1,69 @@
+package classes_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceTrafficShapingCustomPerformanceClassResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_traffic_shaping_custom_performance_class"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_traffic_shaping_custom_performance_class"),
+			},
+
+			// Create and Read Custom Performance Class
+			{
+				Config: NetworksApplianceTrafficShapingCustomPerformanceClassResourceConfig(100),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_traffic_shaping_custom_performance_class.test", map[string]string{
+					"name":                "VoIP",
+					"max_latency":         "100",
+					"max_jitter":          "50",
+					"max_loss_percentage": "5",
+				}),
+			},
+
+			// Update and Read Custom Performance Class
+			{
+				Config: NetworksApplianceTrafficShapingCustomPerformanceClassResourceConfig(200),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_traffic_shaping_custom_performance_class.test", map[string]string{
+					"max_latency": "200",
+				}),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_appliance_traffic_shaping_custom_performance_class.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceTrafficShapingCustomPerformanceClassResourceConfig(maxLatency int) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_traffic_shaping_custom_performance_class" "test" {
+    network_id = resource.meraki_network.test.network_id
+    name = "VoIP"
+    max_latency = %d
+    max_jitter = 50
+    max_loss_percentage = 5
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_traffic_shaping_custom_performance_class"),
+		maxLatency,
+	)
+}
@@ -0,0 +1,178 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// networkDefaultSettings is the per-client bandwidth limit setting of rules that use the limits of the network. It is
+// read as unset per_client_bandwidth_limits.
+const networkDefaultSettings = "network default"
+
+// rulesPayload returns the traffic shaping rules payload for the planned data. The rules are always sent, so that
+// rules removed from the configuration are removed from the Dashboard.
+func rulesPayload(ctx context.Context, data *resourceModel) (openApiClient.UpdateNetworkApplianceTrafficShapingRulesRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var payload openApiClient.UpdateNetworkApplianceTrafficShapingRulesRequest
+
+	var rules []ruleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	shapingRules := apiTrafficShapingRules{
+		Rules: []apiRule{},
+	}
+	if utils.IsKnown(data.DefaultRulesEnabled) {
+		shapingRules.DefaultRulesEnabled = data.DefaultRulesEnabled.ValueBoolPointer()
+	}
+
+	for _, rule := range rules {
+		apiRule := apiRule{
+			Definitions:  []apiDefinition{},
+			DscpTagValue: rule.DscpTagValue.ValueInt64Pointer(),
+		}
+		if utils.IsKnown(rule.Priority) {
+			apiRule.Priority = rule.Priority.ValueStringPointer()
+		}
+		for _, definition := range rule.Definitions {
+			apiRule.Definitions = append(apiRule.Definitions, apiDefinition{Type: definition.Type.ValueString(), Value: definition.Value.ValueString()})
+		}
+
+		// Rules without per-client bandwidth limits use the limits of the network
+		settings := networkDefaultSettings
+		apiRule.PerClientBandwidthLimits = &apiPerClientBandwidthLimits{Settings: &settings}
+		if limits := rule.PerClientBandwidthLimits; limits != nil {
+			apiRule.PerClientBandwidthLimits.Settings = limits.Settings.ValueStringPointer()
+			if limits.BandwidthLimits != nil {
+				apiRule.PerClientBandwidthLimits.BandwidthLimits = &apiBandwidthLimits{
+					LimitUp:   limits.BandwidthLimits.LimitUp.ValueInt64Pointer(),
+					LimitDown: limits.BandwidthLimits.LimitDown.ValueInt64Pointer(),
+				}
+			}
+		}
+
+		shapingRules.Rules = append(shapingRules.Rules, apiRule)
+	}
+
+	if err := utils.ConvertJSON(shapingRules, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the traffic shaping rules payload: %s", err))
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that restores the default traffic shaping rules of a network: the default rules
+// enabled and no custom rules.
+func resetPayload() openApiClient.UpdateNetworkApplianceTrafficShapingRulesRequest {
+	payload := *openApiClient.NewUpdateNetworkApplianceTrafficShapingRulesRequest()
+	payload.SetDefaultRulesEnabled(true)
+	payload.Rules = []openApiClient.UpdateNetworkApplianceTrafficShapingRulesRequestRulesInner{}
+	return payload
+}
+
+// readRules sets data from the traffic shaping rules returned by the Dashboard API, in the order of the Dashboard.
+func readRules(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var shapingRules apiTrafficShapingRules
+	if err := utils.ConvertJSON(response, &shapingRules); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the traffic shaping rules: %s", err))
+		return diags
+	}
+
+	rules := []ruleModel{}
+	for _, apiRule := range shapingRules.Rules {
+		rule := ruleModel{
+			Definitions:  []definitionModel{},
+			DscpTagValue: types.Int64PointerValue(apiRule.DscpTagValue),
+			Priority:     types.StringPointerValue(apiRule.Priority),
+		}
+
+		for _, definition := range apiRule.Definitions {
+			rule.Definitions = append(rule.Definitions, definitionModel{
+				Type:  types.StringValue(definition.Type),
+				Value: types.StringValue(definitionValue(definition.Value)),
+			})
+		}
+
+		if limits := apiRule.PerClientBandwidthLimits; limits != nil && limits.Settings != nil && *limits.Settings != networkDefaultSettings {
+			rule.PerClientBandwidthLimits = &perClientBandwidthLimitsModel{
+				Settings: types.StringPointerValue(limits.Settings),
+			}
+			if limits.BandwidthLimits != nil && *limits.Settings == "custom" {
+				rule.PerClientBandwidthLimits.BandwidthLimits = &bandwidthLimitsModel{
+					LimitUp:   types.Int64PointerValue(limits.BandwidthLimits.LimitUp),
+					LimitDown: types.Int64PointerValue(limits.BandwidthLimits.LimitDown),
+				}
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	data.Id = data.NetworkId
+	data.DefaultRulesEnabled = types.BoolPointerValue(shapingRules.DefaultRulesEnabled)
+
+	var listDiags diag.Diagnostics
+	data.Rules, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// definitionValue returns the value of a traffic definition, which is the ID of the application or application
+// category for those definitions.
+func definitionValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case map[string]interface{}:
+		if id, ok := value["id"].(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// validateRules checks at plan time that bandwidth limits are set exactly for the rules with custom per-client
+// bandwidth limits.
+func validateRules(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.Rules) {
+		return diags
+	}
+
+	var rules []ruleModel
+	diags.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, rule := range rules {
+		limits := rule.PerClientBandwidthLimits
+		if limits == nil || !utils.IsKnown(limits.Settings) {
+			continue
+		}
+
+		limitsPath := path.Root("rules").AtListIndex(i).AtName("per_client_bandwidth_limits").AtName("bandwidth_limits")
+		custom := limits.Settings.ValueString() == "custom"
+		if custom && limits.BandwidthLimits == nil {
+			diags.AddAttributeError(limitsPath, "Invalid Per-Client Bandwidth Limits",
+				"bandwidth_limits must be set when settings is 'custom'")
+		}
+		if !custom && limits.BandwidthLimits != nil {
+			diags.AddAttributeError(limitsPath, "Invalid Per-Client Bandwidth Limits",
+				"bandwidth_limits is only used when settings is 'custom'")
+		}
+	}
+
+	return diags
+}
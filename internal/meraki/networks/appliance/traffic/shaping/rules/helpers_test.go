@@ -0,0 +1,140 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testResourceModel(t *testing.T, rules ...ruleModel) resourceModel {
+	if rules == nil {
+		rules = []ruleModel{}
+	}
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: ruleAttrTypes()}, rules)
+	require.False(t, diags.HasError())
+
+	return resourceModel{
+		Id:                  types.StringUnknown(),
+		NetworkId:           types.StringValue("N_1"),
+		DefaultRulesEnabled: types.BoolUnknown(),
+		Rules:               list,
+	}
+}
+
+func testRule(settings string, limits *bandwidthLimitsModel) ruleModel {
+	rule := ruleModel{
+		Definitions: []definitionModel{
+			{Type: types.StringValue("application"), Value: types.StringValue("meraki:layer7/application/67")},
+		},
+		DscpTagValue: types.Int64Value(46),
+		Priority:     types.StringUnknown(),
+	}
+	if settings != "" {
+		rule.PerClientBandwidthLimits = &perClientBandwidthLimitsModel{Settings: types.StringValue(settings), BandwidthLimits: limits}
+	}
+	return rule
+}
+
+func TestRulesPayload(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Rules without per-client bandwidth limits use the limits of the network
+	data := testResourceModel(t, testRule("", nil), testRule("custom", &bandwidthLimitsModel{LimitUp: types.Int64Value(1000), LimitDown: types.Int64Value(2000)}))
+	payload, diags := rulesPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"rules": [
+		{"definitions": [{"type": "application", "value": "meraki:layer7/application/67"}], "dscpTagValue": 46, "perClientBandwidthLimits": {"settings": "network default"}},
+		{"definitions": [{"type": "application", "value": "meraki:layer7/application/67"}], "dscpTagValue": 46, "perClientBandwidthLimits": {"settings": "custom", "bandwidthLimits": {"limitUp": 1000, "limitDown": 2000}}}
+	]}`, string(body))
+
+	// Test case: Empty rules are sent to remove the rules in the Dashboard
+	data = testResourceModel(t)
+	data.DefaultRulesEnabled = types.BoolValue(false)
+	payload, diags = rulesPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err = json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"defaultRulesEnabled": false, "rules": []}`, string(body))
+}
+
+func TestReadRules(t *testing.T) {
+	ctx := context.Background()
+
+	data := testResourceModel(t)
+	response := map[string]interface{}{
+		"defaultRulesEnabled": true,
+		"rules": []interface{}{
+			map[string]interface{}{
+				"definitions": []interface{}{
+					map[string]interface{}{"type": "application", "value": map[string]interface{}{"id": "meraki:layer7/application/67", "name": "Skype"}},
+					map[string]interface{}{"type": "host", "value": "example.org"},
+				},
+				"perClientBandwidthLimits": map[string]interface{}{"settings": "network default"},
+				"dscpTagValue":             nil,
+				"priority":                 "normal",
+			},
+			map[string]interface{}{
+				"definitions": []interface{}{
+					map[string]interface{}{"type": "port", "value": "443"},
+				},
+				"perClientBandwidthLimits": map[string]interface{}{
+					"settings":        "custom",
+					"bandwidthLimits": map[string]interface{}{"limitUp": 1000, "limitDown": 2000},
+				},
+				"dscpTagValue": 46,
+				"priority":     "high",
+			},
+		},
+	}
+	require.False(t, readRules(ctx, &data, response).HasError())
+
+	var rules []ruleModel
+	require.False(t, data.Rules.ElementsAs(ctx, &rules, false).HasError())
+
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	assert.True(t, data.DefaultRulesEnabled.ValueBool())
+	require.Len(t, rules, 2)
+	assert.Equal(t, []definitionModel{
+		{Type: types.StringValue("application"), Value: types.StringValue("meraki:layer7/application/67")},
+		{Type: types.StringValue("host"), Value: types.StringValue("example.org")},
+	}, rules[0].Definitions)
+	assert.Nil(t, rules[0].PerClientBandwidthLimits)
+	assert.True(t, rules[0].DscpTagValue.IsNull())
+	assert.Equal(t, &perClientBandwidthLimitsModel{
+		Settings:        types.StringValue("custom"),
+		BandwidthLimits: &bandwidthLimitsModel{LimitUp: types.Int64Value(1000), LimitDown: types.Int64Value(2000)},
+	}, rules[1].PerClientBandwidthLimits)
+	assert.Equal(t, "high", rules[1].Priority.ValueString())
+}
+
+func TestValidateRules(t *testing.T) {
+	ctx := context.Background()
+	limits := &bandwidthLimitsModel{LimitUp: types.Int64Value(1000), LimitDown: types.Int64Value(2000)}
+
+	tests := []struct {
+		name  string
+		rule  ruleModel
+		valid bool
+	}{
+		{name: "network default", rule: testRule("", nil), valid: true},
+		{name: "custom", rule: testRule("custom", limits), valid: true},
+		{name: "ignore", rule: testRule("ignore", nil), valid: true},
+		{name: "custom without limits", rule: testRule("custom", nil)},
+		{name: "ignore with limits", rule: testRule("ignore", limits)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel(t, tt.rule)
+			diags := validateRules(ctx, &data)
+			assert.Equal(t, !tt.valid, diags.HasError(), diags)
+		})
+	}
+}
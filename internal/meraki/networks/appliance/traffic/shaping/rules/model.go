@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the traffic shaping rules of a network appliance.
+type resourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	NetworkId           types.String `tfsdk:"network_id"`
+	DefaultRulesEnabled types.Bool   `tfsdk:"default_rules_enabled"`
+	Rules               types.List   `tfsdk:"rules"`
+}
+
+type ruleModel struct {
+	Definitions              []definitionModel              `tfsdk:"definitions"`
+	PerClientBandwidthLimits *perClientBandwidthLimitsModel `tfsdk:"per_client_bandwidth_limits"`
+	DscpTagValue             types.Int64                    `tfsdk:"dscp_tag_value"`
+	Priority                 types.String                   `tfsdk:"priority"`
+}
+
+type definitionModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type perClientBandwidthLimitsModel struct {
+	Settings        types.String          `tfsdk:"settings"`
+	BandwidthLimits *bandwidthLimitsModel `tfsdk:"bandwidth_limits"`
+}
+
+type bandwidthLimitsModel struct {
+	LimitUp   types.Int64 `tfsdk:"limit_up"`
+	LimitDown types.Int64 `tfsdk:"limit_down"`
+}
+
+func definitionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":  types.StringType,
+		"value": types.StringType,
+	}
+}
+
+func bandwidthLimitsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"limit_up":   types.Int64Type,
+		"limit_down": types.Int64Type,
+	}
+}
+
+func perClientBandwidthLimitsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"settings":         types.StringType,
+		"bandwidth_limits": types.ObjectType{AttrTypes: bandwidthLimitsAttrTypes()},
+	}
+}
+
+func ruleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"definitions":                 types.ListType{ElemType: types.ObjectType{AttrTypes: definitionAttrTypes()}},
+		"per_client_bandwidth_limits": types.ObjectType{AttrTypes: perClientBandwidthLimitsAttrTypes()},
+		"dscp_tag_value":              types.Int64Type,
+		"priority":                    types.StringType,
+	}
+}
+
+// apiTrafficShapingRules is the traffic shaping rules of a network in the format of the Dashboard API.
+type apiTrafficShapingRules struct {
+	DefaultRulesEnabled *bool     `json:"defaultRulesEnabled,omitempty"`
+	Rules               []apiRule `json:"rules"`
+}
+
+type apiRule struct {
+	Definitions              []apiDefinition              `json:"definitions"`
+	PerClientBandwidthLimits *apiPerClientBandwidthLimits `json:"perClientBandwidthLimits,omitempty"`
+	DscpTagValue             *int64                       `json:"dscpTagValue,omitempty"`
+	Priority                 *string                      `json:"priority,omitempty"`
+}
+
+// apiDefinition is a traffic definition of a rule. The Dashboard returns the value of application and application
+// category definitions as an object with an id and a name, but expects the ID only.
+type apiDefinition struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type apiPerClientBandwidthLimits struct {
+	Settings        *string             `json:"settings,omitempty"`
+	BandwidthLimits *apiBandwidthLimits `json:"bandwidthLimits,omitempty"`
+}
+
+type apiBandwidthLimits struct {
+	LimitUp   *int64 `json:"limitUp,omitempty"`
+	LimitDown *int64 `json:"limitDown,omitempty"`
+}
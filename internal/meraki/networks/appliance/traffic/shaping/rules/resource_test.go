@@ -0,0 +1,85 @@
+package rules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceTrafficShapingRulesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_traffic_shaping_rules"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_traffic_shaping_rules"),
+			},
+
+			// Create and Read Traffic Shaping Rules
+			{
+				Config: NetworksApplianceTrafficShapingRulesResourceConfig("high"),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_traffic_shaping_rules.test", map[string]string{
+					"default_rules_enabled":                        "true",
+					"rules.#":                                      "2",
+					"rules.0.definitions.0.type":                   "host",
+					"rules.0.definitions.0.value":                  "example.org",
+					"rules.0.dscp_tag_value":                       "46",
+					"rules.0.priority":                             "high",
+					"rules.1.definitions.0.value":                  "443",
+					"rules.1.per_client_bandwidth_limits.settings": "custom",
+					"rules.1.per_client_bandwidth_limits.bandwidth_limits.limit_up": "1000",
+				}),
+			},
+
+			// Update and Read Traffic Shaping Rules
+			{
+				Config: NetworksApplianceTrafficShapingRulesResourceConfig("low"),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_traffic_shaping_rules.test", map[string]string{
+					"rules.0.priority": "low",
+				}),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_appliance_traffic_shaping_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceTrafficShapingRulesResourceConfig(priority string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_traffic_shaping_rules" "test" {
+    network_id = resource.meraki_network.test.network_id
+    default_rules_enabled = true
+    rules = [
+        {
+            definitions = [{ type = "host", value = "example.org" }]
+            dscp_tag_value = 46
+            priority = "%s"
+        },
+        {
+            definitions = [{ type = "port", value = "443" }]
+            per_client_bandwidth_limits = {
+                settings = "custom"
+                bandwidth_limits = { limit_up = 1000, limit_down = 2000 }
+            }
+        }
+    ]
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_traffic_shaping_rules"),
+		priority,
+	)
+}
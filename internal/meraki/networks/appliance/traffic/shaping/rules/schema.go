@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the traffic shaping rules of a network appliance. The rules are applied in order and replace any rules configured in the Dashboard. Deleting this resource removes the rules and enables the default rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_rules_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether default traffic shaping rules are enabled",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The traffic shaping rules, in order. Defaults to no rules.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: ruleAttrTypes()}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"definitions": schema.ListNestedAttribute{
+							MarkdownDescription: "The traffic matched by the rule",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of definition: 'application', 'applicationCategory', 'host', 'port', 'ipRange' or 'localNet'",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("application", "applicationCategory", "host", "port", "ipRange", "localNet"),
+										},
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The application or application category ID, hostname, port, IP range or local network matched by the definition",
+										Required:            true,
+									},
+								},
+							},
+						},
+						"per_client_bandwidth_limits": schema.SingleNestedAttribute{
+							MarkdownDescription: "The per-client bandwidth limits of the traffic matched by the rule. Defaults to the limits of the network.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"settings": schema.StringAttribute{
+									MarkdownDescription: "'ignore' to not limit the bandwidth, or 'custom' to use `bandwidth_limits`",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("ignore", "custom"),
+									},
+								},
+								"bandwidth_limits": schema.SingleNestedAttribute{
+									MarkdownDescription: "The bandwidth limits in Kbps. Required when `settings` is 'custom'.",
+									Optional:            true,
+									Attributes: map[string]schema.Attribute{
+										"limit_up": schema.Int64Attribute{
+											MarkdownDescription: "The maximum upload limit",
+											Required:            true,
+										},
+										"limit_down": schema.Int64Attribute{
+											MarkdownDescription: "The maximum download limit",
+											Required:            true,
+										},
+									},
+								},
+							},
+						},
+						"dscp_tag_value": schema.Int64Attribute{
+							MarkdownDescription: "The DSCP tag applied by the rule. Defaults to no tag.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.OneOf(0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56),
+							},
+						},
+						"priority": schema.StringAttribute{
+							MarkdownDescription: "The priority of the rule: 'low', 'normal' or 'high'",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("low", "normal", "high"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -34,18 +34,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -93,13 +93,10 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	updateApplianceTrafficShapingUplinkBandWidth.SetBandwidthLimits(bandwidthLimit)
 
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceTrafficShapingUplinkBandwidth(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceTrafficShapingUplinkBandwidthRequest(updateApplianceTrafficShapingUplinkBandWidth).Execute()
+	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceTrafficShapingUplinkBandwidth(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceTrafficShapingUplinkBandwidthRequest(updateApplianceTrafficShapingUplinkBandWidth).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -143,13 +140,10 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceTrafficShapingUplinkBandwidth(context.Background(), data.NetworkId.ValueString()).Execute()
+	_, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceTrafficShapingUplinkBandwidth(ctx, data.NetworkId.ValueString()).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -232,13 +226,10 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 
 	updateApplianceTrafficShapingUplinkBandWidth.SetBandwidthLimits(bandwidthLimit)
 
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceTrafficShapingUplinkBandwidth(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceTrafficShapingUplinkBandwidthRequest(updateApplianceTrafficShapingUplinkBandWidth).Execute()
+	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceTrafficShapingUplinkBandwidth(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceTrafficShapingUplinkBandwidthRequest(updateApplianceTrafficShapingUplinkBandWidth).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -298,13 +289,10 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	bandwidthLimit.SetWan2(wan2)
 	updateApplianceTrafficShapingUplinkBandWidth.SetBandwidthLimits(bandwidthLimit)
 
-	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceTrafficShapingUplinkBandwidth(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceTrafficShapingUplinkBandwidthRequest(updateApplianceTrafficShapingUplinkBandWidth).Execute()
+	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceTrafficShapingUplinkBandwidth(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceTrafficShapingUplinkBandwidthRequest(updateApplianceTrafficShapingUplinkBandWidth).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
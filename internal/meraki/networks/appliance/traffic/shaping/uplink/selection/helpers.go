@@ -0,0 +1,316 @@
+package selection
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// selectionPayload returns the uplink selection payload for the planned data. Settings that are not known are left
+// out, so that the Dashboard keeps its value. The preferences are always sent, so that preferences removed from the
+// configuration are removed from the Dashboard.
+func selectionPayload(ctx context.Context, data *resourceModel) (openApiClient.UpdateNetworkApplianceTrafficShapingUplinkSelectionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var payload openApiClient.UpdateNetworkApplianceTrafficShapingUplinkSelectionRequest
+
+	var wanPreferences []wanPreferenceModel
+	if utils.IsKnown(data.WanTrafficUplinkPreferences) {
+		diags.Append(data.WanTrafficUplinkPreferences.ElementsAs(ctx, &wanPreferences, false)...)
+	}
+	var vpnPreferences []vpnPreferenceModel
+	if utils.IsKnown(data.VpnTrafficUplinkPreferences) {
+		diags.Append(data.VpnTrafficUplinkPreferences.ElementsAs(ctx, &vpnPreferences, false)...)
+	}
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	selection := apiUplinkSelection{
+		WanTrafficUplinkPreferences: []apiPreference{},
+		VpnTrafficUplinkPreferences: []apiPreference{},
+	}
+	if utils.IsKnown(data.ActiveActiveAutoVpnEnabled) {
+		selection.ActiveActiveAutoVpnEnabled = data.ActiveActiveAutoVpnEnabled.ValueBoolPointer()
+	}
+	if utils.IsKnown(data.DefaultUplink) {
+		selection.DefaultUplink = data.DefaultUplink.ValueStringPointer()
+	}
+	if utils.IsKnown(data.LoadBalancingEnabled) {
+		selection.LoadBalancingEnabled = data.LoadBalancingEnabled.ValueBoolPointer()
+	}
+	if utils.IsKnown(data.FailoverAndFailbackImmediateEnabled) {
+		selection.FailoverAndFailback = &apiFailoverAndFailback{}
+		selection.FailoverAndFailback.Immediate.Enabled = data.FailoverAndFailbackImmediateEnabled.ValueBool()
+	}
+
+	for _, preference := range wanPreferences {
+		apiPreference := apiPreference{
+			TrafficFilters:  []apiTrafficFilter{},
+			PreferredUplink: preference.PreferredUplink.ValueString(),
+		}
+		for _, filter := range preference.TrafficFilters {
+			apiFilter := apiTrafficFilter{Type: filter.Type.ValueString()}
+			apiFilter.Value.Protocol = knownString(filter.Value.Protocol)
+			apiFilter.Value.Source = &apiEndpoint{
+				Port: knownString(filter.Value.Source.Port),
+				Cidr: knownString(filter.Value.Source.Cidr),
+				Vlan: filter.Value.Source.Vlan.ValueInt64Pointer(),
+				Host: filter.Value.Source.Host.ValueInt64Pointer(),
+			}
+			apiFilter.Value.Destination = &apiEndpoint{
+				Port: knownString(filter.Value.Destination.Port),
+				Cidr: knownString(filter.Value.Destination.Cidr),
+			}
+			apiPreference.TrafficFilters = append(apiPreference.TrafficFilters, apiFilter)
+		}
+		selection.WanTrafficUplinkPreferences = append(selection.WanTrafficUplinkPreferences, apiPreference)
+	}
+
+	for _, preference := range vpnPreferences {
+		apiPreference := apiPreference{
+			TrafficFilters:    []apiTrafficFilter{},
+			PreferredUplink:   preference.PreferredUplink.ValueString(),
+			FailOverCriterion: knownString(preference.FailOverCriterion),
+		}
+		if class := preference.PerformanceClass; class != nil {
+			apiPreference.PerformanceClass = &apiPerformanceClass{
+				Type:                        class.Type.ValueString(),
+				BuiltinPerformanceClassName: class.BuiltinPerformanceClassName.ValueStringPointer(),
+				CustomPerformanceClassId:    class.CustomPerformanceClassId.ValueStringPointer(),
+			}
+		}
+		for _, filter := range preference.TrafficFilters {
+			apiFilter := apiTrafficFilter{Type: filter.Type.ValueString()}
+			apiFilter.Value.Id = filter.Value.Id.ValueStringPointer()
+			apiFilter.Value.Protocol = knownString(filter.Value.Protocol)
+			if source := filter.Value.Source; source != nil {
+				apiFilter.Value.Source = &apiEndpoint{
+					Port:    knownString(source.Port),
+					Cidr:    knownString(source.Cidr),
+					Network: source.Network.ValueStringPointer(),
+					Vlan:    source.Vlan.ValueInt64Pointer(),
+					Host:    source.Host.ValueInt64Pointer(),
+				}
+			}
+			if destination := filter.Value.Destination; destination != nil {
+				apiFilter.Value.Destination = &apiEndpoint{
+					Port:    knownString(destination.Port),
+					Cidr:    knownString(destination.Cidr),
+					Network: destination.Network.ValueStringPointer(),
+					Vlan:    destination.Vlan.ValueInt64Pointer(),
+					Host:    destination.Host.ValueInt64Pointer(),
+					Fqdn:    destination.Fqdn.ValueStringPointer(),
+				}
+			}
+			apiPreference.TrafficFilters = append(apiPreference.TrafficFilters, apiFilter)
+		}
+		selection.VpnTrafficUplinkPreferences = append(selection.VpnTrafficUplinkPreferences, apiPreference)
+	}
+
+	if err := utils.ConvertJSON(selection, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the uplink selection payload: %s", err))
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that restores the default uplink selection of a network: active-active AutoVPN,
+// load balancing and immediate failover and failback enabled, WAN 1 as the default uplink and no preferences.
+func resetPayload() openApiClient.UpdateNetworkApplianceTrafficShapingUplinkSelectionRequest {
+	payload := *openApiClient.NewUpdateNetworkApplianceTrafficShapingUplinkSelectionRequest()
+	payload.SetActiveActiveAutoVpnEnabled(true)
+	payload.SetDefaultUplink("wan1")
+	payload.SetLoadBalancingEnabled(true)
+	payload.SetFailoverAndFailback(openApiClient.UpdateNetworkApplianceTrafficShapingUplinkSelectionRequestFailoverAndFailback{
+		Immediate: &openApiClient.UpdateNetworkApplianceTrafficShapingUplinkSelectionRequestFailoverAndFailbackImmediate{Enabled: true},
+	})
+	payload.WanTrafficUplinkPreferences = []openApiClient.UpdateNetworkApplianceTrafficShapingUplinkSelectionRequestWanTrafficUplinkPreferencesInner{}
+	payload.VpnTrafficUplinkPreferences = []openApiClient.UpdateNetworkApplianceTrafficShapingUplinkSelectionRequestVpnTrafficUplinkPreferencesInner{}
+	return payload
+}
+
+// readSelection sets data from the uplink selection returned by the Dashboard API. The preferences keep the order of
+// the Dashboard, which is the order in which they are evaluated.
+func readSelection(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var selection apiUplinkSelection
+	if err := utils.ConvertJSON(response, &selection); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the uplink selection: %s", err))
+		return diags
+	}
+
+	wanPreferences := []wanPreferenceModel{}
+	for _, apiPreference := range selection.WanTrafficUplinkPreferences {
+		preference := wanPreferenceModel{
+			TrafficFilters:  []wanTrafficFilterModel{},
+			PreferredUplink: types.StringValue(apiPreference.PreferredUplink),
+		}
+		for _, apiFilter := range apiPreference.TrafficFilters {
+			source := endpointOrEmpty(apiFilter.Value.Source)
+			destination := endpointOrEmpty(apiFilter.Value.Destination)
+			preference.TrafficFilters = append(preference.TrafficFilters, wanTrafficFilterModel{
+				Type: types.StringValue(apiFilter.Type),
+				Value: wanTrafficFilterValueModel{
+					Protocol: types.StringPointerValue(apiFilter.Value.Protocol),
+					Source: wanSourceModel{
+						Port: types.StringPointerValue(source.Port),
+						Cidr: types.StringPointerValue(source.Cidr),
+						Vlan: types.Int64PointerValue(source.Vlan),
+						Host: types.Int64PointerValue(source.Host),
+					},
+					Destination: wanDestinationModel{
+						Port: types.StringPointerValue(destination.Port),
+						Cidr: types.StringPointerValue(destination.Cidr),
+					},
+				},
+			})
+		}
+		wanPreferences = append(wanPreferences, preference)
+	}
+
+	vpnPreferences := []vpnPreferenceModel{}
+	for _, apiPreference := range selection.VpnTrafficUplinkPreferences {
+		preference := vpnPreferenceModel{
+			TrafficFilters:    []vpnTrafficFilterModel{},
+			PreferredUplink:   types.StringValue(apiPreference.PreferredUplink),
+			FailOverCriterion: types.StringPointerValue(apiPreference.FailOverCriterion),
+		}
+		if class := apiPreference.PerformanceClass; class != nil {
+			preference.PerformanceClass = &performanceClassModel{
+				Type:                        types.StringValue(class.Type),
+				BuiltinPerformanceClassName: types.StringPointerValue(class.BuiltinPerformanceClassName),
+				CustomPerformanceClassId:    types.StringPointerValue(class.CustomPerformanceClassId),
+			}
+		}
+		for _, apiFilter := range apiPreference.TrafficFilters {
+			filter := vpnTrafficFilterModel{
+				Type: types.StringValue(apiFilter.Type),
+				Value: vpnTrafficFilterValueModel{
+					Id:       types.StringPointerValue(apiFilter.Value.Id),
+					Protocol: types.StringPointerValue(apiFilter.Value.Protocol),
+				},
+			}
+			if source := apiFilter.Value.Source; source != nil {
+				filter.Value.Source = &vpnSourceModel{
+					Port:    types.StringPointerValue(source.Port),
+					Cidr:    types.StringPointerValue(source.Cidr),
+					Network: types.StringPointerValue(source.Network),
+					Vlan:    types.Int64PointerValue(source.Vlan),
+					Host:    types.Int64PointerValue(source.Host),
+				}
+			}
+			if destination := apiFilter.Value.Destination; destination != nil {
+				filter.Value.Destination = &vpnDestinationModel{
+					Port:    types.StringPointerValue(destination.Port),
+					Cidr:    types.StringPointerValue(destination.Cidr),
+					Network: types.StringPointerValue(destination.Network),
+					Vlan:    types.Int64PointerValue(destination.Vlan),
+					Host:    types.Int64PointerValue(destination.Host),
+					Fqdn:    types.StringPointerValue(destination.Fqdn),
+				}
+			}
+			preference.TrafficFilters = append(preference.TrafficFilters, filter)
+		}
+		vpnPreferences = append(vpnPreferences, preference)
+	}
+
+	data.Id = data.NetworkId
+	data.ActiveActiveAutoVpnEnabled = types.BoolPointerValue(selection.ActiveActiveAutoVpnEnabled)
+	data.DefaultUplink = types.StringPointerValue(selection.DefaultUplink)
+	data.LoadBalancingEnabled = types.BoolPointerValue(selection.LoadBalancingEnabled)
+	data.FailoverAndFailbackImmediateEnabled = types.BoolNull()
+	if selection.FailoverAndFailback != nil {
+		data.FailoverAndFailbackImmediateEnabled = types.BoolValue(selection.FailoverAndFailback.Immediate.Enabled)
+	}
+
+	var listDiags diag.Diagnostics
+	data.WanTrafficUplinkPreferences, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: wanPreferenceAttrTypes()}, wanPreferences)
+	diags.Append(listDiags...)
+	data.VpnTrafficUplinkPreferences, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: vpnPreferenceAttrTypes()}, vpnPreferences)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// validateSelection checks at plan time that the VPN traffic filters set the values of their type and that the
+// performance classes of the VPN preferences name the class of their type.
+func validateSelection(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.VpnTrafficUplinkPreferences) {
+		return diags
+	}
+
+	var preferences []vpnPreferenceModel
+	diags.Append(data.VpnTrafficUplinkPreferences.ElementsAs(ctx, &preferences, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, preference := range preferences {
+		preferencePath := path.Root("vpn_traffic_uplink_preferences").AtListIndex(i)
+
+		for j, filter := range preference.TrafficFilters {
+			if !utils.IsKnown(filter.Type) {
+				continue
+			}
+
+			valuePath := preferencePath.AtName("traffic_filters").AtListIndex(j).AtName("value")
+			custom := filter.Type.ValueString() == "custom"
+			if custom && (filter.Value.Source == nil || filter.Value.Destination == nil) {
+				diags.AddAttributeError(valuePath, "Invalid Traffic Filter",
+					"source and destination must be set for 'custom' traffic filters")
+			}
+			if !custom && filter.Value.Id.IsNull() {
+				diags.AddAttributeError(valuePath.AtName("id"), "Invalid Traffic Filter",
+					fmt.Sprintf("id must be set for '%s' traffic filters", filter.Type.ValueString()))
+			}
+			if !custom && (filter.Value.Source != nil || filter.Value.Destination != nil) {
+				diags.AddAttributeError(valuePath, "Invalid Traffic Filter",
+					"source and destination are only used by 'custom' traffic filters")
+			}
+		}
+
+		class := preference.PerformanceClass
+		if class == nil || !utils.IsKnown(class.Type) {
+			continue
+		}
+
+		classPath := preferencePath.AtName("performance_class")
+		switch class.Type.ValueString() {
+		case "builtin":
+			if class.BuiltinPerformanceClassName.IsNull() || !class.CustomPerformanceClassId.IsNull() {
+				diags.AddAttributeError(classPath, "Invalid Performance Class",
+					"builtin_performance_class_name, and not custom_performance_class_id, must be set for 'builtin' performance classes")
+			}
+		case "custom":
+			if class.CustomPerformanceClassId.IsNull() || !class.BuiltinPerformanceClassName.IsNull() {
+				diags.AddAttributeError(classPath, "Invalid Performance Class",
+					"custom_performance_class_id, and not builtin_performance_class_name, must be set for 'custom' performance classes")
+			}
+		}
+	}
+
+	return diags
+}
+
+// endpointOrEmpty returns endpoint, or an endpoint without values if the Dashboard API left it out.
+func endpointOrEmpty(endpoint *apiEndpoint) apiEndpoint {
+	if endpoint == nil {
+		return apiEndpoint{}
+	}
+	return *endpoint
+}
+
+// knownString returns a pointer to the value of s, or nil if it is null or unknown.
+func knownString(s types.String) *string {
+	if !utils.IsKnown(s) {
+		return nil
+	}
+	return s.ValueStringPointer()
+}
@@ -0,0 +1,216 @@
+package selection
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testResourceModel(t *testing.T, wanPreferences []wanPreferenceModel, vpnPreferences []vpnPreferenceModel) resourceModel {
+	if wanPreferences == nil {
+		wanPreferences = []wanPreferenceModel{}
+	}
+	if vpnPreferences == nil {
+		vpnPreferences = []vpnPreferenceModel{}
+	}
+	wanList, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: wanPreferenceAttrTypes()}, wanPreferences)
+	require.False(t, diags.HasError())
+	vpnList, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: vpnPreferenceAttrTypes()}, vpnPreferences)
+	require.False(t, diags.HasError())
+
+	return resourceModel{
+		Id:                                  types.StringUnknown(),
+		NetworkId:                           types.StringValue("N_1"),
+		ActiveActiveAutoVpnEnabled:          types.BoolUnknown(),
+		DefaultUplink:                       types.StringValue("wan2"),
+		LoadBalancingEnabled:                types.BoolValue(false),
+		FailoverAndFailbackImmediateEnabled: types.BoolUnknown(),
+		WanTrafficUplinkPreferences:         wanList,
+		VpnTrafficUplinkPreferences:         vpnList,
+	}
+}
+
+func testWanPreference() wanPreferenceModel {
+	return wanPreferenceModel{
+		TrafficFilters: []wanTrafficFilterModel{{
+			Type: types.StringValue("custom"),
+			Value: wanTrafficFilterValueModel{
+				Protocol: types.StringValue("tcp"),
+				Source:   wanSourceModel{Port: types.StringUnknown(), Cidr: types.StringValue("192.168.1.0/24"), Vlan: types.Int64Null(), Host: types.Int64Null()},
+				Destination: wanDestinationModel{
+					Port: types.StringValue("443"),
+					Cidr: types.StringUnknown(),
+				},
+			},
+		}},
+		PreferredUplink: types.StringValue("wan1"),
+	}
+}
+
+func testVpnPreference(filterType string, id *string, source *vpnSourceModel, destination *vpnDestinationModel, class *performanceClassModel) vpnPreferenceModel {
+	return vpnPreferenceModel{
+		TrafficFilters: []vpnTrafficFilterModel{{
+			Type: types.StringValue(filterType),
+			Value: vpnTrafficFilterValueModel{
+				Id:          types.StringPointerValue(id),
+				Protocol:    types.StringUnknown(),
+				Source:      source,
+				Destination: destination,
+			},
+		}},
+		PreferredUplink:   types.StringValue("bestForVoIP"),
+		FailOverCriterion: types.StringUnknown(),
+		PerformanceClass:  class,
+	}
+}
+
+func testPerformanceClass(classType, builtinName, customId string) *performanceClassModel {
+	class := &performanceClassModel{
+		Type:                        types.StringValue(classType),
+		BuiltinPerformanceClassName: types.StringNull(),
+		CustomPerformanceClassId:    types.StringNull(),
+	}
+	if builtinName != "" {
+		class.BuiltinPerformanceClassName = types.StringValue(builtinName)
+	}
+	if customId != "" {
+		class.CustomPerformanceClassId = types.StringValue(customId)
+	}
+	return class
+}
+
+func testSource() *vpnSourceModel {
+	return &vpnSourceModel{Port: types.StringUnknown(), Cidr: types.StringValue("10.0.0.0/8"), Network: types.StringNull(), Vlan: types.Int64Null(), Host: types.Int64Null()}
+}
+
+func testDestination() *vpnDestinationModel {
+	return &vpnDestinationModel{Port: types.StringUnknown(), Cidr: types.StringUnknown(), Network: types.StringNull(), Vlan: types.Int64Null(), Host: types.Int64Null(), Fqdn: types.StringValue("example.org")}
+}
+
+func TestSelectionPayload(t *testing.T) {
+	ctx := context.Background()
+	applicationId := "meraki:layer7/application/3"
+
+	// Test case: Unknown settings are left out
+	data := testResourceModel(t,
+		[]wanPreferenceModel{testWanPreference()},
+		[]vpnPreferenceModel{
+			testVpnPreference("application", &applicationId, nil, nil, testPerformanceClass("builtin", "VoIP", "")),
+			testVpnPreference("custom", nil, testSource(), testDestination(), nil),
+		},
+	)
+	payload, diags := selectionPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"defaultUplink": "wan2",
+		"loadBalancingEnabled": false,
+		"wanTrafficUplinkPreferences": [
+			{"trafficFilters": [{"type": "custom", "value": {"protocol": "tcp", "source": {"cidr": "192.168.1.0/24"}, "destination": {"port": "443"}}}], "preferredUplink": "wan1"}
+		],
+		"vpnTrafficUplinkPreferences": [
+			{"trafficFilters": [{"type": "application", "value": {"id": "meraki:layer7/application/3"}}], "preferredUplink": "bestForVoIP", "performanceClass": {"type": "builtin", "builtinPerformanceClassName": "VoIP"}},
+			{"trafficFilters": [{"type": "custom", "value": {"source": {"cidr": "10.0.0.0/8"}, "destination": {"fqdn": "example.org"}}}], "preferredUplink": "bestForVoIP"}
+		]
+	}`, string(body))
+
+	// Test case: Empty preferences are sent to remove the preferences in the Dashboard
+	data = testResourceModel(t, nil, nil)
+	data.FailoverAndFailbackImmediateEnabled = types.BoolValue(false)
+	payload, diags = selectionPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err = json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"defaultUplink": "wan2", "loadBalancingEnabled": false, "failoverAndFailback": {"immediate": {"enabled": false}}, "wanTrafficUplinkPreferences": [], "vpnTrafficUplinkPreferences": []}`, string(body))
+}
+
+func TestReadSelection(t *testing.T) {
+	ctx := context.Background()
+
+	data := testResourceModel(t, nil, nil)
+	response := map[string]interface{}{
+		"activeActiveAutoVpnEnabled": true,
+		"defaultUplink":              "wan1",
+		"loadBalancingEnabled":       true,
+		"failoverAndFailback":        map[string]interface{}{"immediate": map[string]interface{}{"enabled": true}},
+		"wanTrafficUplinkPreferences": []interface{}{
+			map[string]interface{}{
+				"trafficFilters": []interface{}{
+					map[string]interface{}{"type": "custom", "value": map[string]interface{}{
+						"protocol":    "tcp",
+						"source":      map[string]interface{}{"port": "any", "cidr": "192.168.1.0/24"},
+						"destination": map[string]interface{}{"port": "443", "cidr": "any"},
+					}},
+				},
+				"preferredUplink": "wan1",
+			},
+		},
+		"vpnTrafficUplinkPreferences": []interface{}{
+			map[string]interface{}{
+				"trafficFilters": []interface{}{
+					map[string]interface{}{"type": "application", "value": map[string]interface{}{"id": "meraki:layer7/application/3"}},
+				},
+				"preferredUplink":   "bestForVoIP",
+				"failOverCriterion": "poorPerformance",
+				"performanceClass":  map[string]interface{}{"type": "custom", "customPerformanceClassId": "123456"},
+			},
+		},
+	}
+	require.False(t, readSelection(ctx, &data, response).HasError())
+
+	var wanPreferences []wanPreferenceModel
+	require.False(t, data.WanTrafficUplinkPreferences.ElementsAs(ctx, &wanPreferences, false).HasError())
+	var vpnPreferences []vpnPreferenceModel
+	require.False(t, data.VpnTrafficUplinkPreferences.ElementsAs(ctx, &vpnPreferences, false).HasError())
+
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	assert.True(t, data.ActiveActiveAutoVpnEnabled.ValueBool())
+	assert.Equal(t, "wan1", data.DefaultUplink.ValueString())
+	assert.True(t, data.FailoverAndFailbackImmediateEnabled.ValueBool())
+
+	require.Len(t, wanPreferences, 1)
+	assert.Equal(t, wanSourceModel{Port: types.StringValue("any"), Cidr: types.StringValue("192.168.1.0/24"), Vlan: types.Int64Null(), Host: types.Int64Null()}, wanPreferences[0].TrafficFilters[0].Value.Source)
+	assert.Equal(t, "443", wanPreferences[0].TrafficFilters[0].Value.Destination.Port.ValueString())
+
+	require.Len(t, vpnPreferences, 1)
+	assert.Equal(t, "meraki:layer7/application/3", vpnPreferences[0].TrafficFilters[0].Value.Id.ValueString())
+	assert.Nil(t, vpnPreferences[0].TrafficFilters[0].Value.Source)
+	assert.True(t, vpnPreferences[0].TrafficFilters[0].Value.Protocol.IsNull())
+	assert.Equal(t, "poorPerformance", vpnPreferences[0].FailOverCriterion.ValueString())
+	assert.Equal(t, testPerformanceClass("custom", "", "123456"), vpnPreferences[0].PerformanceClass)
+}
+
+func TestValidateSelection(t *testing.T) {
+	ctx := context.Background()
+	applicationId := "meraki:layer7/application/3"
+
+	tests := []struct {
+		name       string
+		preference vpnPreferenceModel
+		valid      bool
+	}{
+		{name: "application", preference: testVpnPreference("application", &applicationId, nil, nil, nil), valid: true},
+		{name: "custom", preference: testVpnPreference("custom", nil, testSource(), testDestination(), nil), valid: true},
+		{name: "application without id", preference: testVpnPreference("applicationCategory", nil, nil, nil, nil)},
+		{name: "application with source", preference: testVpnPreference("application", &applicationId, testSource(), nil, nil)},
+		{name: "custom without destination", preference: testVpnPreference("custom", nil, testSource(), nil, nil)},
+		{name: "builtin class", preference: testVpnPreference("application", &applicationId, nil, nil, testPerformanceClass("builtin", "VoIP", "")), valid: true},
+		{name: "custom class", preference: testVpnPreference("application", &applicationId, nil, nil, testPerformanceClass("custom", "", "123456")), valid: true},
+		{name: "builtin class without name", preference: testVpnPreference("application", &applicationId, nil, nil, testPerformanceClass("builtin", "", "123456"))},
+		{name: "custom class without id", preference: testVpnPreference("application", &applicationId, nil, nil, testPerformanceClass("custom", "VoIP", ""))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel(t, nil, []vpnPreferenceModel{tt.preference})
+			diags := validateSelection(ctx, &data)
+			assert.Equal(t, !tt.valid, diags.HasError(), diags)
+		})
+	}
+}
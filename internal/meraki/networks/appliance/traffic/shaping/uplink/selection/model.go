@@ -0,0 +1,193 @@
+package selection
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the uplink selection settings of a network appliance.
+type resourceModel struct {
+	Id                                  types.String `tfsdk:"id"`
+	NetworkId                           types.String `tfsdk:"network_id"`
+	ActiveActiveAutoVpnEnabled          types.Bool   `tfsdk:"active_active_auto_vpn_enabled"`
+	DefaultUplink                       types.String `tfsdk:"default_uplink"`
+	LoadBalancingEnabled                types.Bool   `tfsdk:"load_balancing_enabled"`
+	FailoverAndFailbackImmediateEnabled types.Bool   `tfsdk:"failover_and_failback_immediate_enabled"`
+	WanTrafficUplinkPreferences         types.List   `tfsdk:"wan_traffic_uplink_preferences"`
+	VpnTrafficUplinkPreferences         types.List   `tfsdk:"vpn_traffic_uplink_preferences"`
+}
+
+type wanPreferenceModel struct {
+	TrafficFilters  []wanTrafficFilterModel `tfsdk:"traffic_filters"`
+	PreferredUplink types.String            `tfsdk:"preferred_uplink"`
+}
+
+type wanTrafficFilterModel struct {
+	Type  types.String               `tfsdk:"type"`
+	Value wanTrafficFilterValueModel `tfsdk:"value"`
+}
+
+type wanTrafficFilterValueModel struct {
+	Protocol    types.String        `tfsdk:"protocol"`
+	Source      wanSourceModel      `tfsdk:"source"`
+	Destination wanDestinationModel `tfsdk:"destination"`
+}
+
+type wanSourceModel struct {
+	Port types.String `tfsdk:"port"`
+	Cidr types.String `tfsdk:"cidr"`
+	Vlan types.Int64  `tfsdk:"vlan"`
+	Host types.Int64  `tfsdk:"host"`
+}
+
+type wanDestinationModel struct {
+	Port types.String `tfsdk:"port"`
+	Cidr types.String `tfsdk:"cidr"`
+}
+
+type vpnPreferenceModel struct {
+	TrafficFilters    []vpnTrafficFilterModel `tfsdk:"traffic_filters"`
+	PreferredUplink   types.String            `tfsdk:"preferred_uplink"`
+	FailOverCriterion types.String            `tfsdk:"fail_over_criterion"`
+	PerformanceClass  *performanceClassModel  `tfsdk:"performance_class"`
+}
+
+type vpnTrafficFilterModel struct {
+	Type  types.String               `tfsdk:"type"`
+	Value vpnTrafficFilterValueModel `tfsdk:"value"`
+}
+
+type vpnTrafficFilterValueModel struct {
+	Id          types.String         `tfsdk:"id"`
+	Protocol    types.String         `tfsdk:"protocol"`
+	Source      *vpnSourceModel      `tfsdk:"source"`
+	Destination *vpnDestinationModel `tfsdk:"destination"`
+}
+
+type vpnSourceModel struct {
+	Port    types.String `tfsdk:"port"`
+	Cidr    types.String `tfsdk:"cidr"`
+	Network types.String `tfsdk:"network"`
+	Vlan    types.Int64  `tfsdk:"vlan"`
+	Host    types.Int64  `tfsdk:"host"`
+}
+
+type vpnDestinationModel struct {
+	Port    types.String `tfsdk:"port"`
+	Cidr    types.String `tfsdk:"cidr"`
+	Network types.String `tfsdk:"network"`
+	Vlan    types.Int64  `tfsdk:"vlan"`
+	Host    types.Int64  `tfsdk:"host"`
+	Fqdn    types.String `tfsdk:"fqdn"`
+}
+
+type performanceClassModel struct {
+	Type                        types.String `tfsdk:"type"`
+	BuiltinPerformanceClassName types.String `tfsdk:"builtin_performance_class_name"`
+	CustomPerformanceClassId    types.String `tfsdk:"custom_performance_class_id"`
+}
+
+func wanPreferenceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"traffic_filters": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"type": types.StringType,
+			"value": types.ObjectType{AttrTypes: map[string]attr.Type{
+				"protocol": types.StringType,
+				"source": types.ObjectType{AttrTypes: map[string]attr.Type{
+					"port": types.StringType,
+					"cidr": types.StringType,
+					"vlan": types.Int64Type,
+					"host": types.Int64Type,
+				}},
+				"destination": types.ObjectType{AttrTypes: map[string]attr.Type{
+					"port": types.StringType,
+					"cidr": types.StringType,
+				}},
+			}},
+		}}},
+		"preferred_uplink": types.StringType,
+	}
+}
+
+func vpnPreferenceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"traffic_filters": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"type": types.StringType,
+			"value": types.ObjectType{AttrTypes: map[string]attr.Type{
+				"id":       types.StringType,
+				"protocol": types.StringType,
+				"source": types.ObjectType{AttrTypes: map[string]attr.Type{
+					"port":    types.StringType,
+					"cidr":    types.StringType,
+					"network": types.StringType,
+					"vlan":    types.Int64Type,
+					"host":    types.Int64Type,
+				}},
+				"destination": types.ObjectType{AttrTypes: map[string]attr.Type{
+					"port":    types.StringType,
+					"cidr":    types.StringType,
+					"network": types.StringType,
+					"vlan":    types.Int64Type,
+					"host":    types.Int64Type,
+					"fqdn":    types.StringType,
+				}},
+			}},
+		}}},
+		"preferred_uplink":    types.StringType,
+		"fail_over_criterion": types.StringType,
+		"performance_class": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"type":                           types.StringType,
+			"builtin_performance_class_name": types.StringType,
+			"custom_performance_class_id":    types.StringType,
+		}},
+	}
+}
+
+// apiUplinkSelection is the uplink selection settings of a network in the format of the Dashboard API. The WAN and
+// VPN traffic uplink preferences share the same format, the WAN preferences only use a subset of it.
+type apiUplinkSelection struct {
+	ActiveActiveAutoVpnEnabled  *bool                   `json:"activeActiveAutoVpnEnabled,omitempty"`
+	DefaultUplink               *string                 `json:"defaultUplink,omitempty"`
+	LoadBalancingEnabled        *bool                   `json:"loadBalancingEnabled,omitempty"`
+	FailoverAndFailback         *apiFailoverAndFailback `json:"failoverAndFailback,omitempty"`
+	WanTrafficUplinkPreferences []apiPreference         `json:"wanTrafficUplinkPreferences"`
+	VpnTrafficUplinkPreferences []apiPreference         `json:"vpnTrafficUplinkPreferences"`
+}
+
+type apiFailoverAndFailback struct {
+	Immediate struct {
+		Enabled bool `json:"enabled"`
+	} `json:"immediate"`
+}
+
+type apiPreference struct {
+	TrafficFilters    []apiTrafficFilter   `json:"trafficFilters"`
+	PreferredUplink   string               `json:"preferredUplink"`
+	FailOverCriterion *string              `json:"failOverCriterion,omitempty"`
+	PerformanceClass  *apiPerformanceClass `json:"performanceClass,omitempty"`
+}
+
+type apiTrafficFilter struct {
+	Type  string `json:"type"`
+	Value struct {
+		Id          *string      `json:"id,omitempty"`
+		Protocol    *string      `json:"protocol,omitempty"`
+		Source      *apiEndpoint `json:"source,omitempty"`
+		Destination *apiEndpoint `json:"destination,omitempty"`
+	} `json:"value"`
+}
+
+type apiEndpoint struct {
+	Port    *string `json:"port,omitempty"`
+	Cidr    *string `json:"cidr,omitempty"`
+	Network *string `json:"network,omitempty"`
+	Vlan    *int64  `json:"vlan,omitempty"`
+	Host    *int64  `json:"host,omitempty"`
+	Fqdn    *string `json:"fqdn,omitempty"`
+}
+
+type apiPerformanceClass struct {
+	Type                        string  `json:"type"`
+	BuiltinPerformanceClassName *string `json:"builtinPerformanceClassName,omitempty"`
+	CustomPerformanceClassId    *string `json:"customPerformanceClassId,omitempty"`
+}
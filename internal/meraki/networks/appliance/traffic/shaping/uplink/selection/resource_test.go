@@ -0,0 +1,110 @@
+package selection_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceTrafficShapingUplinkSelectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_traffic_shaping_uplink_selection"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_traffic_shaping_uplink_selection"),
+			},
+
+			// Create and Read Uplink Selection
+			{
+				Config: NetworksApplianceTrafficShapingUplinkSelectionResourceConfig("wan1"),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_traffic_shaping_uplink_selection.test", map[string]string{
+					"default_uplink":                   "wan1",
+					"load_balancing_enabled":           "false",
+					"wan_traffic_uplink_preferences.#": "1",
+					"wan_traffic_uplink_preferences.0.traffic_filters.0.value.source.cidr":              "192.168.1.0/24",
+					"wan_traffic_uplink_preferences.0.traffic_filters.0.value.destination.port":         "443",
+					"wan_traffic_uplink_preferences.0.preferred_uplink":                                 "wan2",
+					"vpn_traffic_uplink_preferences.#":                                                  "2",
+					"vpn_traffic_uplink_preferences.0.traffic_filters.0.type":                           "application",
+					"vpn_traffic_uplink_preferences.0.performance_class.builtin_performance_class_name": "VoIP",
+					"vpn_traffic_uplink_preferences.1.traffic_filters.0.value.destination.fqdn":         "example.org",
+				}),
+			},
+
+			// Update and Read Uplink Selection
+			{
+				Config: NetworksApplianceTrafficShapingUplinkSelectionResourceConfig("wan2"),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_traffic_shaping_uplink_selection.test", map[string]string{
+					"default_uplink": "wan2",
+				}),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_appliance_traffic_shaping_uplink_selection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksApplianceTrafficShapingUplinkSelectionResourceConfig(defaultUplink string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_appliance_traffic_shaping_uplink_selection" "test" {
+    network_id = resource.meraki_network.test.network_id
+    default_uplink = "%s"
+    load_balancing_enabled = false
+    wan_traffic_uplink_preferences = [
+        {
+            traffic_filters = [
+                {
+                    type = "custom"
+                    value = {
+                        protocol = "tcp"
+                        source = { cidr = "192.168.1.0/24" }
+                        destination = { port = "443" }
+                    }
+                }
+            ]
+            preferred_uplink = "wan2"
+        }
+    ]
+    vpn_traffic_uplink_preferences = [
+        {
+            traffic_filters = [
+                { type = "application", value = { id = "meraki:layer7/application/3" } }
+            ]
+            preferred_uplink = "bestForVoIP"
+            fail_over_criterion = "poorPerformance"
+            performance_class = { type = "builtin", builtin_performance_class_name = "VoIP" }
+        },
+        {
+            traffic_filters = [
+                {
+                    type = "custom"
+                    value = {
+                        source = { cidr = "any" }
+                        destination = { fqdn = "example.org" }
+                    }
+                }
+            ]
+            preferred_uplink = "wan1"
+        }
+    ]
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_traffic_shaping_uplink_selection"),
+		defaultUplink,
+	)
+}
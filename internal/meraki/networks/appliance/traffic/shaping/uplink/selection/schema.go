@@ -0,0 +1,307 @@
+package selection
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the uplink selection settings of a network appliance. The WAN and VPN traffic uplink preferences are evaluated in order and replace any preferences configured in the Dashboard. Deleting this resource removes the preferences and restores the default settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active_active_auto_vpn_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether active-active AutoVPN is enabled",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_uplink": schema.StringAttribute{
+				MarkdownDescription: "The default uplink: 'wan1' or 'wan2'",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("wan1", "wan2"),
+				},
+			},
+			"load_balancing_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether load balancing is enabled",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"failover_and_failback_immediate_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether immediate WAN transition terminates established connections",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wan_traffic_uplink_preferences": schema.ListNestedAttribute{
+				MarkdownDescription: "The uplink preferences of the WAN traffic, in order. Defaults to no preferences.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: wanPreferenceAttrTypes()}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"traffic_filters": schema.ListNestedAttribute{
+							MarkdownDescription: "The traffic the preference applies to",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of traffic filter: 'custom'",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("custom"),
+										},
+									},
+									"value": schema.SingleNestedAttribute{
+										MarkdownDescription: "The traffic matched by the filter",
+										Required:            true,
+										Attributes: map[string]schema.Attribute{
+											"protocol": schema.StringAttribute{
+												MarkdownDescription: "The protocol: 'tcp', 'udp', 'icmp6' or 'any'",
+												Optional:            true,
+												Computed:            true,
+												Validators: []validator.String{
+													stringvalidator.OneOf("tcp", "udp", "icmp6", "any"),
+												},
+											},
+											"source": schema.SingleNestedAttribute{
+												MarkdownDescription: "The source of the traffic",
+												Required:            true,
+												Attributes: map[string]schema.Attribute{
+													"port": schema.StringAttribute{
+														MarkdownDescription: "A port or port range, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+													"cidr": schema.StringAttribute{
+														MarkdownDescription: "An IP address or subnet in CIDR notation, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+													"vlan": schema.Int64Attribute{
+														MarkdownDescription: "A VLAN ID. Only available in template networks.",
+														Optional:            true,
+													},
+													"host": schema.Int64Attribute{
+														MarkdownDescription: "A host ID in the VLAN, used along with `vlan`. Only available in template networks.",
+														Optional:            true,
+													},
+												},
+											},
+											"destination": schema.SingleNestedAttribute{
+												MarkdownDescription: "The destination of the traffic",
+												Required:            true,
+												Attributes: map[string]schema.Attribute{
+													"port": schema.StringAttribute{
+														MarkdownDescription: "A port or port range, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+													"cidr": schema.StringAttribute{
+														MarkdownDescription: "An IP address or subnet in CIDR notation, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"preferred_uplink": schema.StringAttribute{
+							MarkdownDescription: "The preferred uplink: 'wan1' or 'wan2'",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("wan1", "wan2"),
+							},
+						},
+					},
+				},
+			},
+			"vpn_traffic_uplink_preferences": schema.ListNestedAttribute{
+				MarkdownDescription: "The uplink preferences of the VPN traffic, in order. Defaults to no preferences.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: vpnPreferenceAttrTypes()}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"traffic_filters": schema.ListNestedAttribute{
+							MarkdownDescription: "The traffic the preference applies to",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of traffic filter: 'application', 'applicationCategory' or 'custom'",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("application", "applicationCategory", "custom"),
+										},
+									},
+									"value": schema.SingleNestedAttribute{
+										MarkdownDescription: "The traffic matched by the filter",
+										Required:            true,
+										Attributes: map[string]schema.Attribute{
+											"id": schema.StringAttribute{
+												MarkdownDescription: "The ID of the application or application category. Required for 'application' and 'applicationCategory' filters.",
+												Optional:            true,
+											},
+											"protocol": schema.StringAttribute{
+												MarkdownDescription: "The protocol: 'tcp', 'udp', 'icmp', 'icmp6' or 'any'",
+												Optional:            true,
+												Computed:            true,
+												Validators: []validator.String{
+													stringvalidator.OneOf("tcp", "udp", "icmp", "icmp6", "any"),
+												},
+											},
+											"source": schema.SingleNestedAttribute{
+												MarkdownDescription: "The source of the traffic. Required for 'custom' filters.",
+												Optional:            true,
+												Attributes: map[string]schema.Attribute{
+													"port": schema.StringAttribute{
+														MarkdownDescription: "A port or port range, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+													"cidr": schema.StringAttribute{
+														MarkdownDescription: "An IP address or subnet in CIDR notation, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+													"network": schema.StringAttribute{
+														MarkdownDescription: "The ID of a network in the organization",
+														Optional:            true,
+													},
+													"vlan": schema.Int64Attribute{
+														MarkdownDescription: "A VLAN ID. Only available in template networks.",
+														Optional:            true,
+													},
+													"host": schema.Int64Attribute{
+														MarkdownDescription: "A host ID in the VLAN, used along with `vlan`. Only available in template networks.",
+														Optional:            true,
+													},
+												},
+											},
+											"destination": schema.SingleNestedAttribute{
+												MarkdownDescription: "The destination of the traffic. Required for 'custom' filters.",
+												Optional:            true,
+												Attributes: map[string]schema.Attribute{
+													"port": schema.StringAttribute{
+														MarkdownDescription: "A port or port range, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+													"cidr": schema.StringAttribute{
+														MarkdownDescription: "An IP address or subnet in CIDR notation, or 'any'",
+														Optional:            true,
+														Computed:            true,
+													},
+													"network": schema.StringAttribute{
+														MarkdownDescription: "The ID of a network in the organization",
+														Optional:            true,
+													},
+													"vlan": schema.Int64Attribute{
+														MarkdownDescription: "A VLAN ID. Only available in template networks.",
+														Optional:            true,
+													},
+													"host": schema.Int64Attribute{
+														MarkdownDescription: "A host ID in the VLAN, used along with `vlan`. Only available in template networks.",
+														Optional:            true,
+													},
+													"fqdn": schema.StringAttribute{
+														MarkdownDescription: "A fully qualified domain name",
+														Optional:            true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"preferred_uplink": schema.StringAttribute{
+							MarkdownDescription: "The preferred uplink: 'wan1', 'wan2', 'bestForVoIP', 'loadBalancing' or 'defaultUplink'",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("wan1", "wan2", "bestForVoIP", "loadBalancing", "defaultUplink"),
+							},
+						},
+						"fail_over_criterion": schema.StringAttribute{
+							MarkdownDescription: "The criterion for failing over to the other uplink: 'poorPerformance' or 'uplinkDown'",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("poorPerformance", "uplinkDown"),
+							},
+						},
+						"performance_class": schema.SingleNestedAttribute{
+							MarkdownDescription: "The performance class the uplink must meet, used with the 'poorPerformance' criterion",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of performance class: 'builtin' or 'custom'",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("builtin", "custom"),
+									},
+								},
+								"builtin_performance_class_name": schema.StringAttribute{
+									MarkdownDescription: "The name of the builtin performance class: 'VoIP'. Required for 'builtin' performance classes.",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("VoIP"),
+									},
+								},
+								"custom_performance_class_id": schema.StringAttribute{
+									MarkdownDescription: "The ID of the custom performance class. Required for 'custom' performance classes.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
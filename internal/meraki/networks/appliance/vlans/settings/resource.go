@@ -34,18 +34,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -61,12 +61,9 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	updateNetworksApplianceVlansSettings := *openApiClient.NewUpdateNetworkApplianceVlansSettingsRequest()
 	updateNetworksApplianceVlansSettings.SetVlansEnabled(data.VlansEnabled.ValueBool())
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceVlansSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceVlansSettingsRequest(updateNetworksApplianceVlansSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceVlansSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceVlansSettingsRequest(updateNetworksApplianceVlansSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -111,12 +108,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.SettingsApi.GetNetworkApplianceVlansSettings(context.Background(), data.NetworkId.ValueString()).Execute()
+	_, httpResp, err := r.client.SettingsApi.GetNetworkApplianceVlansSettings(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -166,12 +160,9 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	updateNetworksApplianceVlansSettings := *openApiClient.NewUpdateNetworkApplianceVlansSettingsRequest()
 	updateNetworksApplianceVlansSettings.SetVlansEnabled(data.VlansEnabled.ValueBool())
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceVlansSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceVlansSettingsRequest(updateNetworksApplianceVlansSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceVlansSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceVlansSettingsRequest(updateNetworksApplianceVlansSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -219,12 +210,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	updateNetworksApplianceVlansSettings := *openApiClient.NewUpdateNetworkApplianceVlansSettingsRequest()
 	updateNetworksApplianceVlansSettings.SetVlansEnabled(data.VlansEnabled.ValueBool())
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceVlansSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkApplianceVlansSettingsRequest(updateNetworksApplianceVlansSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkApplianceVlansSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceVlansSettingsRequest(updateNetworksApplianceVlansSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -4,16 +4,20 @@ import (
 	"context"
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var (
-	_ resource.Resource                = &Resource{}
-	_ resource.ResourceWithConfigure   = &Resource{}
-	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.Resource                   = &Resource{}
+	_ resource.ResourceWithConfigure      = &Resource{}
+	_ resource.ResourceWithImportState    = &Resource{}
+	_ resource.ResourceWithValidateConfig = &Resource{}
 )
 
 func NewResource() resource.Resource {
@@ -22,7 +26,8 @@ func NewResource() resource.Resource {
 
 // Resource defines the resource implementation.
 type Resource struct {
-	client *openApiClient.APIClient
+	client  *openApiClient.APIClient
+	batches *utils.ActionBatcher
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,18 +40,38 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.batches = providerData.ActionBatches
+}
+
+// ValidateConfig checks that the appliance IP is a usable address within the VLAN subnet.
+func (r *Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var subnet, applianceIp types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subnet"), &subnet)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("appliance_ip"), &applianceIp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if subnet.IsNull() || subnet.IsUnknown() || applianceIp.IsNull() || applianceIp.IsUnknown() {
+		return
+	}
+
+	if err := utils.ValidateApplianceIP(subnet.ValueString(), applianceIp.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("appliance_ip"), "Invalid Appliance IP", err.Error())
+	}
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -75,14 +100,23 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
+	// Queue the create and the follow-up update into a single action batch when enabled
+	if r.batches != nil {
+		resp.Diagnostics.Append(r.createWithActionBatch(ctx, data, payload)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		tflog.Info(ctx, "[finish] CREATE Function Call")
+		return
+	}
+
 	inlineResp, httpResp, err := r.client.ApplianceApi.CreateNetworkApplianceVlan(ctx, data.NetworkId.ValueString()).CreateNetworkApplianceVlanRequest(payload).Execute()
 
 	// Meraki API seems to return http status code 201 as an error.
 	if err != nil && httpResp.StatusCode != 201 {
-		resp.Diagnostics.AddError(
-			"HTTP Client Create Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Create Failure", httpResp, err))
 		return
 	}
 
@@ -118,10 +152,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	updateInlineResp, updateHttpResp, updateErr := r.client.ApplianceApi.UpdateNetworkApplianceVlan(ctx, data.NetworkId.ValueString(), vlanId).UpdateNetworkApplianceVlanRequest(*updatePayload).Execute()
 	if updateErr != nil && updateHttpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"HTTP Client Update Failure",
-			utils.HttpDiagnostics(updateHttpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Update Failure", updateHttpResp, updateErr))
 		return
 	}
 
@@ -179,10 +210,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 
 	inlineResp, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceVlan(ctx, data.NetworkId.ValueString(), vlanId).Execute()
 	if err != nil && httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"HTTP Client Read Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
 		return
 	}
 
@@ -246,12 +274,25 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	// API returns this as string, openAPI spec has set as Integer
 	vlanId := fmt.Sprintf("%v", data.VlanId.ValueInt64())
 
+	// Queue the update into an action batch when enabled
+	if r.batches != nil {
+		resp.Diagnostics.Append(r.submitActionBatch(ctx, data, utils.BatchOperationUpdate, payload)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.read(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		tflog.Info(ctx, "[finish] UPDATE Function Call")
+		return
+	}
+
 	inlineResp, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceVlan(ctx, data.NetworkId.ValueString(), vlanId).UpdateNetworkApplianceVlanRequest(*payload).Execute()
 	if err != nil && httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"HTTP Client Update Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Update Failure", httpResp, err))
 		return
 	}
 
@@ -307,12 +348,21 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	// API returns a string, OpenAPI spec defines an integer
 	vlanId := fmt.Sprintf("%v", data.VlanId.ValueInt64())
 
+	// Queue the delete into an action batch when enabled
+	if r.batches != nil {
+		resp.Diagnostics.Append(r.submitActionBatch(ctx, data, utils.BatchOperationDestroy, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.State.RemoveResource(ctx)
+		tflog.Info(ctx, "[finish] DELETE Function Call")
+		return
+	}
+
 	httpResp, err := r.client.ApplianceApi.DeleteNetworkApplianceVlan(ctx, data.NetworkId.ValueString(), vlanId).Execute()
 	if err != nil && httpResp.StatusCode != 204 {
-		resp.Diagnostics.AddError(
-			"HTTP Client Delete Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Delete Failure", httpResp, err))
 		return
 	}
 
@@ -339,3 +389,67 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	})
 
 }
+
+// createWithActionBatch submits the create and the update of settings that the create call does not accept in one
+// action batch, then reads back the VLAN, since action batches do not return the resources they change.
+func (r *Resource) createWithActionBatch(ctx context.Context, data *NetworksApplianceVLANModel, payload openApiClient.CreateNetworkApplianceVlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	updatePayload, updateDiags := UpdateHttpReqPayload(ctx, data)
+	diags.Append(updateDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	create, err := utils.NewBatchAction(fmt.Sprintf("/networks/%s/appliance/vlans", data.NetworkId.ValueString()), utils.BatchOperationCreate, payload)
+	if err != nil {
+		diags.AddError("Action Batch Failure", err.Error())
+		return diags
+	}
+	update, err := utils.NewBatchAction(vlanBatchResource(data), utils.BatchOperationUpdate, updatePayload)
+	if err != nil {
+		diags.AddError("Action Batch Failure", err.Error())
+		return diags
+	}
+
+	if _, err = r.batches.SubmitForNetwork(ctx, data.NetworkId.ValueString(), create, update); err != nil {
+		diags.AddError("Action Batch Failure", fmt.Sprintf("Could not create VLAN %d: %s", data.VlanId.ValueInt64(), err))
+		return diags
+	}
+
+	diags.Append(r.read(ctx, data)...)
+	return diags
+}
+
+// submitActionBatch submits a single update or destroy of the VLAN as part of an action batch.
+func (r *Resource) submitActionBatch(ctx context.Context, data *NetworksApplianceVLANModel, operation string, payload interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	action, err := utils.NewBatchAction(vlanBatchResource(data), operation, payload)
+	if err == nil {
+		_, err = r.batches.SubmitForNetwork(ctx, data.NetworkId.ValueString(), action)
+	}
+	if err != nil {
+		diags.AddError("Action Batch Failure", fmt.Sprintf("Could not %s VLAN %d: %s", operation, data.VlanId.ValueInt64(), err))
+	}
+	return diags
+}
+
+// read refreshes data from the VLAN returned by the Dashboard API.
+func (r *Resource) read(ctx context.Context, data *NetworksApplianceVLANModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inlineResp, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceVlan(ctx, data.NetworkId.ValueString(), fmt.Sprintf("%v", data.VlanId.ValueInt64())).Execute()
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return diags
+	}
+
+	diags.Append(ReadHttpResponse(ctx, data, inlineResp)...)
+	return diags
+}
+
+// vlanBatchResource returns the action batch resource path of the VLAN.
+func vlanBatchResource(data *NetworksApplianceVLANModel) string {
+	return fmt.Sprintf("/networks/%s/appliance/vlans/%d", data.NetworkId.ValueString(), data.VlanId.ValueInt64())
+}
@@ -0,0 +1,263 @@
+package bgp
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// hubMode is the site-to-site VPN mode of the networks that can run BGP.
+const hubMode = "hub"
+
+// bgpPayload returns the BGP payload for the planned data, with the neighbor passwords decrypted with keys.
+// Unknown values are left out so that the Dashboard keeps or defaults them.
+func bgpPayload(ctx context.Context, data *resourceModel, keys utils.EncryptionKeys) (openApiClient.UpdateNetworkApplianceVpnBgpRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var payload openApiClient.UpdateNetworkApplianceVpnBgpRequest
+
+	apiBgp := apiBgp{
+		Enabled: data.Enabled.ValueBool(),
+	}
+	if utils.IsKnown(data.AsNumber) {
+		apiBgp.AsNumber = data.AsNumber.ValueInt64Pointer()
+	}
+	if utils.IsKnown(data.IbgpHoldTimer) {
+		apiBgp.IbgpHoldTimer = data.IbgpHoldTimer.ValueInt64Pointer()
+	}
+
+	if utils.IsKnown(data.Neighbors) {
+		var neighbors []neighborModel
+		diags.Append(data.Neighbors.ElementsAs(ctx, &neighbors, false)...)
+		if diags.HasError() {
+			return payload, diags
+		}
+
+		for i, neighbor := range neighbors {
+			apiNeighbor := apiNeighbor{
+				Ip:             neighbor.Ip.ValueStringPointer(),
+				RemoteAsNumber: neighbor.RemoteAsNumber.ValueInt64(),
+				EbgpHoldTimer:  neighbor.EbgpHoldTimer.ValueInt64(),
+				EbgpMultihop:   neighbor.EbgpMultihop.ValueInt64(),
+			}
+			if neighbor.Ipv6 != nil {
+				apiNeighbor.Ipv6 = &struct {
+					Address string `json:"address"`
+				}{Address: neighbor.Ipv6.Address.ValueString()}
+			}
+			if utils.IsKnown(neighbor.ReceiveLimit) {
+				apiNeighbor.ReceiveLimit = neighbor.ReceiveLimit.ValueInt64Pointer()
+			}
+			if utils.IsKnown(neighbor.AllowTransit) {
+				apiNeighbor.AllowTransit = neighbor.AllowTransit.ValueBoolPointer()
+			}
+			if utils.IsKnown(neighbor.SourceInterface) {
+				apiNeighbor.SourceInterface = neighbor.SourceInterface.ValueStringPointer()
+			}
+			if utils.IsKnown(neighbor.NextHopIp) {
+				apiNeighbor.NextHopIp = neighbor.NextHopIp.ValueStringPointer()
+			}
+			if utils.IsKnown(neighbor.TtlSecurity) {
+				var ttlSecurity ttlSecurityModel
+				diags.Append(neighbor.TtlSecurity.As(ctx, &ttlSecurity, basetypes.ObjectAsOptions{})...)
+				apiNeighbor.TtlSecurity = &struct {
+					Enabled *bool `json:"enabled,omitempty"`
+				}{Enabled: ttlSecurity.Enabled.ValueBoolPointer()}
+			}
+			if neighbor.Authentication != nil {
+				password, _, err := keys.Decrypt(neighbor.Authentication.Password.ValueString())
+				if err != nil {
+					diags.Append(utils.NewEncryptionDiagnostic(path.Root("neighbors").AtListIndex(i).AtName("authentication").AtName("password"), err))
+					continue
+				}
+				apiNeighbor.Authentication = &struct {
+					Password *string `json:"password,omitempty"`
+				}{Password: &password}
+			}
+
+			apiBgp.Neighbors = append(apiBgp.Neighbors, apiNeighbor)
+		}
+	}
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	if err := utils.ConvertJSON(apiBgp, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the BGP payload: %s", err))
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that disables BGP on a network.
+func resetPayload() openApiClient.UpdateNetworkApplianceVpnBgpRequest {
+	return *openApiClient.NewUpdateNetworkApplianceVpnBgpRequest(false)
+}
+
+// readBgp sets data from the BGP configuration returned by the Dashboard API. The password of a neighbor keeps the
+// value of the neighbor with the same address in data when the Dashboard does not return it, or when it decrypts with
+// keys to the password returned by the Dashboard.
+func readBgp(ctx context.Context, keys utils.EncryptionKeys, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiBgp apiBgp
+	if err := utils.ConvertJSON(response, &apiBgp); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the BGP configuration: %s", err))
+		return diags
+	}
+
+	priorAuthentication := map[string]*authenticationModel{}
+	if utils.IsKnown(data.Neighbors) {
+		var priorNeighbors []neighborModel
+		diags.Append(data.Neighbors.ElementsAs(ctx, &priorNeighbors, true)...)
+		for _, neighbor := range priorNeighbors {
+			priorAuthentication[neighbor.address()] = neighbor.Authentication
+		}
+	}
+
+	data.Id = data.NetworkId
+	data.Enabled = types.BoolValue(apiBgp.Enabled)
+	data.AsNumber = types.Int64PointerValue(apiBgp.AsNumber)
+	data.IbgpHoldTimer = types.Int64PointerValue(apiBgp.IbgpHoldTimer)
+
+	neighbors := []neighborModel{}
+	for _, apiNeighbor := range apiBgp.Neighbors {
+		neighbor := neighborModel{
+			Ip:              types.StringPointerValue(apiNeighbor.Ip),
+			RemoteAsNumber:  types.Int64Value(apiNeighbor.RemoteAsNumber),
+			ReceiveLimit:    types.Int64PointerValue(apiNeighbor.ReceiveLimit),
+			AllowTransit:    types.BoolPointerValue(apiNeighbor.AllowTransit),
+			EbgpHoldTimer:   types.Int64Value(apiNeighbor.EbgpHoldTimer),
+			EbgpMultihop:    types.Int64Value(apiNeighbor.EbgpMultihop),
+			SourceInterface: types.StringPointerValue(apiNeighbor.SourceInterface),
+			NextHopIp:       types.StringPointerValue(apiNeighbor.NextHopIp),
+			TtlSecurity:     types.ObjectNull(ttlSecurityAttrTypes()),
+		}
+		if apiNeighbor.Ipv6 != nil {
+			neighbor.Ipv6 = &ipv6Model{Address: types.StringValue(apiNeighbor.Ipv6.Address)}
+		}
+		if apiNeighbor.TtlSecurity != nil {
+			var objectDiags diag.Diagnostics
+			neighbor.TtlSecurity, objectDiags = types.ObjectValue(ttlSecurityAttrTypes(), map[string]attr.Value{
+				"enabled": types.BoolPointerValue(apiNeighbor.TtlSecurity.Enabled),
+			})
+			diags.Append(objectDiags...)
+		}
+
+		var password *string
+		if apiNeighbor.Authentication != nil {
+			password = apiNeighbor.Authentication.Password
+		}
+		prior := priorAuthentication[apiNeighbor.address()]
+		switch {
+		case password == nil:
+			neighbor.Authentication = prior
+		case prior != nil && utils.IsKnown(prior.Password) && keys.Equivalent(prior.Password.ValueString(), *password):
+			neighbor.Authentication = prior
+		default:
+			neighbor.Authentication = &authenticationModel{Password: types.StringValue(*password)}
+		}
+
+		neighbors = append(neighbors, neighbor)
+	}
+
+	var listDiags diag.Diagnostics
+	data.Neighbors, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: neighborAttrTypes()}, neighbors)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// neighborPasswords describes the authentication passwords of the neighbors, which are matched by address across the
+// plan and the prior state.
+var neighborPasswords = utils.SensitiveList[neighborModel]{
+	Path:       path.Root("neighbors"),
+	SecretPath: []string{"authentication", "password"},
+	Secret: func(neighbor *neighborModel) *types.String {
+		if neighbor.Authentication == nil {
+			return nil
+		}
+		return &neighbor.Authentication.Password
+	},
+	Key: func(neighbor neighborModel) string { return neighbor.address() },
+}
+
+// sealPasswords encrypts the neighbor passwords in state with the provider's current encryption key. Values already
+// encrypted with the current key are left untouched.
+func sealPasswords(ctx context.Context, keys utils.EncryptionKeys, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.Neighbors, diags = neighborPasswords.Seal(ctx, keys, data.Neighbors)
+	return diags
+}
+
+// preservePasswords keeps the encrypted prior state value of the password of each planned neighbor when the neighbor
+// with the same address in state decrypts to the configured plaintext, so that encryption alone never produces a diff.
+func preservePasswords(ctx context.Context, keys utils.EncryptionKeys, plan, state *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	plan.Neighbors, diags = neighborPasswords.Preserve(ctx, keys, plan.Neighbors, state.Neighbors)
+	return diags
+}
+
+// validateBgp checks that neighbors are only configured with BGP enabled, that every neighbor has an address that no
+// other neighbor uses, since the passwords are matched to the neighbors by address, and that authentication has a
+// password.
+func validateBgp(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.Neighbors) {
+		return diags
+	}
+
+	if !data.Enabled.IsUnknown() && !data.Enabled.ValueBool() {
+		diags.AddAttributeError(path.Root("neighbors"), "BGP Disabled",
+			"BGP neighbors can only be configured when enabled is true")
+	}
+
+	var neighbors []neighborModel
+	diags.Append(data.Neighbors.ElementsAs(ctx, &neighbors, true)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	addresses := map[string]bool{}
+	for i, neighbor := range neighbors {
+		neighborPath := path.Root("neighbors").AtListIndex(i)
+		if neighbor.Authentication != nil && neighbor.Authentication.Password.IsNull() {
+			diags.AddAttributeError(neighborPath.AtName("authentication").AtName("password"), "Missing BGP Neighbor Password",
+				"BGP neighbor authentication requires a password")
+		}
+
+		if neighbor.Ip.IsUnknown() || (neighbor.Ipv6 != nil && neighbor.Ipv6.Address.IsUnknown()) {
+			continue
+		}
+		address := neighbor.address()
+		if address == "" {
+			continue
+		}
+		if addresses[address] {
+			diags.AddAttributeError(neighborPath, "Duplicate BGP Neighbor",
+				fmt.Sprintf("The address %q is used by more than one BGP neighbor", address))
+		}
+		addresses[address] = true
+	}
+
+	return diags
+}
+
+// validateHubMode checks that BGP is only enabled on a network whose site-to-site VPN mode is hub.
+func validateHubMode(enabled types.Bool, mode string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if enabled.ValueBool() && mode != hubMode {
+		diags.AddAttributeError(path.Root("enabled"), "BGP Requires Hub Mode",
+			fmt.Sprintf("BGP can only be enabled on networks in hub mode, but the site-to-site VPN mode of the network is %q. "+
+				"Set the mode with the meraki_networks_appliance_vpn_site_to_site_vpn resource first.", mode))
+	}
+
+	return diags
+}
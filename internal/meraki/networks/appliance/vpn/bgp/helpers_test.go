@@ -0,0 +1,247 @@
+package bgp
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testNeighbor(ip, password string) neighborModel {
+	neighbor := neighborModel{
+		Ip:              types.StringValue(ip),
+		RemoteAsNumber:  types.Int64Value(65001),
+		ReceiveLimit:    types.Int64Unknown(),
+		AllowTransit:    types.BoolValue(true),
+		EbgpHoldTimer:   types.Int64Value(180),
+		EbgpMultihop:    types.Int64Value(2),
+		SourceInterface: types.StringValue("wan1"),
+		NextHopIp:       types.StringUnknown(),
+		TtlSecurity:     types.ObjectUnknown(ttlSecurityAttrTypes()),
+	}
+	if password != "" {
+		neighbor.Authentication = &authenticationModel{Password: types.StringValue(password)}
+	}
+	return neighbor
+}
+
+func testIpv6Neighbor(address string) neighborModel {
+	neighbor := testNeighbor("", "")
+	neighbor.Ip = types.StringNull()
+	neighbor.Ipv6 = &ipv6Model{Address: types.StringValue(address)}
+	neighbor.TtlSecurity = types.ObjectValueMust(ttlSecurityAttrTypes(), map[string]attr.Value{
+		"enabled": types.BoolValue(true),
+	})
+	return neighbor
+}
+
+func testResourceModel(t *testing.T, neighbors ...neighborModel) resourceModel {
+	list := types.ListNull(types.ObjectType{AttrTypes: neighborAttrTypes()})
+	if neighbors != nil {
+		var diags diag.Diagnostics
+		list, diags = types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: neighborAttrTypes()}, neighbors)
+		require.False(t, diags.HasError())
+	}
+
+	return resourceModel{
+		Id:            types.StringUnknown(),
+		NetworkId:     types.StringValue("N_123456"),
+		Enabled:       types.BoolValue(true),
+		AsNumber:      types.Int64Value(64515),
+		IbgpHoldTimer: types.Int64Unknown(),
+		Neighbors:     list,
+	}
+}
+
+func testNeighbors(t *testing.T, data resourceModel) []neighborModel {
+	var neighbors []neighborModel
+	require.False(t, data.Neighbors.ElementsAs(context.Background(), &neighbors, false).HasError())
+	return neighbors
+}
+
+func TestBgpPayload(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	encrypted, err := keys.Encrypt("first")
+	require.NoError(t, err)
+	data := testResourceModel(t, testNeighbor("10.0.0.1", encrypted), testIpv6Neighbor("2001:db8::1"))
+
+	// Test case: Passwords are decrypted and unknown values are left out
+	payload, diags := bgpPayload(ctx, &data, keys)
+	require.False(t, diags.HasError(), diags)
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"enabled": true,
+		"asNumber": 64515,
+		"neighbors": [
+			{"ip": "10.0.0.1", "remoteAsNumber": 65001, "allowTransit": true, "ebgpHoldTimer": 180, "ebgpMultihop": 2,
+				"sourceInterface": "wan1", "authentication": {"password": "first"}},
+			{"ipv6": {"address": "2001:db8::1"}, "remoteAsNumber": 65001, "allowTransit": true, "ebgpHoldTimer": 180,
+				"ebgpMultihop": 2, "sourceInterface": "wan1", "ttlSecurity": {"enabled": true}}
+		]
+	}`, string(body))
+
+	// Test case: Passwords that fail authentication are not sent
+	_, diags = bgpPayload(ctx, &data, utils.EncryptionKeys{Key: "other"})
+	assert.True(t, diags.HasError())
+
+	// Test case: Unset neighbors are not sent, so that the Dashboard keeps them
+	data = testResourceModel(t)
+	payload, diags = bgpPayload(ctx, &data, keys)
+	require.False(t, diags.HasError(), diags)
+	assert.Nil(t, payload.Neighbors)
+}
+
+func TestReadBgp(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	sealed, err := keys.Encrypt("kept")
+	require.NoError(t, err)
+
+	response := map[string]interface{}{
+		"enabled":       true,
+		"asNumber":      64515,
+		"ibgpHoldTimer": 240,
+		"neighbors": []interface{}{
+			map[string]interface{}{"ip": "10.0.0.1", "remoteAsNumber": 65001, "receiveLimit": 0, "allowTransit": true,
+				"ebgpHoldTimer": 180, "ebgpMultihop": 2, "sourceInterface": "wan1", "nextHopIp": "10.0.0.1",
+				"ttlSecurity": map[string]interface{}{"enabled": false}, "authentication": map[string]interface{}{"password": "kept"}},
+			map[string]interface{}{"ip": "10.0.0.2", "remoteAsNumber": 65002, "ebgpHoldTimer": 180, "ebgpMultihop": 1,
+				"authentication": map[string]interface{}{"password": "changed"}},
+			map[string]interface{}{"ipv6": map[string]interface{}{"address": "2001:db8::1"}, "remoteAsNumber": 65003,
+				"ebgpHoldTimer": 180, "ebgpMultihop": 1},
+		},
+	}
+
+	ipv6Neighbor := testIpv6Neighbor("2001:db8::1")
+	ipv6Neighbor.Authentication = &authenticationModel{Password: types.StringValue(sealed)}
+	data := testResourceModel(t, testNeighbor("10.0.0.1", sealed), testNeighbor("10.0.0.2", sealed), ipv6Neighbor)
+
+	diags := readBgp(ctx, keys, &data, response)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_123456", data.Id.ValueString())
+	assert.Equal(t, int64(64515), data.AsNumber.ValueInt64())
+	assert.Equal(t, int64(240), data.IbgpHoldTimer.ValueInt64())
+
+	neighbors := testNeighbors(t, data)
+	require.Len(t, neighbors, 3)
+
+	// Test case: A password that matches the prior state keeps its encrypted value
+	assert.Equal(t, sealed, neighbors[0].Authentication.Password.ValueString())
+	assert.Equal(t, "10.0.0.1", neighbors[0].NextHopIp.ValueString())
+	assert.False(t, neighbors[0].TtlSecurity.IsNull())
+
+	// Test case: A password changed outside of Terraform is read in plaintext
+	assert.Equal(t, "changed", neighbors[1].Authentication.Password.ValueString())
+	assert.True(t, neighbors[1].TtlSecurity.IsNull())
+
+	// Test case: A password the Dashboard does not return keeps the prior state value
+	assert.True(t, neighbors[2].Ip.IsNull())
+	assert.Equal(t, "2001:db8::1", neighbors[2].Ipv6.Address.ValueString())
+	assert.Equal(t, sealed, neighbors[2].Authentication.Password.ValueString())
+
+	// Test case: A disabled configuration has no neighbors
+	data = testResourceModel(t)
+	require.False(t, readBgp(ctx, keys, &data, map[string]interface{}{"enabled": false}).HasError())
+	assert.False(t, data.Enabled.ValueBool())
+	assert.True(t, data.AsNumber.IsNull())
+	assert.Empty(t, data.Neighbors.Elements())
+}
+
+func TestSealAndPreservePasswords(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	state := testResourceModel(t, testNeighbor("10.0.0.1", "secret"), testNeighbor("10.0.0.2", ""))
+	require.False(t, sealPasswords(ctx, keys, &state).HasError())
+
+	neighbors := testNeighbors(t, state)
+	sealed := neighbors[0].Authentication.Password.ValueString()
+	assert.True(t, utils.IsEncrypted(sealed))
+	assert.Nil(t, neighbors[1].Authentication)
+
+	// Test case: An unchanged password keeps its encrypted state value in the plan
+	plan := testResourceModel(t, testNeighbor("10.0.0.1", "secret"))
+	require.False(t, preservePasswords(ctx, keys, &plan, &state).HasError())
+	assert.Equal(t, sealed, testNeighbors(t, plan)[0].Authentication.Password.ValueString())
+
+	// Test case: A changed password is planned in plaintext
+	plan = testResourceModel(t, testNeighbor("10.0.0.1", "changed"))
+	require.False(t, preservePasswords(ctx, keys, &plan, &state).HasError())
+	assert.Equal(t, "changed", testNeighbors(t, plan)[0].Authentication.Password.ValueString())
+
+	// Test case: A neighbor with a new address is planned in plaintext
+	plan = testResourceModel(t, testNeighbor("10.0.0.3", "secret"))
+	require.False(t, preservePasswords(ctx, keys, &plan, &state).HasError())
+	assert.Equal(t, "secret", testNeighbors(t, plan)[0].Authentication.Password.ValueString())
+}
+
+func TestValidateBgp(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		neighbors []neighborModel
+		enabled   bool
+		valid     bool
+	}{
+		{
+			name:      "valid",
+			neighbors: []neighborModel{testNeighbor("10.0.0.1", "secret"), testIpv6Neighbor("2001:db8::1")},
+			enabled:   true,
+			valid:     true,
+		},
+		{
+			name:    "disabled without neighbors",
+			enabled: false,
+			valid:   true,
+		},
+		{
+			name:      "neighbors while disabled",
+			neighbors: []neighborModel{testNeighbor("10.0.0.1", "")},
+			enabled:   false,
+		},
+		{
+			name: "authentication without password",
+			neighbors: []neighborModel{func() neighborModel {
+				neighbor := testNeighbor("10.0.0.1", "")
+				neighbor.Authentication = &authenticationModel{Password: types.StringNull()}
+				return neighbor
+			}()},
+			enabled: true,
+		},
+		{
+			name:      "duplicate address",
+			neighbors: []neighborModel{testNeighbor("10.0.0.1", ""), testNeighbor("10.0.0.1", "")},
+			enabled:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel(t, tt.neighbors...)
+			data.Enabled = types.BoolValue(tt.enabled)
+
+			diags := validateBgp(ctx, &data)
+			assert.Equal(t, !tt.valid, diags.HasError(), diags)
+		})
+	}
+}
+
+func TestValidateHubMode(t *testing.T) {
+	assert.False(t, validateHubMode(types.BoolValue(true), "hub").HasError())
+	assert.True(t, validateHubMode(types.BoolValue(true), "spoke").HasError())
+	assert.True(t, validateHubMode(types.BoolValue(true), "none").HasError())
+	assert.False(t, validateHubMode(types.BoolValue(false), "spoke").HasError())
+	assert.False(t, validateHubMode(types.BoolUnknown(), "spoke").HasError())
+}
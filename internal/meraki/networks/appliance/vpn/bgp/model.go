@@ -0,0 +1,129 @@
+package bgp
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the BGP configuration of a network.
+type resourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	NetworkId     types.String `tfsdk:"network_id"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	AsNumber      types.Int64  `tfsdk:"as_number"`
+	IbgpHoldTimer types.Int64  `tfsdk:"ibgp_hold_timer"`
+	Neighbors     types.List   `tfsdk:"neighbors"`
+}
+
+// neighborModel describes an eBGP neighbor. The authentication password is encrypted in state when the provider has
+// an encryption_key.
+type neighborModel struct {
+	Ip              types.String         `tfsdk:"ip"`
+	Ipv6            *ipv6Model           `tfsdk:"ipv6"`
+	RemoteAsNumber  types.Int64          `tfsdk:"remote_as_number"`
+	ReceiveLimit    types.Int64          `tfsdk:"receive_limit"`
+	AllowTransit    types.Bool           `tfsdk:"allow_transit"`
+	EbgpHoldTimer   types.Int64          `tfsdk:"ebgp_hold_timer"`
+	EbgpMultihop    types.Int64          `tfsdk:"ebgp_multihop"`
+	SourceInterface types.String         `tfsdk:"source_interface"`
+	NextHopIp       types.String         `tfsdk:"next_hop_ip"`
+	TtlSecurity     types.Object         `tfsdk:"ttl_security"`
+	Authentication  *authenticationModel `tfsdk:"authentication"`
+}
+
+type ipv6Model struct {
+	Address types.String `tfsdk:"address"`
+}
+
+type ttlSecurityModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+type authenticationModel struct {
+	Password types.String `tfsdk:"password"`
+}
+
+func ipv6AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"address": types.StringType,
+	}
+}
+
+func ttlSecurityAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled": types.BoolType,
+	}
+}
+
+func authenticationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"password": types.StringType,
+	}
+}
+
+func neighborAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ip":               types.StringType,
+		"ipv6":             types.ObjectType{AttrTypes: ipv6AttrTypes()},
+		"remote_as_number": types.Int64Type,
+		"receive_limit":    types.Int64Type,
+		"allow_transit":    types.BoolType,
+		"ebgp_hold_timer":  types.Int64Type,
+		"ebgp_multihop":    types.Int64Type,
+		"source_interface": types.StringType,
+		"next_hop_ip":      types.StringType,
+		"ttl_security":     types.ObjectType{AttrTypes: ttlSecurityAttrTypes()},
+		"authentication":   types.ObjectType{AttrTypes: authenticationAttrTypes()},
+	}
+}
+
+// apiBgp is the BGP configuration of a network in the format of the Dashboard API. The generated client returns the
+// configuration as a map, so responses are converted into this type.
+type apiBgp struct {
+	Enabled       bool          `json:"enabled"`
+	AsNumber      *int64        `json:"asNumber,omitempty"`
+	IbgpHoldTimer *int64        `json:"ibgpHoldTimer,omitempty"`
+	Neighbors     []apiNeighbor `json:"neighbors,omitempty"`
+}
+
+type apiNeighbor struct {
+	Ip   *string `json:"ip,omitempty"`
+	Ipv6 *struct {
+		Address string `json:"address"`
+	} `json:"ipv6,omitempty"`
+	RemoteAsNumber  int64   `json:"remoteAsNumber"`
+	ReceiveLimit    *int64  `json:"receiveLimit,omitempty"`
+	AllowTransit    *bool   `json:"allowTransit,omitempty"`
+	EbgpHoldTimer   int64   `json:"ebgpHoldTimer"`
+	EbgpMultihop    int64   `json:"ebgpMultihop"`
+	SourceInterface *string `json:"sourceInterface,omitempty"`
+	NextHopIp       *string `json:"nextHopIp,omitempty"`
+	TtlSecurity     *struct {
+		Enabled *bool `json:"enabled,omitempty"`
+	} `json:"ttlSecurity,omitempty"`
+	Authentication *struct {
+		Password *string `json:"password,omitempty"`
+	} `json:"authentication,omitempty"`
+}
+
+// address returns the IPv4 or IPv6 address that identifies the neighbor.
+func (n apiNeighbor) address() string {
+	if n.Ip != nil {
+		return *n.Ip
+	}
+	if n.Ipv6 != nil {
+		return n.Ipv6.Address
+	}
+	return ""
+}
+
+// address returns the configured IPv4 or IPv6 address that identifies the neighbor.
+func (n neighborModel) address() string {
+	if !n.Ip.IsNull() {
+		return n.Ip.ValueString()
+	}
+	if n.Ipv6 != nil {
+		return n.Ipv6.Address.ValueString()
+	}
+	return ""
+}
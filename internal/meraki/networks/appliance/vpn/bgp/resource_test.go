@@ -0,0 +1,137 @@
+package bgp_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksApplianceVpnBgpResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_vpn_bgp"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_appliance_vpn_bgp"),
+			},
+
+			// Claim the MX and set the network in hub mode, since BGP is checked against the VPN mode at plan time
+			{
+				Config: NetworksApplianceVpnBgpResourceConfigHub(os.Getenv("TF_ACC_MERAKI_MX_SERIAL")),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_vpn_site_to_site_vpn.test", map[string]string{
+					"mode": "hub",
+				}),
+			},
+
+			// Create and Read BGP
+			{
+				Config: NetworksApplianceVpnBgpResourceConfig(os.Getenv("TF_ACC_MERAKI_MX_SERIAL"), "180"),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_vpn_bgp.test", map[string]string{
+					"enabled":                             "true",
+					"as_number":                           "64515",
+					"ibgp_hold_timer":                     "120",
+					"neighbors.#":                         "2",
+					"neighbors.0.ip":                      "10.10.10.22",
+					"neighbors.0.remote_as_number":        "64343",
+					"neighbors.0.ebgp_hold_timer":         "180",
+					"neighbors.0.authentication.password": "Sample Password",
+					"neighbors.1.ipv6.address":            "2002::1234:abcd:ffff:c0a8:101",
+					"neighbors.1.ebgp_multihop":           "2",
+					"neighbors.1.ttl_security.enabled":    "true",
+				}),
+			},
+
+			// Update and Read BGP
+			{
+				Config: NetworksApplianceVpnBgpResourceConfig(os.Getenv("TF_ACC_MERAKI_MX_SERIAL"), "240"),
+				Check: utils.ResourceTestCheck("meraki_networks_appliance_vpn_bgp.test", map[string]string{
+					"neighbors.0.ebgp_hold_timer": "240",
+				}),
+			},
+
+			// Import State testing
+			{
+				ResourceName:      "meraki_networks_appliance_vpn_bgp.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The Dashboard may not return neighbor passwords
+				ImportStateVerifyIgnore: []string{"neighbors.0.authentication.%", "neighbors.0.authentication.password"},
+			},
+		},
+	})
+}
+
+// NetworksApplianceVpnBgpResourceConfigHub returns the configuration string for the test network with the MX claimed
+// and the site-to-site VPN in hub mode
+func NetworksApplianceVpnBgpResourceConfigHub(serial string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "meraki_networks_devices_claim" "test" {
+	depends_on = [resource.meraki_network.test]
+	network_id = resource.meraki_network.test.network_id
+	serials = ["%s"]
+}
+
+resource "meraki_networks_appliance_vpn_site_to_site_vpn" "test" {
+	depends_on = [resource.meraki_networks_devices_claim.test]
+	network_id = resource.meraki_network.test.network_id
+	mode = "hub"
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_appliance_vpn_bgp"),
+		serial,
+	)
+}
+
+// NetworksApplianceVpnBgpResourceConfig returns the configuration string for BGP with an IPv4 neighbor using MD5
+// authentication and an IPv6 neighbor using multihop, the IPv4 neighbor with the given eBGP hold timer
+func NetworksApplianceVpnBgpResourceConfig(serial, ebgpHoldTimer string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "meraki_networks_appliance_vpn_bgp" "test" {
+	depends_on = [resource.meraki_networks_appliance_vpn_site_to_site_vpn.test]
+	network_id = resource.meraki_network.test.network_id
+	enabled = true
+	as_number = 64515
+	ibgp_hold_timer = 120
+	neighbors = [
+		{
+			ip = "10.10.10.22"
+			remote_as_number = 64343
+			receive_limit = 120
+			allow_transit = true
+			ebgp_hold_timer = %s
+			ebgp_multihop = 1
+			source_interface = "wan1"
+			authentication = {
+				password = "Sample Password"
+			}
+		},
+		{
+			ipv6 = {
+				address = "2002::1234:abcd:ffff:c0a8:101"
+			}
+			remote_as_number = 64343
+			ebgp_hold_timer = 180
+			ebgp_multihop = 2
+			ttl_security = {
+				enabled = true
+			}
+		}
+	]
+}
+`,
+		NetworksApplianceVpnBgpResourceConfigHub(serial),
+		ebgpHoldTimer,
+	)
+}
@@ -0,0 +1,182 @@
+package bgp
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"math"
+	"regexp"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the BGP configuration of an MX network in site-to-site VPN hub mode, typically a VPN concentrator. " +
+			"BGP can only be enabled on networks in hub mode, which is checked at plan time against the site-to-site VPN settings of the network. " +
+			"Deleting this resource disables BGP. Neighbor passwords are encrypted in state when the provider has an `encryption_key`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 31),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether BGP is enabled. Requires the site-to-site VPN mode of the network to be hub.",
+				Required:            true,
+			},
+			"as_number": schema.Int64Attribute{
+				MarkdownDescription: "The Autonomous System Number of the Auto VPN domain, applied to every hub. " +
+					"Defaults to the ASN of the other hubs, or 64512. Limited to 2147483647 by the Dashboard API client.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, math.MaxInt32),
+				},
+			},
+			"ibgp_hold_timer": schema.Int64Attribute{
+				MarkdownDescription: "The iBGP hold timer in seconds, between 12 and 240. Defaults to 240.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(12, 240),
+				},
+			},
+			"neighbors": schema.ListNestedAttribute{
+				MarkdownDescription: "The eBGP neighbors. Replaces the neighbors configured in the Dashboard; the Dashboard keeps its neighbors when unset.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 address of the neighbor. Conflicts with `ipv6`.",
+							Optional:            true,
+							Validators: []validator.String{
+								utils.IPv4AddressValidator(),
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("ipv6")),
+							},
+						},
+						"ipv6": schema.SingleNestedAttribute{
+							MarkdownDescription: "The IPv6 neighbor. Conflicts with `ip`.",
+							Optional:            true,
+							Validators: []validator.Object{
+								objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("ip")),
+							},
+							Attributes: map[string]schema.Attribute{
+								"address": schema.StringAttribute{
+									MarkdownDescription: "The IPv6 address of the neighbor",
+									Required:            true,
+								},
+							},
+						},
+						"remote_as_number": schema.Int64Attribute{
+							MarkdownDescription: "The ASN of the neighbor. Limited to 2147483647 by the Dashboard API client.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, math.MaxInt32),
+							},
+						},
+						"receive_limit": schema.Int64Attribute{
+							MarkdownDescription: "The maximum number of routes received from the neighbor. Defaults to 0.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, math.MaxInt32),
+							},
+						},
+						"allow_transit": schema.BoolAttribute{
+							MarkdownDescription: "Whether routes learned from other Autonomous Systems are advertised, allowing traffic between Autonomous Systems to transit this AS. Defaults to false.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"ebgp_hold_timer": schema.Int64Attribute{
+							MarkdownDescription: "The eBGP hold timer in seconds, between 12 and 240",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(12, 240),
+							},
+						},
+						"ebgp_multihop": schema.Int64Attribute{
+							MarkdownDescription: "The number of hops to a neighbor that is not adjacent, between 1 and 255",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 255),
+							},
+						},
+						"source_interface": schema.StringAttribute{
+							MarkdownDescription: "The output interface for peering with the neighbor: 'wan1', 'wan2' or 'vlan{VLAN ID}'",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^(wan1|wan2|vlan\d+)$`), "must be 'wan1', 'wan2' or 'vlan{VLAN ID}'"),
+							},
+						},
+						"next_hop_ip": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 address of the remote BGP peer that establishes the TCP session with the MX",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								utils.IPv4AddressValidator(),
+							},
+						},
+						"ttl_security": schema.SingleNestedAttribute{
+							MarkdownDescription: "BGP TTL security settings",
+							Optional:            true,
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether BGP TTL security is enabled",
+									Required:            true,
+								},
+							},
+						},
+						"authentication": schema.SingleNestedAttribute{
+							MarkdownDescription: "MD5 authentication with the neighbor",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"password": schema.StringAttribute{
+									MarkdownDescription: "The MD5 authentication password. Required.",
+									Optional:            true,
+									Computed:            true,
+									Sensitive:           true,
+									PlanModifiers: []planmodifier.String{
+										utils.NewSensitivePlanModifier(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -35,18 +35,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -59,7 +59,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	payload, diags := NetworkApplianceVpnSiteToSiteVpnResourcePayload(context.Background(), data)
+	payload, diags := NetworkApplianceVpnSiteToSiteVpnResourcePayload(ctx, data)
 	if diags.HasError() {
 		resp.Diagnostics.AddError("Create Payload Error", fmt.Sprintf("\n%v", diags))
 		return
@@ -67,10 +67,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceVpnSiteToSiteVpn(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceVpnSiteToSiteVpnRequest(*payload).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Create HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Create HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -113,10 +110,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 
 	response, httpResp, err := r.client.ApplianceApi.GetNetworkApplianceVpnSiteToSiteVpn(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Read HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Read HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -159,7 +153,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	payload, diags := NetworkApplianceVpnSiteToSiteVpnResourcePayload(context.Background(), data)
+	payload, diags := NetworkApplianceVpnSiteToSiteVpnResourcePayload(ctx, data)
 	if diags.HasError() {
 		resp.Diagnostics.AddError("Update Payload Error", fmt.Sprintf("\n%v", diags))
 		return
@@ -167,10 +161,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 
 	response, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceVpnSiteToSiteVpn(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceVpnSiteToSiteVpnRequest(*payload).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Update HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Update HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -218,10 +209,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 
 	_, httpResp, err := r.client.ApplianceApi.UpdateNetworkApplianceVpnSiteToSiteVpn(ctx, data.NetworkId.ValueString()).UpdateNetworkApplianceVpnSiteToSiteVpnRequest(updateNetworkApplianceVpnSiteToSiteVpn).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Delete HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Delete HTTP Client Failure", httpResp, err))
 		return
 	}
 
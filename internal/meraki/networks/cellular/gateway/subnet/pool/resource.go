@@ -133,21 +133,21 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
 	// This allows the resource to use the configured provider for any API calls it needs to make.
-	r.client = client
+	r.client = providerData.Client
 }
 
 // Create method is responsible for creating a new resource.
@@ -168,12 +168,9 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	updateNetworkCellularGatewaySubnetPool.SetCidr(data.Cidr.ValueString())
 	updateNetworkCellularGatewaySubnetPool.SetMask(int32(data.Mask.ValueInt64()))
 
-	_, httpResp, err := r.client.SubnetPoolApi.UpdateNetworkCellularGatewaySubnetPool(context.Background(), data.Id.ValueString()).UpdateNetworkCellularGatewaySubnetPoolRequest(updateNetworkCellularGatewaySubnetPool).Execute()
+	_, httpResp, err := r.client.SubnetPoolApi.UpdateNetworkCellularGatewaySubnetPool(ctx, data.Id.ValueString()).UpdateNetworkCellularGatewaySubnetPoolRequest(updateNetworkCellularGatewaySubnetPool).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -225,13 +222,10 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.SubnetPoolApi.GetNetworkCellularGatewaySubnetPool(context.Background(), data.Id.ValueString()).Execute()
+	_, httpResp, err := r.client.SubnetPoolApi.GetNetworkCellularGatewaySubnetPool(ctx, data.Id.ValueString()).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -287,14 +281,11 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	updateNetworkCellularGatewaySubnetPool.SetCidr(data.Cidr.ValueString())
 	updateNetworkCellularGatewaySubnetPool.SetMask(int32(data.Mask.ValueInt64()))
 
-	_, httpResp, err := r.client.SubnetPoolApi.UpdateNetworkCellularGatewaySubnetPool(context.Background(), data.Id.ValueString()).UpdateNetworkCellularGatewaySubnetPoolRequest(updateNetworkCellularGatewaySubnetPool).Execute()
+	_, httpResp, err := r.client.SubnetPoolApi.UpdateNetworkCellularGatewaySubnetPool(ctx, data.Id.ValueString()).UpdateNetworkCellularGatewaySubnetPoolRequest(updateNetworkCellularGatewaySubnetPool).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -350,14 +341,11 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	updateNetworkCellularGatewaySubnetPool.SetCidr(data.Cidr.ValueString())
 	updateNetworkCellularGatewaySubnetPool.SetMask(int32(data.Mask.ValueInt64()))
 
-	_, httpResp, err := r.client.SubnetPoolApi.UpdateNetworkCellularGatewaySubnetPool(context.Background(), data.Id.ValueString()).UpdateNetworkCellularGatewaySubnetPoolRequest(updateNetworkCellularGatewaySubnetPool).Execute()
+	_, httpResp, err := r.client.SubnetPoolApi.UpdateNetworkCellularGatewaySubnetPool(ctx, data.Id.ValueString()).UpdateNetworkCellularGatewaySubnetPoolRequest(updateNetworkCellularGatewaySubnetPool).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
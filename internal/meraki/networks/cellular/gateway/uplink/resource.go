@@ -110,21 +110,21 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
 	// This allows the resource to use the configured provider for any API calls it needs to make.
-	r.client = client
+	r.client = providerData.Client
 }
 
 // Create method is responsible for creating a new resource.
@@ -148,14 +148,11 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	bandwidthLimits.SetLimitDown(int32(data.CellularGatewayBandwidthLimits.LimitDown.ValueInt64()))
 	updateNetworkCellularGatewayUplink.SetBandwidthLimits(bandwidthLimits)
 
-	_, httpResp, err := r.client.CellularGatewayApi.UpdateNetworkCellularGatewayUplink(context.Background(), data.NetworkId.ValueString()).UpdateNetworkCellularGatewayUplinkRequest(updateNetworkCellularGatewayUplink).Execute()
+	_, httpResp, err := r.client.CellularGatewayApi.UpdateNetworkCellularGatewayUplink(ctx, data.NetworkId.ValueString()).UpdateNetworkCellularGatewayUplinkRequest(updateNetworkCellularGatewayUplink).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -207,14 +204,11 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.CellularGatewayApi.GetNetworkCellularGatewayUplink(context.Background(), data.NetworkId.ValueString()).Execute()
+	_, httpResp, err := r.client.CellularGatewayApi.GetNetworkCellularGatewayUplink(ctx, data.NetworkId.ValueString()).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -274,14 +268,11 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	bandwidthLimits.SetLimitDown(int32(data.CellularGatewayBandwidthLimits.LimitDown.ValueInt64()))
 	updateNetworkCellularGatewayUplink.SetBandwidthLimits(bandwidthLimits)
 
-	_, httpResp, err := r.client.CellularGatewayApi.UpdateNetworkCellularGatewayUplink(context.Background(), data.NetworkId.ValueString()).UpdateNetworkCellularGatewayUplinkRequest(updateNetworkCellularGatewayUplink).Execute()
+	_, httpResp, err := r.client.CellularGatewayApi.UpdateNetworkCellularGatewayUplink(ctx, data.NetworkId.ValueString()).UpdateNetworkCellularGatewayUplinkRequest(updateNetworkCellularGatewayUplink).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -340,14 +331,11 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	bandwidthLimits.SetLimitDown(int32(data.CellularGatewayBandwidthLimits.LimitDown.ValueInt64()))
 	updateNetworkCellularGatewayUplink.SetBandwidthLimits(bandwidthLimits)
 
-	_, httpResp, err := r.client.CellularGatewayApi.UpdateNetworkCellularGatewayUplink(context.Background(), data.NetworkId.ValueString()).UpdateNetworkCellularGatewayUplinkRequest(updateNetworkCellularGatewayUplink).Execute()
+	_, httpResp, err := r.client.CellularGatewayApi.UpdateNetworkCellularGatewayUplink(ctx, data.NetworkId.ValueString()).UpdateNetworkCellularGatewayUplinkRequest(updateNetworkCellularGatewayUplink).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
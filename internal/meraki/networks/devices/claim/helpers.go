@@ -80,10 +80,7 @@ func manageDeviceClaims(ctx context.Context, client *openApiClient.APIClient, ne
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error managing devices", err.Error())
-		if httpResp != nil {
-			resp.Diagnostics.AddError("HTTP Response", utils.HttpDiagnostics(httpResp))
-		}
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Error managing devices", httpResp, err))
 		return fmt.Errorf("failed to manage devices: %w", err)
 	}
 
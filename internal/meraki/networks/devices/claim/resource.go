@@ -15,7 +15,6 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"time"
 )
 
 var (
@@ -30,6 +29,7 @@ func NewResource() resource.Resource {
 
 type Resource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 type resourceModel struct {
@@ -71,16 +71,17 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *openApiClient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -130,16 +131,15 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	if len(serialsUnclaimed) > 0 {
 		claimNetworkDevices := *openApiClient.NewClaimNetworkDevicesRequest(serialsUnclaimed)
 
-		maxRetries := r.client.GetConfig().MaximumRetries
-		retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
 		apiCall := func() (interface{}, *http.Response, error) {
-			time.Sleep(retryDelay * time.Millisecond)
 			httpResp, err := r.client.NetworksApi.ClaimNetworkDevices(ctx, data.NetworkId.ValueString()).ClaimNetworkDevicesRequest(claimNetworkDevices).Execute()
+			if httpResp == nil {
+				return nil, httpResp, err
+			}
 			return httpResp.Body, httpResp, err
 		}
 
-		claimDevicesResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCall)
+		claimDevicesResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 		if err != nil {
 			handleError(ctx, err, httpResp, "Error claiming devices", resp)
 			return
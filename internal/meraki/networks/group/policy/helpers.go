@@ -1372,7 +1372,7 @@ func ToTerraformStateData(ctx context.Context, state *dataSourceListModel, inlin
 			state.GroupPolicyId = groupPolicyId
 		}
 	}
-	
+
 	// Name
 	if state.Name.IsNull() || state.Name.IsUnknown() {
 		state.Name, diags = utils.ExtractStringAttr(inlineResp, "name")
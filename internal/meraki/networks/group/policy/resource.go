@@ -12,12 +12,12 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"time"
 )
 
 // Resource defines the resource implementation.
 type Resource struct {
 	client *client.APIClient
+	retry  utils.RetryPolicy
 }
 
 func NewResource() resource.Resource {
@@ -47,7 +47,9 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	r.client = req.ProviderData.(*client.APIClient)
+	providerData := req.ProviderData.(*utils.ProviderData)
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 
 }
 
@@ -71,18 +73,14 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// API call function to be passed to retryOn4xx
+	// API call function to be passed to CustomHttpRequestRetry
 	apiCall := func() (map[string]interface{}, *http.Response, error) {
-		time.Sleep(retryDelay * time.Millisecond)
 
 		inline, httpResp, err := r.client.NetworksApi.CreateNetworkGroupPolicy(ctx, plan.NetworkId.ValueString()).CreateNetworkGroupPolicyRequest(payload).Execute()
 		return inline, httpResp, err
 	}
-	// Use retryOn4xx for the API call as the meraki API backend returns HTTP 400 messages as a result of collision issues with rapid creation of postgres GroupPolicyIds.
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCall)
+	// Retry the API call on rate limiting and server errors
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group policy",
@@ -7,7 +7,6 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -37,6 +36,7 @@ func NewResource() resource.Resource {
 // Resource defines the resource implementation.
 type Resource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 // resourceModel describes the resource data model.
@@ -173,17 +173,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -259,7 +260,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	resp.Diagnostics.Append(diags...)
 
 	// Initialize provider client and make API call
-	inlineResp, httpResp, err := r.client.NetworksApi.GetNetwork(context.Background(), state.NetworkId.ValueString()).Execute()
+	inlineResp, httpResp, err := r.client.NetworksApi.GetNetwork(ctx, state.NetworkId.ValueString()).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Network HTTP Client Failure",
@@ -357,19 +358,16 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
 
-	// API call function to be passed to retryOn4xx
+	// API call function to be passed to CustomHttpRequestRetry
 	apiCall := func() (map[string]interface{}, *http.Response, error) {
-		time.Sleep(retryDelay * time.Millisecond)
 
-		httpResp, err := r.client.NetworksApi.DeleteNetwork(context.Background(), state.NetworkId.ValueString()).Execute()
+		httpResp, err := r.client.NetworksApi.DeleteNetwork(ctx, state.NetworkId.ValueString()).Execute()
 		return nil, httpResp, err
 	}
 
-	// HTTP DELETE METHOD does not leverage the retry-after header and throws 400 errors.
-	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCall)
+	// Retry the API call on rate limiting and server errors
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
 
 		if httpResp != nil {
@@ -400,10 +398,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	// check for HTTP errors
 	if httpResp.StatusCode != 204 {
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Delete Network HTTP Client Failure",
-				utils.HttpDiagnostics(httpResp),
-			)
+			resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Delete Network HTTP Client Failure", httpResp, err))
 		}
 	}
 
@@ -93,18 +93,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -136,10 +136,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	_, httpResp, err := r.client.ConfigureApi.UpdateNetworkSwitchStormControl(ctx, data.NetworkId.ValueString()).UpdateNetworkSwitchStormControlRequest(updateNetworkSwitchStormControl).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -185,10 +182,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 
 	_, httpResp, err := r.client.ConfigureApi.GetNetworkSwitchStormControl(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -253,10 +247,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 
 	_, httpResp, err := r.client.ConfigureApi.UpdateNetworkSwitchStormControl(ctx, data.NetworkId.ValueString()).UpdateNetworkSwitchStormControlRequest(updateNetworkSwitchStormControl).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -322,10 +313,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	_, httpResp, err := r.client.ConfigureApi.UpdateNetworkSwitchStormControl(ctx, data.NetworkId.ValueString()).UpdateNetworkSwitchStormControlRequest(updateNetworkSwitchStormControl).Execute()
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
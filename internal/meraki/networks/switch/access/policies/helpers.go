@@ -0,0 +1,360 @@
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"strconv"
+)
+
+const (
+	hybridAuthentication = "Hybrid authentication"
+	multiDomainHostMode  = "Multi-Domain"
+)
+
+// accessPolicyPayload returns the access policy payload for the planned data. RADIUS secrets are decrypted with
+// keys, and accounting servers and walled garden ranges that are not configured are sent empty so that the
+// Dashboard API removes them.
+func accessPolicyPayload(ctx context.Context, data *resourceModel, keys utils.EncryptionKeys) (openApiClient.UpdateNetworkSwitchAccessPolicyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateNetworkSwitchAccessPolicyRequest()
+	payload.SetName(data.Name.ValueString())
+	payload.SetHostMode(data.HostMode.ValueString())
+	if utils.IsKnown(data.AccessPolicyType) {
+		payload.SetAccessPolicyType(data.AccessPolicyType.ValueString())
+	}
+
+	var serverDiags diag.Diagnostics
+	payload.RadiusServers, serverDiags = radiusServersPayload(ctx, data.RadiusServers, keys, "radius_servers")
+	diags.Append(serverDiags...)
+
+	accountingServers, serverDiags := radiusServersPayload(ctx, data.RadiusAccountingServers, keys, "radius_accounting_servers")
+	diags.Append(serverDiags...)
+	payload.RadiusAccountingServers = []openApiClient.CreateNetworkSwitchAccessPolicyRequestRadiusAccountingServersInner{}
+	for _, server := range accountingServers {
+		payload.RadiusAccountingServers = append(payload.RadiusAccountingServers,
+			*openApiClient.NewCreateNetworkSwitchAccessPolicyRequestRadiusAccountingServersInner(server.Host, server.Port, server.Secret))
+	}
+
+	if utils.IsKnown(data.Radius) {
+		var radius radiusModel
+		diags.Append(data.Radius.As(ctx, &radius, basetypes.ObjectAsOptions{})...)
+
+		radiusPayload := openApiClient.GetNetworkSwitchAccessPolicies200ResponseInnerRadius{}
+		if utils.IsKnown(radius.CriticalAuth) {
+			var criticalAuth criticalAuthModel
+			diags.Append(radius.CriticalAuth.As(ctx, &criticalAuth, basetypes.ObjectAsOptions{})...)
+
+			criticalAuthPayload := openApiClient.GetNetworkSwitchAccessPolicies200ResponseInnerRadiusCriticalAuth{}
+			if utils.IsKnown(criticalAuth.DataVlanId) {
+				criticalAuthPayload.SetDataVlanId(int32(criticalAuth.DataVlanId.ValueInt64()))
+			}
+			if utils.IsKnown(criticalAuth.VoiceVlanId) {
+				criticalAuthPayload.SetVoiceVlanId(int32(criticalAuth.VoiceVlanId.ValueInt64()))
+			}
+			if utils.IsKnown(criticalAuth.SuspendPortBounce) {
+				criticalAuthPayload.SetSuspendPortBounce(criticalAuth.SuspendPortBounce.ValueBool())
+			}
+			radiusPayload.SetCriticalAuth(criticalAuthPayload)
+		}
+		if utils.IsKnown(radius.FailedAuthVlanId) {
+			radiusPayload.SetFailedAuthVlanId(int32(radius.FailedAuthVlanId.ValueInt64()))
+		}
+		if utils.IsKnown(radius.ReAuthenticationInterval) {
+			radiusPayload.SetReAuthenticationInterval(int32(radius.ReAuthenticationInterval.ValueInt64()))
+		}
+		payload.SetRadius(radiusPayload)
+	}
+
+	if utils.IsKnown(data.RadiusTestingEnabled) {
+		payload.SetRadiusTestingEnabled(data.RadiusTestingEnabled.ValueBool())
+	}
+	if utils.IsKnown(data.RadiusCoaSupportEnabled) {
+		payload.SetRadiusCoaSupportEnabled(data.RadiusCoaSupportEnabled.ValueBool())
+	}
+	if utils.IsKnown(data.RadiusAccountingEnabled) {
+		payload.SetRadiusAccountingEnabled(data.RadiusAccountingEnabled.ValueBool())
+	}
+	if utils.IsKnown(data.RadiusGroupAttribute) {
+		payload.SetRadiusGroupAttribute(data.RadiusGroupAttribute.ValueString())
+	}
+	if utils.IsKnown(data.GuestPortBouncing) {
+		payload.SetGuestPortBouncing(data.GuestPortBouncing.ValueBool())
+	}
+	if utils.IsKnown(data.GuestVlanId) {
+		payload.SetGuestVlanId(int32(data.GuestVlanId.ValueInt64()))
+	}
+	if utils.IsKnown(data.IncreaseAccessSpeed) {
+		payload.SetIncreaseAccessSpeed(data.IncreaseAccessSpeed.ValueBool())
+	}
+	if utils.IsKnown(data.VoiceVlanClients) {
+		payload.SetVoiceVlanClients(data.VoiceVlanClients.ValueBool())
+	}
+	if utils.IsKnown(data.Dot1x) {
+		var dot1x dot1xModel
+		diags.Append(data.Dot1x.As(ctx, &dot1x, basetypes.ObjectAsOptions{})...)
+
+		dot1xPayload := openApiClient.GetNetworkSwitchAccessPolicies200ResponseInnerDot1x{}
+		if utils.IsKnown(dot1x.ControlDirection) {
+			dot1xPayload.SetControlDirection(dot1x.ControlDirection.ValueString())
+		}
+		payload.SetDot1x(dot1xPayload)
+	}
+
+	if utils.IsKnown(data.UrlRedirectWalledGardenEnabled) {
+		payload.SetUrlRedirectWalledGardenEnabled(data.UrlRedirectWalledGardenEnabled.ValueBool())
+	}
+	payload.UrlRedirectWalledGardenRanges = []string{}
+	if utils.IsKnown(data.UrlRedirectWalledGardenRanges) {
+		diags.Append(data.UrlRedirectWalledGardenRanges.ElementsAs(ctx, &payload.UrlRedirectWalledGardenRanges, false)...)
+	}
+
+	return payload, diags
+}
+
+// createPayload returns the payload that creates the planned access policy. The create request has the same
+// format as the update request, but requires the settings that the Dashboard API has no default for.
+func createPayload(ctx context.Context, data *resourceModel, keys utils.EncryptionKeys) (openApiClient.CreateNetworkSwitchAccessPolicyRequest, diag.Diagnostics) {
+	var payload openApiClient.CreateNetworkSwitchAccessPolicyRequest
+
+	updatePayload, diags := accessPolicyPayload(ctx, data, keys)
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	if err := utils.ConvertJSON(updatePayload, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the access policy payload: %s", err))
+	}
+	return payload, diags
+}
+
+// radiusServersPayload returns the RADIUS servers of list with their secrets decrypted.
+func radiusServersPayload(ctx context.Context, list types.List, keys utils.EncryptionKeys, attribute string) ([]openApiClient.CreateNetworkSwitchAccessPolicyRequestRadiusServersInner, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var servers []radiusServerModel
+	if utils.IsKnown(list) {
+		diags.Append(list.ElementsAs(ctx, &servers, false)...)
+	}
+
+	payload := []openApiClient.CreateNetworkSwitchAccessPolicyRequestRadiusServersInner{}
+	for i, server := range servers {
+		secret, _, err := keys.Decrypt(server.Secret.ValueString())
+		if err != nil {
+			diags.Append(utils.NewEncryptionDiagnostic(path.Root(attribute).AtListIndex(i).AtName("secret"), err))
+			continue
+		}
+		payload = append(payload, *openApiClient.NewCreateNetworkSwitchAccessPolicyRequestRadiusServersInner(
+			server.Host.ValueString(), int32(server.Port.ValueInt64()), secret))
+	}
+	return payload, diags
+}
+
+// decodeAccessPolicy decodes the access policy in the body of a Dashboard API response.
+func decodeAccessPolicy(httpResp *http.Response) (apiAccessPolicy, error) {
+	var policy apiAccessPolicy
+	if httpResp == nil || httpResp.Body == nil {
+		return policy, fmt.Errorf("no response body received")
+	}
+	err := json.NewDecoder(httpResp.Body).Decode(&policy)
+	return policy, err
+}
+
+// readAccessPolicy sets data from an access policy returned by the Dashboard API. The response never includes the
+// RADIUS secrets, so they are kept from the server at the same position in data.
+func readAccessPolicy(ctx context.Context, data *resourceModel, policy apiAccessPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if policy.AccessPolicyNumber == nil {
+		diags.AddError("Resource Response Error", "The Dashboard API did not return the access policy number")
+		return diags
+	}
+
+	data.AccessPolicyNumber = types.Int64Value(int64(*policy.AccessPolicyNumber))
+	data.Id = types.StringValue(data.NetworkId.ValueString() + "," + strconv.FormatInt(data.AccessPolicyNumber.ValueInt64(), 10))
+	data.Name = types.StringPointerValue(policy.Name)
+	data.HostMode = types.StringPointerValue(policy.HostMode)
+	data.AccessPolicyType = types.StringPointerValue(policy.AccessPolicyType)
+	data.RadiusTestingEnabled = types.BoolPointerValue(policy.RadiusTestingEnabled)
+	data.RadiusCoaSupportEnabled = types.BoolPointerValue(policy.RadiusCoaSupportEnabled)
+	data.RadiusAccountingEnabled = types.BoolPointerValue(policy.RadiusAccountingEnabled)
+	data.RadiusGroupAttribute = types.StringPointerValue(policy.RadiusGroupAttribute)
+	data.GuestPortBouncing = types.BoolPointerValue(policy.GuestPortBouncing)
+	data.GuestVlanId = types.Int64PointerValue(policy.GuestVlanId)
+	data.IncreaseAccessSpeed = types.BoolPointerValue(policy.IncreaseAccessSpeed)
+	data.VoiceVlanClients = types.BoolPointerValue(policy.VoiceVlanClients)
+	data.UrlRedirectWalledGardenEnabled = types.BoolPointerValue(policy.UrlRedirectWalledGardenEnabled)
+
+	var listDiags diag.Diagnostics
+	data.RadiusServers, listDiags = readRadiusServers(ctx, policy.RadiusServers, data.RadiusServers)
+	diags.Append(listDiags...)
+	data.RadiusAccountingServers, listDiags = readRadiusServers(ctx, policy.RadiusAccountingServers, data.RadiusAccountingServers)
+	diags.Append(listDiags...)
+
+	data.UrlRedirectWalledGardenRanges = types.SetNull(types.StringType)
+	if len(policy.UrlRedirectWalledGardenRanges) > 0 {
+		data.UrlRedirectWalledGardenRanges, listDiags = types.SetValueFrom(ctx, types.StringType, policy.UrlRedirectWalledGardenRanges)
+		diags.Append(listDiags...)
+	}
+
+	data.Radius = types.ObjectNull(radiusAttrTypes())
+	if policy.Radius != nil {
+		radius := radiusModel{
+			CriticalAuth:             types.ObjectNull(criticalAuthAttrTypes()),
+			FailedAuthVlanId:         types.Int64PointerValue(policy.Radius.FailedAuthVlanId),
+			ReAuthenticationInterval: types.Int64PointerValue(policy.Radius.ReAuthenticationInterval),
+		}
+
+		var objectDiags diag.Diagnostics
+		if criticalAuth := policy.Radius.CriticalAuth; criticalAuth != nil {
+			radius.CriticalAuth, objectDiags = types.ObjectValueFrom(ctx, criticalAuthAttrTypes(), criticalAuthModel{
+				DataVlanId:        types.Int64PointerValue(criticalAuth.DataVlanId),
+				VoiceVlanId:       types.Int64PointerValue(criticalAuth.VoiceVlanId),
+				SuspendPortBounce: types.BoolPointerValue(criticalAuth.SuspendPortBounce),
+			})
+			diags.Append(objectDiags...)
+		}
+
+		data.Radius, objectDiags = types.ObjectValueFrom(ctx, radiusAttrTypes(), radius)
+		diags.Append(objectDiags...)
+	}
+
+	data.Dot1x = types.ObjectNull(dot1xAttrTypes())
+	if policy.Dot1x != nil {
+		var objectDiags diag.Diagnostics
+		data.Dot1x, objectDiags = types.ObjectValueFrom(ctx, dot1xAttrTypes(), dot1xModel{
+			ControlDirection: types.StringPointerValue(policy.Dot1x.ControlDirection),
+		})
+		diags.Append(objectDiags...)
+	}
+
+	return diags
+}
+
+// readRadiusServers returns the RADIUS servers of a response with the secret of the server at the same position in
+// prior.
+func readRadiusServers(ctx context.Context, servers []apiRadiusServer, prior types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(servers) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: radiusServerAttrTypes()}), diags
+	}
+
+	var priorServers []radiusServerModel
+	if utils.IsKnown(prior) {
+		diags.Append(prior.ElementsAs(ctx, &priorServers, true)...)
+	}
+
+	result := make([]radiusServerModel, len(servers))
+	for i, server := range servers {
+		result[i] = radiusServerModel{
+			Host:   types.StringPointerValue(server.Host),
+			Port:   types.Int64PointerValue(server.Port),
+			Secret: types.StringNull(),
+		}
+		if i < len(priorServers) && !priorServers[i].Secret.IsUnknown() {
+			result[i].Secret = priorServers[i].Secret
+		}
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: radiusServerAttrTypes()}, result)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
+// radiusSecrets describes the secrets of the RADIUS servers in the list attribute of the given name.
+func radiusSecrets(attribute string) utils.SensitiveList[radiusServerModel] {
+	return utils.SensitiveList[radiusServerModel]{
+		Path:       path.Root(attribute),
+		SecretPath: []string{"secret"},
+		Secret:     func(server *radiusServerModel) *types.String { return &server.Secret },
+	}
+}
+
+// sealSecrets encrypts the RADIUS secrets in state with the provider's current encryption key. Values already
+// encrypted with the current key are left untouched.
+func sealSecrets(ctx context.Context, keys utils.EncryptionKeys, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var listDiags diag.Diagnostics
+	data.RadiusServers, listDiags = radiusSecrets("radius_servers").Seal(ctx, keys, data.RadiusServers)
+	diags.Append(listDiags...)
+	data.RadiusAccountingServers, listDiags = radiusSecrets("radius_accounting_servers").Seal(ctx, keys, data.RadiusAccountingServers)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// preserveSecrets keeps the encrypted prior state value of each RADIUS secret in the plan when it decrypts to the
+// configured plaintext, so that encryption alone never produces a diff.
+func preserveSecrets(ctx context.Context, keys utils.EncryptionKeys, plan, state *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var listDiags diag.Diagnostics
+	plan.RadiusServers, listDiags = radiusSecrets("radius_servers").Preserve(ctx, keys, plan.RadiusServers, state.RadiusServers)
+	diags.Append(listDiags...)
+	plan.RadiusAccountingServers, listDiags = radiusSecrets("radius_accounting_servers").Preserve(ctx, keys, plan.RadiusAccountingServers, state.RadiusAccountingServers)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// validateAccessPolicy checks the RADIUS servers, access policy type and walled garden settings.
+func validateAccessPolicy(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validateSecrets(ctx, data.RadiusServers, "radius_servers")...)
+	diags.Append(validateSecrets(ctx, data.RadiusAccountingServers, "radius_accounting_servers")...)
+
+	if data.RadiusAccountingEnabled.ValueBool() && data.RadiusAccountingServers.IsNull() {
+		diags.AddAttributeError(path.Root("radius_accounting_servers"), "Missing RADIUS Accounting Servers",
+			"radius_accounting_servers must be configured when radius_accounting_enabled is true")
+	}
+
+	if utils.IsKnown(data.AccessPolicyType) && data.AccessPolicyType.ValueString() != hybridAuthentication {
+		if data.HostMode.ValueString() == multiDomainHostMode {
+			diags.AddAttributeError(path.Root("access_policy_type"), "Invalid Access Policy Type",
+				fmt.Sprintf("access_policy_type must be %q when host_mode is %q", hybridAuthentication, multiDomainHostMode))
+		}
+		if data.IncreaseAccessSpeed.ValueBool() {
+			diags.AddAttributeError(path.Root("increase_access_speed"), "Invalid Access Policy Type",
+				fmt.Sprintf("increase_access_speed can only be enabled when access_policy_type is %q", hybridAuthentication))
+		}
+	}
+
+	if utils.IsKnown(data.UrlRedirectWalledGardenRanges) && len(data.UrlRedirectWalledGardenRanges.Elements()) > 0 &&
+		utils.IsKnown(data.UrlRedirectWalledGardenEnabled) && !data.UrlRedirectWalledGardenEnabled.ValueBool() {
+		diags.AddAttributeError(path.Root("url_redirect_walled_garden_ranges"), "Invalid Walled Garden Configuration",
+			"url_redirect_walled_garden_ranges can only be configured when url_redirect_walled_garden_enabled is true")
+	}
+
+	return diags
+}
+
+// validateSecrets checks that every configured RADIUS server has a secret.
+func validateSecrets(ctx context.Context, list types.List, attribute string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(list) {
+		return diags
+	}
+
+	var servers []radiusServerModel
+	diags.Append(list.ElementsAs(ctx, &servers, true)...)
+	for i, server := range servers {
+		if server.Secret.IsNull() {
+			diags.AddAttributeError(path.Root(attribute).AtListIndex(i).AtName("secret"), "Missing RADIUS Secret",
+				"Every RADIUS server requires a secret")
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,229 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func testRadiusServers(secrets ...string) types.List {
+	var servers []attr.Value
+	for i, secret := range secrets {
+		servers = append(servers, types.ObjectValueMust(radiusServerAttrTypes(), map[string]attr.Value{
+			"host":   types.StringValue(fmt.Sprintf("10.0.0.%d", i+1)),
+			"port":   types.Int64Value(1812),
+			"secret": types.StringValue(secret),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: radiusServerAttrTypes()}, servers)
+}
+
+func testResourceModel(secrets ...string) resourceModel {
+	return resourceModel{
+		NetworkId:                      types.StringValue("N_1"),
+		AccessPolicyNumber:             types.Int64Unknown(),
+		Name:                           types.StringValue("Access policy"),
+		HostMode:                       types.StringValue("Single-Host"),
+		AccessPolicyType:               types.StringUnknown(),
+		RadiusServers:                  testRadiusServers(secrets...),
+		Radius:                         types.ObjectUnknown(radiusAttrTypes()),
+		RadiusTestingEnabled:           types.BoolUnknown(),
+		RadiusCoaSupportEnabled:        types.BoolValue(true),
+		RadiusAccountingEnabled:        types.BoolUnknown(),
+		RadiusAccountingServers:        types.ListNull(types.ObjectType{AttrTypes: radiusServerAttrTypes()}),
+		RadiusGroupAttribute:           types.StringUnknown(),
+		GuestPortBouncing:              types.BoolUnknown(),
+		GuestVlanId:                    types.Int64Value(100),
+		IncreaseAccessSpeed:            types.BoolUnknown(),
+		VoiceVlanClients:               types.BoolUnknown(),
+		Dot1x:                          types.ObjectUnknown(dot1xAttrTypes()),
+		UrlRedirectWalledGardenEnabled: types.BoolUnknown(),
+		UrlRedirectWalledGardenRanges:  types.SetNull(types.StringType),
+	}
+}
+
+func TestCreatePayload(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	encrypted, err := keys.Encrypt("first")
+	require.NoError(t, err)
+	data := testResourceModel(encrypted, "second")
+
+	// Test case: Secrets are decrypted and unset lists are sent empty so that they are removed
+	payload, diags := createPayload(ctx, &data, keys)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "Access policy", payload.Name)
+	assert.Equal(t, "Single-Host", payload.HostMode)
+	require.Len(t, payload.RadiusServers, 2)
+	assert.Equal(t, "first", payload.RadiusServers[0].Secret)
+	assert.Equal(t, "second", payload.RadiusServers[1].Secret)
+	assert.Equal(t, int32(1812), payload.RadiusServers[0].Port)
+	assert.True(t, payload.RadiusCoaSupportEnabled)
+	assert.Equal(t, int32(100), payload.GetGuestVlanId())
+	assert.NotNil(t, payload.RadiusAccountingServers)
+	assert.Empty(t, payload.RadiusAccountingServers)
+	assert.NotNil(t, payload.UrlRedirectWalledGardenRanges)
+	assert.False(t, payload.HasAccessPolicyType())
+
+	// Test case: Secrets that fail authentication are not sent
+	data = testResourceModel(encrypted)
+	_, diags = accessPolicyPayload(ctx, &data, utils.EncryptionKeys{Key: "other"})
+	assert.True(t, diags.HasError())
+}
+
+func TestReadAccessPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	body := `{
+		"accessPolicyNumber": "2",
+		"name": "Access policy",
+		"hostMode": "Single-Host",
+		"accessPolicyType": "802.1x",
+		"radiusServers": [{"host": "10.0.0.1", "port": 1812}, {"host": "10.0.0.9", "port": 1645}],
+		"radius": {"criticalAuth": {"dataVlanId": 100, "suspendPortBounce": true}, "failedAuthVlanId": 200, "reAuthenticationInterval": 3600},
+		"radiusTestingEnabled": false,
+		"radiusCoaSupportEnabled": true,
+		"radiusAccountingEnabled": false,
+		"radiusGroupAttribute": "11",
+		"guestVlanId": 100,
+		"dot1x": {"controlDirection": "inbound"},
+		"urlRedirectWalledGardenEnabled": true,
+		"urlRedirectWalledGardenRanges": ["192.168.1.0/24"]
+	}`
+	policy, err := decodeAccessPolicy(&http.Response{Body: io.NopCloser(strings.NewReader(body))})
+	require.NoError(t, err)
+
+	data := testResourceModel("secret")
+	diags := readAccessPolicy(ctx, &data, policy)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_1,2", data.Id.ValueString())
+	assert.Equal(t, int64(2), data.AccessPolicyNumber.ValueInt64())
+	assert.Equal(t, "802.1x", data.AccessPolicyType.ValueString())
+	assert.Equal(t, "11", data.RadiusGroupAttribute.ValueString())
+	assert.True(t, data.RadiusAccountingServers.IsNull())
+	assert.Len(t, data.UrlRedirectWalledGardenRanges.Elements(), 1)
+
+	// Test case: The secret of the first server is kept and the second server has none in data
+	var servers []radiusServerModel
+	require.False(t, data.RadiusServers.ElementsAs(ctx, &servers, false).HasError())
+	require.Len(t, servers, 2)
+	assert.Equal(t, "secret", servers[0].Secret.ValueString())
+	assert.True(t, servers[1].Secret.IsNull())
+	assert.Equal(t, int64(1645), servers[1].Port.ValueInt64())
+
+	var radius radiusModel
+	require.False(t, data.Radius.As(ctx, &radius, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, int64(200), radius.FailedAuthVlanId.ValueInt64())
+	assert.False(t, radius.CriticalAuth.IsNull())
+
+	// Test case: A numeric access policy number is also accepted
+	policy, err = decodeAccessPolicy(&http.Response{Body: io.NopCloser(strings.NewReader(`{"accessPolicyNumber": 3}`))})
+	require.NoError(t, err)
+	assert.False(t, readAccessPolicy(ctx, &data, policy).HasError())
+	assert.Equal(t, int64(3), data.AccessPolicyNumber.ValueInt64())
+
+	// Test case: A response without an access policy number is an error
+	assert.True(t, readAccessPolicy(ctx, &data, apiAccessPolicy{}).HasError())
+}
+
+func TestSealAndPreserveSecrets(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	state := testResourceModel("secret")
+	require.False(t, sealSecrets(ctx, keys, &state).HasError())
+
+	var servers []radiusServerModel
+	require.False(t, state.RadiusServers.ElementsAs(ctx, &servers, false).HasError())
+	sealed := servers[0].Secret.ValueString()
+	assert.True(t, utils.IsEncrypted(sealed))
+
+	// Test case: An unchanged secret keeps its encrypted state value in the plan
+	plan := testResourceModel("secret")
+	require.False(t, preserveSecrets(ctx, keys, &plan, &state).HasError())
+	require.False(t, plan.RadiusServers.ElementsAs(ctx, &servers, false).HasError())
+	assert.Equal(t, sealed, servers[0].Secret.ValueString())
+
+	// Test case: A changed secret is planned in plaintext
+	plan = testResourceModel("changed")
+	require.False(t, preserveSecrets(ctx, keys, &plan, &state).HasError())
+	require.False(t, plan.RadiusServers.ElementsAs(ctx, &servers, false).HasError())
+	assert.Equal(t, "changed", servers[0].Secret.ValueString())
+}
+
+func TestValidateAccessPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		modify func(data *resourceModel)
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			modify: func(data *resourceModel) {},
+			valid:  true,
+		},
+		{
+			name: "missing secret",
+			modify: func(data *resourceModel) {
+				data.RadiusServers = types.ListValueMust(types.ObjectType{AttrTypes: radiusServerAttrTypes()}, []attr.Value{
+					types.ObjectValueMust(radiusServerAttrTypes(), map[string]attr.Value{
+						"host":   types.StringValue("10.0.0.1"),
+						"port":   types.Int64Value(1812),
+						"secret": types.StringNull(),
+					}),
+				})
+			},
+		},
+		{
+			name: "accounting without servers",
+			modify: func(data *resourceModel) {
+				data.RadiusAccountingEnabled = types.BoolValue(true)
+			},
+		},
+		{
+			name: "multi-domain without hybrid authentication",
+			modify: func(data *resourceModel) {
+				data.HostMode = types.StringValue("Multi-Domain")
+				data.AccessPolicyType = types.StringValue("802.1x")
+			},
+		},
+		{
+			name: "increase access speed without hybrid authentication",
+			modify: func(data *resourceModel) {
+				data.AccessPolicyType = types.StringValue("MAC authentication bypass")
+				data.IncreaseAccessSpeed = types.BoolValue(true)
+			},
+		},
+		{
+			name: "walled garden ranges while disabled",
+			modify: func(data *resourceModel) {
+				data.UrlRedirectWalledGardenEnabled = types.BoolValue(false)
+				data.UrlRedirectWalledGardenRanges = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/8")})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel("secret")
+			tt.modify(&data)
+
+			diags := validateAccessPolicy(ctx, &data)
+			assert.Equal(t, !tt.valid, diags.HasError(), diags)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package policies
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+// resourceModel describes the data model of a switch access policy.
+type resourceModel struct {
+	Id                             types.String `tfsdk:"id"`
+	NetworkId                      types.String `tfsdk:"network_id"`
+	AccessPolicyNumber             types.Int64  `tfsdk:"access_policy_number"`
+	Name                           types.String `tfsdk:"name"`
+	HostMode                       types.String `tfsdk:"host_mode"`
+	AccessPolicyType               types.String `tfsdk:"access_policy_type"`
+	RadiusServers                  types.List   `tfsdk:"radius_servers"`
+	Radius                         types.Object `tfsdk:"radius"`
+	RadiusTestingEnabled           types.Bool   `tfsdk:"radius_testing_enabled"`
+	RadiusCoaSupportEnabled        types.Bool   `tfsdk:"radius_coa_support_enabled"`
+	RadiusAccountingEnabled        types.Bool   `tfsdk:"radius_accounting_enabled"`
+	RadiusAccountingServers        types.List   `tfsdk:"radius_accounting_servers"`
+	RadiusGroupAttribute           types.String `tfsdk:"radius_group_attribute"`
+	GuestPortBouncing              types.Bool   `tfsdk:"guest_port_bouncing"`
+	GuestVlanId                    types.Int64  `tfsdk:"guest_vlan_id"`
+	IncreaseAccessSpeed            types.Bool   `tfsdk:"increase_access_speed"`
+	VoiceVlanClients               types.Bool   `tfsdk:"voice_vlan_clients"`
+	Dot1x                          types.Object `tfsdk:"dot1x"`
+	UrlRedirectWalledGardenEnabled types.Bool   `tfsdk:"url_redirect_walled_garden_enabled"`
+	UrlRedirectWalledGardenRanges  types.Set    `tfsdk:"url_redirect_walled_garden_ranges"`
+}
+
+// radiusServerModel describes a RADIUS authentication or accounting server. The secret is encrypted in state
+// when the provider has an encryption_key.
+type radiusServerModel struct {
+	Host   types.String `tfsdk:"host"`
+	Port   types.Int64  `tfsdk:"port"`
+	Secret types.String `tfsdk:"secret"`
+}
+
+func radiusServerAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":   types.StringType,
+		"port":   types.Int64Type,
+		"secret": types.StringType,
+	}
+}
+
+type radiusModel struct {
+	CriticalAuth             types.Object `tfsdk:"critical_auth"`
+	FailedAuthVlanId         types.Int64  `tfsdk:"failed_auth_vlan_id"`
+	ReAuthenticationInterval types.Int64  `tfsdk:"re_authentication_interval"`
+}
+
+func radiusAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"critical_auth":              types.ObjectType{AttrTypes: criticalAuthAttrTypes()},
+		"failed_auth_vlan_id":        types.Int64Type,
+		"re_authentication_interval": types.Int64Type,
+	}
+}
+
+type criticalAuthModel struct {
+	DataVlanId        types.Int64 `tfsdk:"data_vlan_id"`
+	VoiceVlanId       types.Int64 `tfsdk:"voice_vlan_id"`
+	SuspendPortBounce types.Bool  `tfsdk:"suspend_port_bounce"`
+}
+
+func criticalAuthAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"data_vlan_id":        types.Int64Type,
+		"voice_vlan_id":       types.Int64Type,
+		"suspend_port_bounce": types.BoolType,
+	}
+}
+
+type dot1xModel struct {
+	ControlDirection types.String `tfsdk:"control_direction"`
+}
+
+func dot1xAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"control_direction": types.StringType,
+	}
+}
+
+// apiAccessPolicy is an access policy in the format of the Dashboard API. The generated client drops the access
+// policy number from its responses, so responses are decoded from the HTTP body into this type.
+type apiAccessPolicy struct {
+	AccessPolicyNumber *apiAccessPolicyNumber `json:"accessPolicyNumber,omitempty"`
+	Name               *string                `json:"name,omitempty"`
+	HostMode           *string                `json:"hostMode,omitempty"`
+	AccessPolicyType   *string                `json:"accessPolicyType,omitempty"`
+	RadiusServers      []apiRadiusServer      `json:"radiusServers,omitempty"`
+	Radius             *struct {
+		CriticalAuth *struct {
+			DataVlanId        *int64 `json:"dataVlanId,omitempty"`
+			VoiceVlanId       *int64 `json:"voiceVlanId,omitempty"`
+			SuspendPortBounce *bool  `json:"suspendPortBounce,omitempty"`
+		} `json:"criticalAuth,omitempty"`
+		FailedAuthVlanId         *int64 `json:"failedAuthVlanId,omitempty"`
+		ReAuthenticationInterval *int64 `json:"reAuthenticationInterval,omitempty"`
+	} `json:"radius,omitempty"`
+	RadiusTestingEnabled    *bool             `json:"radiusTestingEnabled,omitempty"`
+	RadiusCoaSupportEnabled *bool             `json:"radiusCoaSupportEnabled,omitempty"`
+	RadiusAccountingEnabled *bool             `json:"radiusAccountingEnabled,omitempty"`
+	RadiusAccountingServers []apiRadiusServer `json:"radiusAccountingServers,omitempty"`
+	RadiusGroupAttribute    *string           `json:"radiusGroupAttribute,omitempty"`
+	GuestPortBouncing       *bool             `json:"guestPortBouncing,omitempty"`
+	GuestVlanId             *int64            `json:"guestVlanId,omitempty"`
+	IncreaseAccessSpeed     *bool             `json:"increaseAccessSpeed,omitempty"`
+	VoiceVlanClients        *bool             `json:"voiceVlanClients,omitempty"`
+	Dot1x                   *struct {
+		ControlDirection *string `json:"controlDirection,omitempty"`
+	} `json:"dot1x,omitempty"`
+	UrlRedirectWalledGardenEnabled *bool    `json:"urlRedirectWalledGardenEnabled,omitempty"`
+	UrlRedirectWalledGardenRanges  []string `json:"urlRedirectWalledGardenRanges,omitempty"`
+}
+
+// apiRadiusServer is a RADIUS server in a Dashboard API response, which never includes the secret.
+type apiRadiusServer struct {
+	Host *string `json:"host,omitempty"`
+	Port *int64  `json:"port,omitempty"`
+}
+
+// apiAccessPolicyNumber accepts the access policy number as either a JSON string or number, since the Dashboard
+// API returns it as a string.
+type apiAccessPolicyNumber int64
+
+func (n *apiAccessPolicyNumber) UnmarshalJSON(data []byte) error {
+	// json.Number also accepts strings that hold a valid number
+	var value json.Number
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid access policy number %s: %w", data, err)
+	}
+
+	number, err := strconv.ParseInt(value.String(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid access policy number %q: %w", value, err)
+	}
+	*n = apiAccessPolicyNumber(number)
+	return nil
+}
@@ -0,0 +1,87 @@
+package policies_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksSwitchAccessPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_access_policy"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_switch_access_policy"),
+			},
+
+			// Create and Read Access Policy
+			{
+				Config: NetworksSwitchAccessPolicyResourceConfig("Single-Host", 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("meraki_networks_switch_access_policy.test", "access_policy_number"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "name", "Access policy"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "host_mode", "Single-Host"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "radius_servers.#", "1"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "radius_servers.0.port", "1812"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "guest_vlan_id", "100"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "url_redirect_walled_garden_ranges.#", "1"),
+				),
+			},
+
+			// Update and Read Access Policy
+			{
+				Config: NetworksSwitchAccessPolicyResourceConfig("Multi-Auth", 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "host_mode", "Multi-Auth"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_access_policy.test", "guest_vlan_id", "200"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:            "meraki_networks_switch_access_policy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"radius_servers.0.secret"},
+			},
+		},
+	})
+}
+
+func NetworksSwitchAccessPolicyResourceConfig(hostMode string, guestVlanId int) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_switch_access_policy" "test" {
+    network_id = resource.meraki_network.test.network_id
+    name = "Access policy"
+    host_mode = "%s"
+    access_policy_type = "802.1x"
+    radius_servers = [
+        {
+            host = "1.2.3.4"
+            port = 1812
+            secret = "secret"
+        }
+    ]
+    radius_testing_enabled = false
+    radius_coa_support_enabled = false
+    radius_accounting_enabled = false
+    guest_vlan_id = %d
+    url_redirect_walled_garden_enabled = true
+    url_redirect_walled_garden_ranges = ["192.168.1.0/24"]
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_access_policy"),
+		hostMode,
+		guestVlanId,
+	)
+}
@@ -0,0 +1,230 @@
+package policies
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage an 802.1X or MAC authentication bypass access policy of the switches in a network. " +
+			"Switch ports use the policy by setting `access_policy_type` to 'Custom access policy' and `access_policy_number` to the `access_policy_number` of this resource. " +
+			"RADIUS secrets are encrypted in state when the provider has an `encryption_key`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID and access policy number, separated by a comma",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_policy_number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the access policy, which switch ports reference in `access_policy_number`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the access policy",
+				Required:            true,
+			},
+			"host_mode": schema.StringAttribute{
+				MarkdownDescription: "The host mode of the access policy. Can be one of 'Single-Host', 'Multi-Domain', 'Multi-Host' or 'Multi-Auth'.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Single-Host", multiDomainHostMode, "Multi-Host", "Multi-Auth"),
+				},
+			},
+			"access_policy_type": schema.StringAttribute{
+				MarkdownDescription: "Access type of the policy. Can be one of '802.1x', 'MAC authentication bypass' or 'Hybrid authentication'. Automatically 'Hybrid authentication' when `host_mode` is 'Multi-Domain'.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("802.1x", "MAC authentication bypass", hybridAuthentication),
+				},
+			},
+			"radius_servers": schema.ListNestedAttribute{
+				MarkdownDescription: "RADIUS servers that connecting devices authenticate against before they are granted network access",
+				Required:            true,
+				NestedObject:        radiusServerAttribute("RADIUS"),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"radius": schema.SingleNestedAttribute{
+				MarkdownDescription: "Object for RADIUS settings",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"critical_auth": schema.SingleNestedAttribute{
+						MarkdownDescription: "Critical auth settings for when authentication is rejected by the RADIUS server",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"data_vlan_id":  vlanAttribute("VLAN that clients who use data will be placed on when RADIUS authentication fails. Null if `host_mode` is 'Multi-Auth'."),
+							"voice_vlan_id": vlanAttribute("VLAN that clients who use voice will be placed on when RADIUS authentication fails. Null if `host_mode` is 'Multi-Auth'."),
+							"suspend_port_bounce": schema.BoolAttribute{
+								MarkdownDescription: "Enable to suspend port bounce when RADIUS servers are unreachable",
+								Optional:            true,
+								Computed:            true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+					},
+					"failed_auth_vlan_id": vlanAttribute("VLAN that clients will be placed on when RADIUS authentication fails. Null if `host_mode` is 'Multi-Auth'."),
+					"re_authentication_interval": schema.Int64Attribute{
+						MarkdownDescription: "Re-authentication period in seconds. Null if `host_mode` is 'Multi-Auth'.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"radius_testing_enabled":     boolAttribute("If enabled, Meraki devices will periodically send access-request messages to the RADIUS servers"),
+			"radius_coa_support_enabled": boolAttribute("Enable change of authorization for RADIUS re-authentication and disconnection"),
+			"radius_accounting_enabled":  boolAttribute("Enable to send start, interim-update and stop messages to the RADIUS accounting servers for tracking connected clients"),
+			"radius_accounting_servers": schema.ListNestedAttribute{
+				MarkdownDescription: "RADIUS accounting servers. Required if `radius_accounting_enabled` is true.",
+				Optional:            true,
+				NestedObject:        radiusServerAttribute("RADIUS accounting"),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"radius_group_attribute": schema.StringAttribute{
+				MarkdownDescription: "Can be '' for none, or '11' for group policies ACL",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("", "11"),
+				},
+			},
+			"guest_port_bouncing":   boolAttribute("If enabled, ports are bounced when clients move to or from the guest VLAN"),
+			"guest_vlan_id":         vlanAttribute("ID of the guest VLAN that gives unauthorized devices access to limited network resources"),
+			"increase_access_speed": boolAttribute("Enable to make switches execute 802.1X and MAC authentication bypass simultaneously so that clients authenticate faster. Only applicable when `access_policy_type` is 'Hybrid authentication'."),
+			"voice_vlan_clients":    boolAttribute("CDP/LLDP capable voice clients will be able to use this VLAN. Automatically true when `host_mode` is 'Multi-Domain'."),
+			"dot1x": schema.SingleNestedAttribute{
+				MarkdownDescription: "802.1X settings",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"control_direction": schema.StringAttribute{
+						MarkdownDescription: "Can be 'both' or 'inbound'. Set to 'inbound' to allow unauthorized egress on the switch port, or 'both' to control both traffic directions with authorization.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf("both", "inbound"),
+						},
+					},
+				},
+			},
+			"url_redirect_walled_garden_enabled": boolAttribute("Enable to restrict the access of clients to a specific set of IP addresses before they authenticate"),
+			"url_redirect_walled_garden_ranges": schema.SetAttribute{
+				MarkdownDescription: "IP address ranges, in CIDR notation, that clients can access before they authenticate. Requires `url_redirect_walled_garden_enabled`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(utils.IPv4SubnetValidator()),
+				},
+			},
+		},
+	}
+}
+
+// radiusServerAttribute returns the schema of a RADIUS server. The secret is Optional and Computed so that its
+// encrypted state value can be kept in the plan, and ValidateConfig requires it.
+func radiusServerAttribute(kind string) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Public IP address of the " + kind + " server",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "UDP port that the " + kind + " server listens on",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "RADIUS client shared secret. Required.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					utils.NewSensitivePlanModifier(),
+				},
+			},
+		},
+	}
+}
+
+func vlanAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(1, 4094),
+		},
+	}
+}
+
+func boolAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
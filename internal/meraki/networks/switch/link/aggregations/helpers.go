@@ -0,0 +1,119 @@
+package aggregations
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// linkAggregationPayload creates the request payload of a link aggregation. The update request has the same fields.
+func linkAggregationPayload(ctx context.Context, data *resourceModel) (openApiClient.CreateNetworkSwitchLinkAggregationRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := *openApiClient.NewCreateNetworkSwitchLinkAggregationRequest()
+
+	if !data.SwitchPorts.IsNull() && !data.SwitchPorts.IsUnknown() {
+		var ports []switchPortModel
+		diags.Append(data.SwitchPorts.ElementsAs(ctx, &ports, false)...)
+		for _, port := range ports {
+			payload.SwitchPorts = append(payload.SwitchPorts,
+				*openApiClient.NewCreateNetworkSwitchLinkAggregationRequestSwitchPortsInner(port.Serial.ValueString(), port.PortId.ValueString()))
+		}
+	}
+
+	if !data.SwitchProfilePorts.IsNull() && !data.SwitchProfilePorts.IsUnknown() {
+		var ports []switchProfilePortModel
+		diags.Append(data.SwitchProfilePorts.ElementsAs(ctx, &ports, false)...)
+		for _, port := range ports {
+			payload.SwitchProfilePorts = append(payload.SwitchProfilePorts,
+				*openApiClient.NewCreateNetworkSwitchLinkAggregationRequestSwitchProfilePortsInner(port.Profile.ValueString(), port.PortId.ValueString()))
+		}
+	}
+
+	return payload, diags
+}
+
+// readLinkAggregation sets data from a link aggregation returned by the Dashboard API.
+func readLinkAggregation(ctx context.Context, data *resourceModel, aggregation apiLinkAggregation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.LinkAggregationId = types.StringValue(aggregation.Id)
+	data.Id = types.StringValue(data.NetworkId.ValueString() + "," + aggregation.Id)
+
+	data.SwitchPorts = types.SetNull(types.ObjectType{AttrTypes: switchPortAttrTypes()})
+	if len(aggregation.SwitchPorts) > 0 {
+		ports := make([]switchPortModel, 0, len(aggregation.SwitchPorts))
+		for _, port := range aggregation.SwitchPorts {
+			ports = append(ports, switchPortModel{
+				Serial: types.StringValue(port.Serial),
+				PortId: types.StringValue(port.PortId),
+			})
+		}
+
+		var setDiags diag.Diagnostics
+		data.SwitchPorts, setDiags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: switchPortAttrTypes()}, ports)
+		diags.Append(setDiags...)
+	}
+
+	data.SwitchProfilePorts = types.SetNull(types.ObjectType{AttrTypes: switchProfilePortAttrTypes()})
+	if len(aggregation.SwitchProfilePorts) > 0 {
+		ports := make([]switchProfilePortModel, 0, len(aggregation.SwitchProfilePorts))
+		for _, port := range aggregation.SwitchProfilePorts {
+			ports = append(ports, switchProfilePortModel{
+				Profile: types.StringValue(port.Profile),
+				PortId:  types.StringValue(port.PortId),
+			})
+		}
+
+		var setDiags diag.Diagnostics
+		data.SwitchProfilePorts, setDiags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: switchProfilePortAttrTypes()}, ports)
+		diags.Append(setDiags...)
+	}
+
+	return diags
+}
+
+// decodeLinkAggregation converts a link aggregation returned by the generated client, which is untyped.
+func decodeLinkAggregation(response interface{}) (apiLinkAggregation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var aggregation apiLinkAggregation
+	if err := utils.ConvertJSON(response, &aggregation); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the link aggregation: %s", err))
+	}
+	return aggregation, diags
+}
+
+// findLinkAggregation returns the link aggregation with the given ID from the link aggregations of a network.
+func findLinkAggregation(aggregations []map[string]interface{}, id string) (map[string]interface{}, bool) {
+	for _, aggregation := range aggregations {
+		if aggregationId, ok := aggregation["id"].(string); ok && aggregationId == id {
+			return aggregation, true
+		}
+	}
+	return nil, false
+}
+
+// memberPorts returns the keys of the switch ports of a link aggregation, or nil when they are not known yet.
+// Switch template ports are not managed by the switch port resource, so they are not returned.
+func memberPorts(ctx context.Context, switchPorts types.Set) ([]utils.SwitchPortKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if switchPorts.IsNull() || switchPorts.IsUnknown() {
+		return nil, diags
+	}
+
+	var ports []switchPortModel
+	diags.Append(switchPorts.ElementsAs(ctx, &ports, false)...)
+
+	members := make([]utils.SwitchPortKey, 0, len(ports))
+	for _, port := range ports {
+		if port.Serial.IsUnknown() || port.PortId.IsUnknown() {
+			return nil, diags
+		}
+		members = append(members, utils.NewSwitchPortKey(port.Serial.ValueString(), port.PortId.ValueString()))
+	}
+	return members, diags
+}
@@ -0,0 +1,72 @@
+package aggregations
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReadLinkAggregation(t *testing.T) {
+	ctx := context.Background()
+
+	var response []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"id": "other", "switchPorts": []},
+		{
+			"id": "NDU2N18yXzM=",
+			"switchPorts": [
+				{"serial": "Q234-ABCD-0001", "portId": "1"},
+				{"serial": "Q234-ABCD-0002", "portId": "1"}
+			]
+		}
+	]`), &response))
+
+	found, ok := findLinkAggregation(response, "NDU2N18yXzM=")
+	require.True(t, ok)
+
+	_, ok = findLinkAggregation(response, "missing")
+	assert.False(t, ok)
+
+	aggregation, diags := decodeLinkAggregation(found)
+	require.False(t, diags.HasError(), diags)
+
+	data := resourceModel{NetworkId: types.StringValue("N_1")}
+	diags = readLinkAggregation(ctx, &data, aggregation)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_1,NDU2N18yXzM=", data.Id.ValueString())
+	assert.Equal(t, "NDU2N18yXzM=", data.LinkAggregationId.ValueString())
+	assert.Len(t, data.SwitchPorts.Elements(), 2)
+	assert.True(t, data.SwitchProfilePorts.IsNull())
+
+	// Test case: Member ports are keyed for the switch port registry
+	members, diags := memberPorts(ctx, data.SwitchPorts)
+	require.False(t, diags.HasError(), diags)
+	assert.ElementsMatch(t, []utils.SwitchPortKey{
+		utils.NewSwitchPortKey("Q234-ABCD-0001", "1"),
+		utils.NewSwitchPortKey("Q234-ABCD-0002", "1"),
+	}, members)
+}
+
+func TestMemberPortsUnknown(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Members are not known until every serial and port ID is known
+	switchPorts, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: switchPortAttrTypes()}, []switchPortModel{
+		{Serial: types.StringValue("Q234-ABCD-0001"), PortId: types.StringValue("1")},
+		{Serial: types.StringUnknown(), PortId: types.StringValue("1")},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	members, diags := memberPorts(ctx, switchPorts)
+	require.False(t, diags.HasError(), diags)
+	assert.Nil(t, members)
+
+	members, diags = memberPorts(ctx, types.SetUnknown(types.ObjectType{AttrTypes: switchPortAttrTypes()}))
+	require.False(t, diags.HasError(), diags)
+	assert.Nil(t, members)
+}
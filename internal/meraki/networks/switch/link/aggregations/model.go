@@ -0,0 +1,57 @@
+package aggregations
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of a switch link aggregation.
+type resourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	NetworkId          types.String `tfsdk:"network_id"`
+	LinkAggregationId  types.String `tfsdk:"link_aggregation_id"`
+	SwitchPorts        types.Set    `tfsdk:"switch_ports"`
+	SwitchProfilePorts types.Set    `tfsdk:"switch_profile_ports"`
+}
+
+type switchPortModel struct {
+	Serial types.String `tfsdk:"serial"`
+	PortId types.String `tfsdk:"port_id"`
+}
+
+func switchPortAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"serial":  types.StringType,
+		"port_id": types.StringType,
+	}
+}
+
+type switchProfilePortModel struct {
+	Profile types.String `tfsdk:"profile"`
+	PortId  types.String `tfsdk:"port_id"`
+}
+
+func switchProfilePortAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"profile": types.StringType,
+		"port_id": types.StringType,
+	}
+}
+
+// apiLinkAggregation is a link aggregation in the format of the Dashboard API, which the generated client only
+// returns as a map.
+type apiLinkAggregation struct {
+	Id                 string                 `json:"id"`
+	SwitchPorts        []apiSwitchPort        `json:"switchPorts"`
+	SwitchProfilePorts []apiSwitchProfilePort `json:"switchProfilePorts"`
+}
+
+type apiSwitchPort struct {
+	Serial string `json:"serial"`
+	PortId string `json:"portId"`
+}
+
+type apiSwitchProfilePort struct {
+	Profile string `json:"profile"`
+	PortId  string `json:"portId"`
+}
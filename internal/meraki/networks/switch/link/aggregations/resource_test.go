@@ -0,0 +1,92 @@
+package aggregations_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccNetworksSwitchLinkAggregationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_link_aggregation"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_switch_link_aggregation"),
+			},
+
+			// Member ports managed with conflicting settings are rejected at plan time
+			{
+				Config:      NetworksSwitchLinkAggregationConflictConfig(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Conflicting Link Aggregation Member Settings"),
+			},
+
+			// Create and Read Link Aggregation
+			{
+				Config: NetworksSwitchLinkAggregationResourceConfig(`"1", "2"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("meraki_networks_switch_link_aggregation.test", "link_aggregation_id"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_link_aggregation.test", "switch_ports.#", "2"),
+				),
+			},
+
+			// Update and Read Link Aggregation
+			{
+				Config: NetworksSwitchLinkAggregationResourceConfig(`"1", "2", "3"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_link_aggregation.test", "switch_ports.#", "3"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_switch_link_aggregation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksSwitchLinkAggregationResourceConfig(portIds string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_switch_link_aggregation" "test" {
+    network_id = resource.meraki_network.test.network_id
+    switch_ports = [for port_id in [%s] : {
+        serial = "%s"
+        port_id = port_id
+    }]
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_link_aggregation"),
+		portIds,
+		os.Getenv("TF_ACC_MERAKI_MS_SERIAL"),
+	)
+}
+
+func NetworksSwitchLinkAggregationConflictConfig() string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_devices_switch_port" "test" {
+    for_each = { "1" = 10, "2" = 20 }
+    serial = "%s"
+    port_id = each.key
+    type = "access"
+    vlan = each.value
+}
+`,
+		NetworksSwitchLinkAggregationResourceConfig(`"1", "2"`),
+		os.Getenv("TF_ACC_MERAKI_MS_SERIAL"),
+	)
+}
@@ -0,0 +1,84 @@
+package aggregations
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a link aggregation of the switches in a network. Member ports that are also managed by `meraki_devices_switch_port` must share their settings, which is checked at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID and link aggregation ID, separated by a comma",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"link_aggregation_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the link aggregation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"switch_ports": schema.SetNestedAttribute{
+				MarkdownDescription: "The switch ports to aggregate, from 2 to 8 ports. Conflicts with `switch_profile_ports`.",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(2, 8),
+					setvalidator.ExactlyOneOf(path.MatchRoot("switch_profile_ports")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"serial": schema.StringAttribute{
+							MarkdownDescription: "Serial number of the switch",
+							Required:            true,
+							Validators: []validator.String{
+								utils.SerialValidator(),
+							},
+						},
+						"port_id": schema.StringAttribute{
+							MarkdownDescription: "Port identifier of the switch port",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"switch_profile_ports": schema.SetNestedAttribute{
+				MarkdownDescription: "The switch template ports to aggregate, from 2 to 8 ports. Conflicts with `switch_ports`.",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(2, 8),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"profile": schema.StringAttribute{
+							MarkdownDescription: "Profile identifier of the switch template",
+							Required:            true,
+						},
+						"port_id": schema.StringAttribute{
+							MarkdownDescription: "Port identifier of the switch template port",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// portSchedulePayload returns the schedule of each configured day. Days that are not configured keep their
+// current schedule.
+func portSchedulePayload(ctx context.Context, data *resourceModel) (*openApiClient.CreateNetworkSwitchPortScheduleRequestPortSchedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.PortSchedule) {
+		return nil, diags
+	}
+
+	schedule := map[string]apiScheduleDay{}
+	for day, value := range data.PortSchedule.Attributes() {
+		object, ok := value.(types.Object)
+		if !ok || !utils.IsKnown(object) {
+			continue
+		}
+
+		var model dayModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		schedule[day] = apiScheduleDay{
+			Active: model.Active.ValueBoolPointer(),
+			From:   model.From.ValueStringPointer(),
+			To:     model.To.ValueStringPointer(),
+		}
+	}
+
+	var payload openApiClient.CreateNetworkSwitchPortScheduleRequestPortSchedule
+	if err := utils.ConvertJSON(schedule, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the port schedule payload: %s", err))
+	}
+	return &payload, diags
+}
+
+// readPortSchedule sets data from a port schedule returned by the Dashboard API.
+func readPortSchedule(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var schedule apiPortSchedule
+	if err := utils.ConvertJSON(response, &schedule); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the port schedule: %s", err))
+		return diags
+	}
+
+	data.PortScheduleId = types.StringPointerValue(schedule.Id)
+	data.Id = types.StringValue(data.NetworkId.ValueString() + "," + data.PortScheduleId.ValueString())
+	data.Name = types.StringPointerValue(schedule.Name)
+
+	dayValues := make(map[string]attr.Value, len(days))
+	for _, day := range days {
+		dayValues[day] = types.ObjectNull(dayAttrTypes())
+		if value, ok := schedule.PortSchedule[day]; ok {
+			var objectDiags diag.Diagnostics
+			dayValues[day], objectDiags = types.ObjectValueFrom(ctx, dayAttrTypes(), dayModel{
+				Active: types.BoolPointerValue(value.Active),
+				From:   types.StringPointerValue(value.From),
+				To:     types.StringPointerValue(value.To),
+			})
+			diags.Append(objectDiags...)
+		}
+	}
+
+	var objectDiags diag.Diagnostics
+	data.PortSchedule, objectDiags = types.ObjectValue(portScheduleAttrTypes(), dayValues)
+	diags.Append(objectDiags...)
+
+	return diags
+}
+
+// findPortSchedule returns the port schedule with the given ID from the port schedules of a network.
+func findPortSchedule(schedules []openApiClient.GetNetworkSwitchPortSchedules200ResponseInner, id string) (*openApiClient.GetNetworkSwitchPortSchedules200ResponseInner, bool) {
+	for i := range schedules {
+		if schedules[i].GetId() == id {
+			return &schedules[i], true
+		}
+	}
+	return nil, false
+}
+
+// validatePortSchedule checks that each configured day starts before it ends.
+func validatePortSchedule(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.PortSchedule) {
+		return diags
+	}
+
+	for _, day := range days {
+		object, ok := data.PortSchedule.Attributes()[day].(types.Object)
+		if !ok || !utils.IsKnown(object) {
+			continue
+		}
+
+		var model dayModel
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if !utils.IsKnown(model.From) || !utils.IsKnown(model.To) {
+			continue
+		}
+
+		// Times are zero padded, so they can be compared as strings
+		if model.From.ValueString() >= model.To.ValueString() {
+			diags.AddAttributeError(path.Root("port_schedule").AtName(day), "Invalid Port Schedule",
+				fmt.Sprintf("%s must start before it ends, but is scheduled from %s to %s", day, model.From.ValueString(), model.To.ValueString()))
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,117 @@
+package schedules
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// testPortSchedule returns a port schedule with the given days configured and the other days unknown.
+func testPortSchedule(configured map[string][3]interface{}) types.Object {
+	values := map[string]attr.Value{}
+	for _, day := range days {
+		values[day] = types.ObjectUnknown(dayAttrTypes())
+		if schedule, ok := configured[day]; ok {
+			values[day] = types.ObjectValueMust(dayAttrTypes(), map[string]attr.Value{
+				"active": types.BoolValue(schedule[0].(bool)),
+				"from":   types.StringValue(schedule[1].(string)),
+				"to":     types.StringValue(schedule[2].(string)),
+			})
+		}
+	}
+	return types.ObjectValueMust(portScheduleAttrTypes(), values)
+}
+
+func TestPortSchedulePayload(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Only configured days are sent
+	data := resourceModel{PortSchedule: testPortSchedule(map[string][3]interface{}{
+		"monday":   {true, "08:00", "17:30"},
+		"saturday": {false, "00:00", "24:00"},
+	})}
+
+	payload, diags := portSchedulePayload(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"monday": {"active": true, "from": "08:00", "to": "17:30"},
+		"saturday": {"active": false, "from": "00:00", "to": "24:00"}
+	}`, string(body))
+
+	// Test case: An unknown schedule is not sent
+	data.PortSchedule = types.ObjectUnknown(portScheduleAttrTypes())
+	payload, diags = portSchedulePayload(ctx, &data)
+	require.False(t, diags.HasError(), diags)
+	assert.Nil(t, payload)
+}
+
+func TestReadPortSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	var response openApiClient.GetNetworkSwitchPortSchedules200ResponseInner
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "1234",
+		"networkId": "N_1",
+		"name": "Weekdays",
+		"portSchedule": {
+			"monday": {"active": true, "from": "09:00", "to": "17:00"},
+			"sunday": {"active": false, "from": "00:00", "to": "24:00"}
+		}
+	}`), &response))
+
+	data := resourceModel{NetworkId: types.StringValue("N_1")}
+	diags := readPortSchedule(ctx, &data, response)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_1,1234", data.Id.ValueString())
+	assert.Equal(t, "1234", data.PortScheduleId.ValueString())
+	assert.Equal(t, "Weekdays", data.Name.ValueString())
+
+	monday := data.PortSchedule.Attributes()["monday"].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("09:00"), monday["from"])
+	sunday := data.PortSchedule.Attributes()["sunday"].(types.Object).Attributes()
+	assert.Equal(t, types.BoolValue(false), sunday["active"])
+	assert.True(t, data.PortSchedule.Attributes()["tuesday"].IsNull())
+
+	// Test case: Port schedules are found by ID
+	found, ok := findPortSchedule([]openApiClient.GetNetworkSwitchPortSchedules200ResponseInner{{}, response}, "1234")
+	require.True(t, ok)
+	assert.Equal(t, "Weekdays", found.GetName())
+
+	_, ok = findPortSchedule([]openApiClient.GetNetworkSwitchPortSchedules200ResponseInner{response}, "5678")
+	assert.False(t, ok)
+}
+
+func TestValidatePortSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	data := resourceModel{PortSchedule: testPortSchedule(map[string][3]interface{}{
+		"monday": {true, "08:00", "17:00"},
+	})}
+	assert.False(t, validatePortSchedule(ctx, &data).HasError())
+
+	// Test case: A day must start before it ends
+	data.PortSchedule = testPortSchedule(map[string][3]interface{}{
+		"friday": {true, "17:00", "08:00"},
+	})
+	diags := validatePortSchedule(ctx, &data)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "friday must start before it ends")
+}
+
+func TestScheduleTime(t *testing.T) {
+	for _, valid := range []string{"00:00", "09:30", "23:30", "24:00"} {
+		assert.True(t, scheduleTime.MatchString(valid), valid)
+	}
+	for _, invalid := range []string{"24:30", "9:00", "09:15", "25:00", ""} {
+		assert.False(t, scheduleTime.MatchString(invalid), invalid)
+	}
+}
@@ -0,0 +1,55 @@
+package schedules
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// days are the days of a port schedule, in the order they are shown in the Dashboard.
+var days = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// resourceModel describes the data model of a switch port schedule.
+type resourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	NetworkId      types.String `tfsdk:"network_id"`
+	PortScheduleId types.String `tfsdk:"port_schedule_id"`
+	Name           types.String `tfsdk:"name"`
+	PortSchedule   types.Object `tfsdk:"port_schedule"`
+}
+
+// portScheduleAttrTypes returns the attribute types of the port schedule, which has an object for each day.
+func portScheduleAttrTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(days))
+	for _, day := range days {
+		attrTypes[day] = types.ObjectType{AttrTypes: dayAttrTypes()}
+	}
+	return attrTypes
+}
+
+type dayModel struct {
+	Active types.Bool   `tfsdk:"active"`
+	From   types.String `tfsdk:"from"`
+	To     types.String `tfsdk:"to"`
+}
+
+func dayAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"active": types.BoolType,
+		"from":   types.StringType,
+		"to":     types.StringType,
+	}
+}
+
+// apiPortSchedule is a port schedule in the format of the Dashboard API, with the schedule of each day keyed by
+// the lower case name of the day.
+type apiPortSchedule struct {
+	Id           *string                   `json:"id,omitempty"`
+	Name         *string                   `json:"name,omitempty"`
+	PortSchedule map[string]apiScheduleDay `json:"portSchedule,omitempty"`
+}
+
+type apiScheduleDay struct {
+	Active *bool   `json:"active,omitempty"`
+	From   *string `json:"from,omitempty"`
+	To     *string `json:"to,omitempty"`
+}
@@ -0,0 +1,81 @@
+package schedules_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksSwitchPortScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_port_schedule"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_switch_port_schedule"),
+			},
+
+			// Create and Read Port Schedule
+			{
+				Config: NetworksSwitchPortScheduleResourceConfig("Weekdays", "17:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("meraki_networks_switch_port_schedule.test", "port_schedule_id"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_port_schedule.test", "name", "Weekdays"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_port_schedule.test", "port_schedule.monday.active", "true"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_port_schedule.test", "port_schedule.monday.to", "17:00"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_port_schedule.test", "port_schedule.sunday.active", "false"),
+				),
+			},
+
+			// Update and Read Port Schedule
+			{
+				Config: NetworksSwitchPortScheduleResourceConfig("Office hours", "18:30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_port_schedule.test", "name", "Office hours"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_port_schedule.test", "port_schedule.monday.to", "18:30"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_switch_port_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksSwitchPortScheduleResourceConfig(name, mondayTo string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_switch_port_schedule" "test" {
+    network_id = resource.meraki_network.test.network_id
+    name = "%s"
+    port_schedule = {
+        monday = {
+            active = true
+            from = "08:00"
+            to = "%s"
+        }
+        sunday = {
+            active = false
+            from = "00:00"
+            to = "24:00"
+        }
+    }
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_port_schedule"),
+		name,
+		mondayTo,
+	)
+}
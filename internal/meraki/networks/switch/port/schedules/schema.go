@@ -0,0 +1,93 @@
+package schedules
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"regexp"
+	"strings"
+)
+
+// scheduleTime matches times from 00:00 to 24:00 in 30 minute increments.
+var scheduleTime = regexp.MustCompile(`^(([01][0-9]|2[0-3]):(00|30)|24:00)$`)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dayAttributes := make(map[string]schema.Attribute, len(days))
+	for _, day := range days {
+		dayAttributes[day] = dayAttribute(day)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a port schedule of the switches in a network. Switch ports use the schedule by setting `port_schedule_id` to the `port_schedule_id` of this resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID and port schedule ID, separated by a comma",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port_schedule_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the port schedule, which switch ports reference in `port_schedule_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the port schedule",
+				Required:            true,
+			},
+			"port_schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "The schedule of each day. Days that are not configured are active all day.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: dayAttributes,
+			},
+		},
+	}
+}
+
+func dayAttribute(day string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The schedule of " + strings.ToUpper(day[:1]) + day[1:],
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schedule is active (true) or inactive (false) between `from` and `to`",
+				Required:            true,
+			},
+			"from": timeAttribute("The time the schedule starts, from '00:00' to '24:00' in 30 minute increments"),
+			"to":   timeAttribute("The time the schedule ends, from '00:00' to '24:00' in 30 minute increments. Must be later than `from`."),
+		},
+	}
+}
+
+func timeAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(scheduleTime, "must be a time from 00:00 to 24:00 in 30 minute increments"),
+		},
+	}
+}
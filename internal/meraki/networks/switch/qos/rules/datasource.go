@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
 	"net/http"
-	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -26,6 +25,7 @@ func NewDataSource() datasource.DataSource {
 // DataSource defines the resource implementation.
 type DataSource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 func (r *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -118,18 +118,19 @@ func (r *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
 func (r *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -142,29 +143,13 @@ func (r *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[[]map[string]interface{}](ctx, maxRetries, retryDelay, func() ([]map[string]interface{}, *http.Response, error) {
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[[]map[string]interface{}](ctx, r.retry, func() ([]map[string]interface{}, *http.Response, error) {
 		inline, respHttp, err := r.client.QosRulesApi.GetNetworkSwitchQosRules(ctx, data.NetworkId.ValueString()).Execute()
 		return inline, respHttp, err
 	})
 
 	if err != nil {
-		tflog.Error(ctx, "HTTP Call Failed", map[string]interface{}{
-			"error": err.Error(),
-		})
-		resp.Diagnostics.AddError(
-			"HTTP Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
-	}
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -8,7 +8,6 @@ import (
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,6 +31,7 @@ func NewResource() resource.Resource {
 // Resource defines the resource implementation.
 type Resource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -121,18 +121,19 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -171,49 +172,14 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		"payload": payload,
 	})
 
-	// Retry logic for 500 Internal Server Error
-	const maxRetries500 = 3
-	const retryDelay500 = 3 * time.Second
-
-	// retry variables for all other errors
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	apiCall := func() (map[string]interface{}, *http.Response, error) {
-		return r.client.QosRulesApi.CreateNetworkSwitchQosRule(context.Background(), data.NetworkId.ValueString()).CreateNetworkSwitchQosRuleRequest(payload).Execute()
-	}
-
-	var inlineResp map[string]interface{}
-	var httpResp *http.Response
-	var err error
-
-	for attempt := 0; attempt < maxRetries500; attempt++ {
-		inlineResp, httpResp, err = utils.CustomHttpRequestRetry[map[string]interface{}](ctx, maxRetries, retryDelay, apiCall)
-
-		if err == nil && httpResp.StatusCode == 201 {
-			// Success, break the retry loop
-			break
-		}
-
-		if httpResp != nil && httpResp.StatusCode == 500 {
-			tflog.Warn(ctx, "Received 500 Internal Server Error, retrying...", map[string]interface{}{
-				"attempt": attempt + 1,
-			})
-			time.Sleep(retryDelay500)
-		} else {
-			// Break on other errors
-			break
-		}
-	}
+	// Server errors are retried by CustomHttpRequestRetry along with rate limiting
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (map[string]interface{}, *http.Response, error) {
+		return r.client.QosRulesApi.CreateNetworkSwitchQosRule(ctx, data.NetworkId.ValueString()).CreateNetworkSwitchQosRuleRequest(payload).Execute()
+	})
 
 	if err != nil {
-		tflog.Error(ctx, "HTTP Call Failed", map[string]interface{}{
-			"error": err.Error(),
-		})
-		resp.Diagnostics.AddError(
-			"HTTP Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Create Failure", httpResp, err))
+		return
 	}
 
 	// Check for API success response code
@@ -286,22 +252,14 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkSwitchQosRule200Response](ctx, maxRetries, retryDelay, func() (*openApiClient.GetNetworkSwitchQosRule200Response, *http.Response, error) {
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkSwitchQosRule200Response](ctx, r.retry, func() (*openApiClient.GetNetworkSwitchQosRule200Response, *http.Response, error) {
 		inline, respHttp, err := r.client.QosRulesApi.GetNetworkSwitchQosRule(ctx, data.NetworkId.ValueString(), data.QosRulesId.ValueString()).Execute()
 		return inline, respHttp, err
 	})
 
 	if err != nil {
-		tflog.Error(ctx, "HTTP Call Failed", map[string]interface{}{
-			"error": err.Error(),
-		})
-		resp.Diagnostics.AddError(
-			"HTTP Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return
 	}
 
 	// Check for API success response code
@@ -395,29 +353,13 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		payload.SetSrcPort(int32(data.SrcPort.ValueFloat64()))
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[map[string]interface{}](ctx, maxRetries, retryDelay, func() (map[string]interface{}, *http.Response, error) {
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[map[string]interface{}](ctx, r.retry, func() (map[string]interface{}, *http.Response, error) {
 		inline, respHttp, err := r.client.QosRulesApi.UpdateNetworkSwitchQosRule(ctx, data.NetworkId.ValueString(), stateData.QosRulesId.ValueString()).UpdateNetworkSwitchQosRuleRequest(payload).Execute()
 		return inline, respHttp, err
 	})
 
 	if err != nil {
-		tflog.Error(ctx, "HTTP Call Failed", map[string]interface{}{
-			"error": err.Error(),
-		})
-		resp.Diagnostics.AddError(
-			"HTTP Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
-	}
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -485,29 +427,13 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 
 	//httpResp, err := r.client.QosRulesApi.DeleteNetworkSwitchQosRule(ctx, data.NetworkId.ValueString(), data.QosRulesId.ValueString()).Execute()
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	_, httpResp, err := utils.CustomHttpRequestRetry[map[string]interface{}](ctx, maxRetries, retryDelay, func() (map[string]interface{}, *http.Response, error) {
+	_, httpResp, err := utils.CustomHttpRequestRetry[map[string]interface{}](ctx, r.retry, func() (map[string]interface{}, *http.Response, error) {
 		respHttp, err := r.client.QosRulesApi.DeleteNetworkSwitchQosRule(ctx, data.NetworkId.ValueString(), data.QosRulesId.ValueString()).Execute()
 		return nil, respHttp, err
 	})
 
 	if err != nil {
-		tflog.Error(ctx, "HTTP Call Failed", map[string]interface{}{
-			"error": err.Error(),
-		})
-		resp.Diagnostics.AddError(
-			"HTTP Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
-	}
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -0,0 +1,183 @@
+package multicast
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// multicastPayload returns the multicast payload for the planned data. Overrides that are not configured are sent
+// empty so that the Dashboard API removes them.
+func multicastPayload(ctx context.Context, data *resourceModel) (openApiClient.UpdateNetworkSwitchRoutingMulticastRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateNetworkSwitchRoutingMulticastRequest()
+
+	if utils.IsKnown(data.DefaultSettings) {
+		var settings defaultSettingsModel
+		diags.Append(data.DefaultSettings.As(ctx, &settings, basetypes.ObjectAsOptions{})...)
+
+		defaultSettings := *openApiClient.NewUpdateNetworkSwitchRoutingMulticastRequestDefaultSettings()
+		if utils.IsKnown(settings.IgmpSnoopingEnabled) {
+			defaultSettings.SetIgmpSnoopingEnabled(settings.IgmpSnoopingEnabled.ValueBool())
+		}
+		if utils.IsKnown(settings.FloodUnknownMulticastTrafficEnabled) {
+			defaultSettings.SetFloodUnknownMulticastTrafficEnabled(settings.FloodUnknownMulticastTrafficEnabled.ValueBool())
+		}
+		payload.SetDefaultSettings(defaultSettings)
+	}
+
+	var overrides []overrideModel
+	diags.Append(data.Overrides.ElementsAs(ctx, &overrides, false)...)
+
+	payload.Overrides = []openApiClient.UpdateNetworkSwitchRoutingMulticastRequestOverridesInner{}
+	for _, override := range overrides {
+		overridePayload := *openApiClient.NewUpdateNetworkSwitchRoutingMulticastRequestOverridesInner(
+			override.IgmpSnoopingEnabled.ValueBool(), override.FloodUnknownMulticastTrafficEnabled.ValueBool())
+		if !override.SwitchProfiles.IsNull() {
+			diags.Append(override.SwitchProfiles.ElementsAs(ctx, &overridePayload.SwitchProfiles, false)...)
+		}
+		if !override.Switches.IsNull() {
+			diags.Append(override.Switches.ElementsAs(ctx, &overridePayload.Switches, false)...)
+		}
+		if !override.Stacks.IsNull() {
+			diags.Append(override.Stacks.ElementsAs(ctx, &overridePayload.Stacks, false)...)
+		}
+		payload.Overrides = append(payload.Overrides, overridePayload)
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that restores the default multicast settings of a network, where IGMP snooping
+// and flooding of unknown multicast traffic are enabled on every switch.
+func resetPayload() openApiClient.UpdateNetworkSwitchRoutingMulticastRequest {
+	defaultSettings := *openApiClient.NewUpdateNetworkSwitchRoutingMulticastRequestDefaultSettings()
+	defaultSettings.SetIgmpSnoopingEnabled(true)
+	defaultSettings.SetFloodUnknownMulticastTrafficEnabled(true)
+
+	payload := *openApiClient.NewUpdateNetworkSwitchRoutingMulticastRequest()
+	payload.SetDefaultSettings(defaultSettings)
+	payload.Overrides = []openApiClient.UpdateNetworkSwitchRoutingMulticastRequestOverridesInner{}
+	return payload
+}
+
+// readMulticast sets data from the multicast settings returned by the Dashboard API.
+func readMulticast(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var multicast apiMulticast
+	if err := utils.ConvertJSON(response, &multicast); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the multicast settings: %s", err))
+		return diags
+	}
+
+	data.Id = data.NetworkId
+
+	data.DefaultSettings = types.ObjectNull(defaultSettingsAttrTypes())
+	if multicast.DefaultSettings != nil {
+		var objectDiags diag.Diagnostics
+		data.DefaultSettings, objectDiags = types.ObjectValueFrom(ctx, defaultSettingsAttrTypes(), defaultSettingsModel{
+			IgmpSnoopingEnabled:                 types.BoolPointerValue(multicast.DefaultSettings.IgmpSnoopingEnabled),
+			FloodUnknownMulticastTrafficEnabled: types.BoolPointerValue(multicast.DefaultSettings.FloodUnknownMulticastTrafficEnabled),
+		})
+		diags.Append(objectDiags...)
+	}
+
+	if len(multicast.Overrides) == 0 {
+		data.Overrides = types.ListNull(types.ObjectType{AttrTypes: overrideAttrTypes()})
+		return diags
+	}
+
+	var overrides []overrideModel
+	for _, override := range multicast.Overrides {
+		model := overrideModel{
+			IgmpSnoopingEnabled:                 types.BoolValue(override.IgmpSnoopingEnabled),
+			FloodUnknownMulticastTrafficEnabled: types.BoolValue(override.FloodUnknownMulticastTrafficEnabled),
+		}
+
+		var setDiags diag.Diagnostics
+		model.SwitchProfiles, setDiags = stringSet(ctx, override.SwitchProfiles)
+		diags.Append(setDiags...)
+		model.Switches, setDiags = stringSet(ctx, override.Switches)
+		diags.Append(setDiags...)
+		model.Stacks, setDiags = stringSet(ctx, override.Stacks)
+		diags.Append(setDiags...)
+
+		overrides = append(overrides, model)
+	}
+
+	var listDiags diag.Diagnostics
+	data.Overrides, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: overrideAttrTypes()}, overrides)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// validateMulticast checks at plan time that every override targets exactly one kind of switch group and that no
+// switch, switch profile or stack is in more than one override.
+func validateMulticast(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Overrides.IsNull() || data.Overrides.IsUnknown() {
+		return diags
+	}
+
+	var overrides []overrideModel
+	diags.Append(data.Overrides.ElementsAs(ctx, &overrides, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	seen := map[string]int{}
+	for i, override := range overrides {
+		overridePath := path.Root("overrides").AtListIndex(i)
+
+		targets := map[string]types.Set{
+			"switch_profiles": override.SwitchProfiles,
+			"switches":        override.Switches,
+			"stacks":          override.Stacks,
+		}
+		configured := 0
+		for _, target := range targets {
+			if !target.IsNull() {
+				configured++
+			}
+		}
+		if configured != 1 {
+			diags.AddAttributeError(overridePath, "Invalid Multicast Override",
+				"exactly one of switch_profiles, switches or stacks must be set")
+			continue
+		}
+
+		for name, target := range targets {
+			if target.IsNull() || target.IsUnknown() {
+				continue
+			}
+			var ids []string
+			diags.Append(target.ElementsAs(ctx, &ids, false)...)
+			for _, id := range ids {
+				if first, ok := seen[id]; ok {
+					diags.AddAttributeError(overridePath.AtName(name), "Duplicate Multicast Override",
+						fmt.Sprintf("%s is already in override %d", id, first))
+					continue
+				}
+				seen[id] = i
+			}
+		}
+	}
+
+	return diags
+}
+
+func stringSet(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,131 @@
+package multicast
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testOverride(switches, stacks []string) attr.Value {
+	set := func(values []string) types.Set {
+		if values == nil {
+			return types.SetNull(types.StringType)
+		}
+		var elements []attr.Value
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	return types.ObjectValueMust(overrideAttrTypes(), map[string]attr.Value{
+		"switch_profiles":       types.SetNull(types.StringType),
+		"switches":              set(switches),
+		"stacks":                set(stacks),
+		"igmp_snooping_enabled": types.BoolValue(false),
+		"flood_unknown_multicast_traffic_enabled": types.BoolValue(true),
+	})
+}
+
+func testResourceModel(overrides ...attr.Value) resourceModel {
+	data := resourceModel{
+		Id:        types.StringUnknown(),
+		NetworkId: types.StringValue("N_1"),
+		DefaultSettings: types.ObjectValueMust(defaultSettingsAttrTypes(), map[string]attr.Value{
+			"igmp_snooping_enabled":                   types.BoolValue(true),
+			"flood_unknown_multicast_traffic_enabled": types.BoolUnknown(),
+		}),
+		Overrides: types.ListNull(types.ObjectType{AttrTypes: overrideAttrTypes()}),
+	}
+	if len(overrides) > 0 {
+		data.Overrides = types.ListValueMust(types.ObjectType{AttrTypes: overrideAttrTypes()}, overrides)
+	}
+	return data
+}
+
+func TestMulticastPayload(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Unknown default settings are left out and overrides that are not configured are removed
+	data := testResourceModel()
+	payload, diags := multicastPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"defaultSettings": {"igmpSnoopingEnabled": true}, "overrides": []}`, string(body))
+
+	// Test case: Only the configured targets of an override are sent
+	data = testResourceModel(testOverride([]string{"Q234-ABCD-5678"}, nil))
+	payload, diags = multicastPayload(ctx, &data)
+	require.False(t, diags.HasError())
+
+	body, err = json.Marshal(payload.Overrides)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"switches": ["Q234-ABCD-5678"], "igmpSnoopingEnabled": false, "floodUnknownMulticastTrafficEnabled": true}]`, string(body))
+}
+
+func TestReadMulticast(t *testing.T) {
+	ctx := context.Background()
+
+	data := testResourceModel()
+	response := map[string]interface{}{
+		"defaultSettings": map[string]interface{}{"igmpSnoopingEnabled": true, "floodUnknownMulticastTrafficEnabled": false},
+		"overrides": []interface{}{
+			map[string]interface{}{"stacks": []interface{}{"789102"}, "igmpSnoopingEnabled": false, "floodUnknownMulticastTrafficEnabled": true},
+		},
+	}
+	require.False(t, readMulticast(ctx, &data, response).HasError())
+
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	assert.Equal(t, testResourceModel(testOverride(nil, []string{"789102"})).Overrides, data.Overrides)
+	assert.Equal(t, types.BoolValue(false), data.DefaultSettings.Attributes()["flood_unknown_multicast_traffic_enabled"])
+}
+
+func TestValidateMulticast(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		overrides []attr.Value
+		err       string
+	}{
+		{
+			name:      "no target",
+			overrides: []attr.Value{testOverride(nil, nil)},
+			err:       "Invalid Multicast Override",
+		},
+		{
+			name:      "several targets",
+			overrides: []attr.Value{testOverride([]string{"Q234-ABCD-5678"}, []string{"789102"})},
+			err:       "Invalid Multicast Override",
+		},
+		{
+			name:      "switch in two overrides",
+			overrides: []attr.Value{testOverride([]string{"Q234-ABCD-5678"}, nil), testOverride([]string{"Q234-ABCD-5678"}, nil)},
+			err:       "Duplicate Multicast Override",
+		},
+		{
+			name:      "valid",
+			overrides: []attr.Value{testOverride([]string{"Q234-ABCD-5678"}, nil), testOverride(nil, []string{"789102"})},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel(tt.overrides...)
+
+			diags := validateMulticast(ctx, &data)
+			if tt.err == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, tt.err, diags.Errors()[0].Summary())
+		})
+	}
+}
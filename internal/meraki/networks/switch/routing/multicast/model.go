@@ -0,0 +1,60 @@
+package multicast
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the switch multicast settings of a network.
+type resourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	NetworkId       types.String `tfsdk:"network_id"`
+	DefaultSettings types.Object `tfsdk:"default_settings"`
+	Overrides       types.List   `tfsdk:"overrides"`
+}
+
+type defaultSettingsModel struct {
+	IgmpSnoopingEnabled                 types.Bool `tfsdk:"igmp_snooping_enabled"`
+	FloodUnknownMulticastTrafficEnabled types.Bool `tfsdk:"flood_unknown_multicast_traffic_enabled"`
+}
+
+func defaultSettingsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"igmp_snooping_enabled":                   types.BoolType,
+		"flood_unknown_multicast_traffic_enabled": types.BoolType,
+	}
+}
+
+type overrideModel struct {
+	SwitchProfiles                      types.Set  `tfsdk:"switch_profiles"`
+	Switches                            types.Set  `tfsdk:"switches"`
+	Stacks                              types.Set  `tfsdk:"stacks"`
+	IgmpSnoopingEnabled                 types.Bool `tfsdk:"igmp_snooping_enabled"`
+	FloodUnknownMulticastTrafficEnabled types.Bool `tfsdk:"flood_unknown_multicast_traffic_enabled"`
+}
+
+func overrideAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"switch_profiles":       types.SetType{ElemType: types.StringType},
+		"switches":              types.SetType{ElemType: types.StringType},
+		"stacks":                types.SetType{ElemType: types.StringType},
+		"igmp_snooping_enabled": types.BoolType,
+		"flood_unknown_multicast_traffic_enabled": types.BoolType,
+	}
+}
+
+// apiMulticast is the switch multicast configuration in the format of the Dashboard API. The generated client
+// returns a struct when reading and a map when updating, which are both converted to this type.
+type apiMulticast struct {
+	DefaultSettings *struct {
+		IgmpSnoopingEnabled                 *bool `json:"igmpSnoopingEnabled,omitempty"`
+		FloodUnknownMulticastTrafficEnabled *bool `json:"floodUnknownMulticastTrafficEnabled,omitempty"`
+	} `json:"defaultSettings,omitempty"`
+	Overrides []struct {
+		SwitchProfiles                      []string `json:"switchProfiles,omitempty"`
+		Switches                            []string `json:"switches,omitempty"`
+		Stacks                              []string `json:"stacks,omitempty"`
+		IgmpSnoopingEnabled                 bool     `json:"igmpSnoopingEnabled"`
+		FloodUnknownMulticastTrafficEnabled bool     `json:"floodUnknownMulticastTrafficEnabled"`
+	} `json:"overrides,omitempty"`
+}
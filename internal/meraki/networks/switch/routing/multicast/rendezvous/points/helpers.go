@@ -0,0 +1,56 @@
+package points
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/netip"
+)
+
+// anyMulticastGroup makes a rendezvous point serve every multicast group.
+const anyMulticastGroup = "Any"
+
+// multicastRange is the IPv4 multicast address range.
+var multicastRange = netip.MustParsePrefix("224.0.0.0/4")
+
+// readRendezvousPoint sets data from the rendezvous point returned by the Dashboard API.
+func readRendezvousPoint(data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var point apiRendezvousPoint
+	if err := utils.ConvertJSON(response, &point); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the rendezvous point: %s", err))
+		return diags
+	}
+
+	data.RendezvousPointId = types.StringPointerValue(point.RendezvousPointId)
+	data.InterfaceIp = types.StringPointerValue(point.InterfaceIp)
+	data.MulticastGroup = types.StringPointerValue(point.MulticastGroup)
+	data.Serial = types.StringPointerValue(point.Serial)
+	data.InterfaceName = types.StringPointerValue(point.InterfaceName)
+	data.Id = types.StringValue(data.NetworkId.ValueString() + "," + data.RendezvousPointId.ValueString())
+
+	return diags
+}
+
+// validateMulticastGroup checks that group is "Any" or an IPv4 multicast subnet.
+func validateMulticastGroup(group types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if group.IsNull() || group.IsUnknown() || group.ValueString() == anyMulticastGroup {
+		return diags
+	}
+
+	prefix, err := utils.ParseIPv4Subnet(group.ValueString())
+	if err == nil && !(multicastRange.Contains(prefix.Addr()) && prefix.Bits() >= multicastRange.Bits()) {
+		err = fmt.Errorf("%s is not within the multicast range %s", prefix, multicastRange)
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("multicast_group"), "Invalid Multicast Group",
+			fmt.Sprintf("multicast_group must be %q or an IPv4 multicast subnet: %s", anyMulticastGroup, err))
+	}
+
+	return diags
+}
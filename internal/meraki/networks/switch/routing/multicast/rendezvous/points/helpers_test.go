@@ -0,0 +1,51 @@
+package points
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidateMulticastGroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		group types.String
+		valid bool
+	}{
+		{name: "any", group: types.StringValue("Any"), valid: true},
+		{name: "multicast subnet", group: types.StringValue("239.0.0.0/8"), valid: true},
+		{name: "whole multicast range", group: types.StringValue("224.0.0.0/4"), valid: true},
+		{name: "unknown", group: types.StringUnknown(), valid: true},
+		{name: "unicast subnet", group: types.StringValue("192.168.0.0/24")},
+		{name: "wider than multicast range", group: types.StringValue("224.0.0.0/3")},
+		{name: "not a subnet", group: types.StringValue("239.1.1.1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateMulticastGroup(tt.group)
+			assert.Equal(t, !tt.valid, diags.HasError(), diags)
+		})
+	}
+}
+
+func TestReadRendezvousPoint(t *testing.T) {
+	data := resourceModel{NetworkId: types.StringValue("N_1")}
+
+	diags := readRendezvousPoint(&data, map[string]interface{}{
+		"rendezvousPointId": "1234",
+		"interfaceIp":       "192.168.1.2",
+		"multicastGroup":    "Any",
+		"serial":            "Q234-ABCD-5678",
+		"interfaceName":     "l3_interface",
+	})
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "N_1,1234", data.Id.ValueString())
+	assert.Equal(t, "1234", data.RendezvousPointId.ValueString())
+	assert.Equal(t, "192.168.1.2", data.InterfaceIp.ValueString())
+	assert.Equal(t, "Any", data.MulticastGroup.ValueString())
+	assert.Equal(t, "Q234-ABCD-5678", data.Serial.ValueString())
+	assert.Equal(t, "l3_interface", data.InterfaceName.ValueString())
+}
@@ -0,0 +1,26 @@
+package points
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of a switch multicast rendezvous point.
+type resourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	NetworkId         types.String `tfsdk:"network_id"`
+	RendezvousPointId types.String `tfsdk:"rendezvous_point_id"`
+	InterfaceIp       types.String `tfsdk:"interface_ip"`
+	MulticastGroup    types.String `tfsdk:"multicast_group"`
+	Serial            types.String `tfsdk:"serial"`
+	InterfaceName     types.String `tfsdk:"interface_name"`
+}
+
+// apiRendezvousPoint is a rendezvous point in the format of the Dashboard API, which the generated client returns as
+// a map.
+type apiRendezvousPoint struct {
+	RendezvousPointId *string `json:"rendezvousPointId,omitempty"`
+	InterfaceIp       *string `json:"interfaceIp,omitempty"`
+	MulticastGroup    *string `json:"multicastGroup,omitempty"`
+	Serial            *string `json:"serial,omitempty"`
+	InterfaceName     *string `json:"interfaceName,omitempty"`
+}
@@ -0,0 +1,59 @@
+package points
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a multicast rendezvous point of the switches in a network",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID and rendezvous point ID, separated by a comma",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rendezvous_point_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the rendezvous point",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface_ip": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the routing interface that will be used as the rendezvous point",
+				Required:            true,
+				Validators: []validator.String{
+					utils.IPv4AddressValidator(),
+				},
+			},
+			"multicast_group": schema.StringAttribute{
+				MarkdownDescription: "'Any', or the IP address of a multicast group in CIDR notation (ex. 239.0.0.0/8)",
+				Required:            true,
+			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the switch with the routing interface",
+				Computed:            true,
+			},
+			"interface_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the routing interface",
+				Computed:            true,
+			},
+		},
+	}
+}
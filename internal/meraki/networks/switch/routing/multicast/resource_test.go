@@ -0,0 +1,67 @@
+package multicast_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksSwitchRoutingMulticastResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_routing_multicast"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_switch_routing_multicast"),
+			},
+
+			// Create and Read Multicast
+			{
+				Config: NetworksSwitchRoutingMulticastResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_multicast.test", "default_settings.igmp_snooping_enabled", "false"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_multicast.test", "default_settings.flood_unknown_multicast_traffic_enabled", "true"),
+				),
+			},
+
+			// Update and Read Multicast
+			{
+				Config: NetworksSwitchRoutingMulticastResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_multicast.test", "default_settings.igmp_snooping_enabled", "true"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_switch_routing_multicast.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksSwitchRoutingMulticastResourceConfig(igmpSnoopingEnabled bool) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_switch_routing_multicast" "test" {
+    network_id = resource.meraki_network.test.network_id
+    default_settings = {
+        igmp_snooping_enabled = %t
+        flood_unknown_multicast_traffic_enabled = true
+    }
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_routing_multicast"),
+		igmpSnoopingEnabled,
+	)
+}
@@ -0,0 +1,106 @@
+package multicast
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the multicast and IGMP snooping settings of the switches in a network. Deleting this resource removes the overrides and enables IGMP snooping and flooding of unknown multicast traffic for every switch. PIM is enabled per routing interface with `multicast_routing` and rendezvous points are managed with `meraki_networks_switch_routing_multicast_rendezvous_point`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Default multicast setting for the entire network",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"igmp_snooping_enabled": schema.BoolAttribute{
+						MarkdownDescription: "IGMP snooping setting for the entire network",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"flood_unknown_multicast_traffic_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Flood unknown multicast traffic setting for the entire network",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"overrides": schema.ListNestedAttribute{
+				MarkdownDescription: "Array of multicast settings for individual switches, switch profiles or stacks. Overrides that are not configured are removed.",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"switch_profiles": schema.SetAttribute{
+							MarkdownDescription: "IDs of the switch profiles the override applies to. Only applicable to template networks.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"switches": schema.SetAttribute{
+							MarkdownDescription: "Serials of the switches the override applies to",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"stacks": schema.SetAttribute{
+							MarkdownDescription: "IDs of the switch stacks the override applies to",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"igmp_snooping_enabled": schema.BoolAttribute{
+							MarkdownDescription: "IGMP snooping setting for the switches, switch profiles or stacks",
+							Required:            true,
+						},
+						"flood_unknown_multicast_traffic_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Flood unknown multicast traffic setting for the switches, switch profiles or stacks",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,320 @@
+package ospf
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"strconv"
+)
+
+// passphrasePath is the path of the MD5 authentication passphrase, which is encrypted in state.
+var passphrasePath = path.Root("md5_authentication_key").AtName("passphrase")
+
+// Default OSPF timers, which Delete restores.
+const (
+	defaultHelloTimerInSeconds = 10
+	defaultDeadTimerInSeconds  = 40
+)
+
+// ospfPayload returns the OSPF payload for the planned data, with the MD5 authentication passphrase decrypted with
+// keys. Areas that are not configured are sent empty so that the Dashboard API removes them.
+func ospfPayload(ctx context.Context, data *resourceModel, keys utils.EncryptionKeys) (openApiClient.UpdateNetworkSwitchRoutingOspfRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := *openApiClient.NewUpdateNetworkSwitchRoutingOspfRequest()
+	if utils.IsKnown(data.Enabled) {
+		payload.SetEnabled(data.Enabled.ValueBool())
+	}
+	if utils.IsKnown(data.HelloTimerInSeconds) {
+		payload.SetHelloTimerInSeconds(int32(data.HelloTimerInSeconds.ValueInt64()))
+	}
+	if utils.IsKnown(data.DeadTimerInSeconds) {
+		payload.SetDeadTimerInSeconds(int32(data.DeadTimerInSeconds.ValueInt64()))
+	}
+
+	var areaDiags diag.Diagnostics
+	payload.Areas, areaDiags = areasPayload(ctx, data.Areas)
+	diags.Append(areaDiags...)
+
+	if utils.IsKnown(data.V3) {
+		var v3 v3Model
+		diags.Append(data.V3.As(ctx, &v3, basetypes.ObjectAsOptions{})...)
+
+		v3Payload := openApiClient.UpdateNetworkSwitchRoutingOspfRequestV3{}
+		if utils.IsKnown(v3.Enabled) {
+			v3Payload.SetEnabled(v3.Enabled.ValueBool())
+		}
+		if utils.IsKnown(v3.HelloTimerInSeconds) {
+			v3Payload.SetHelloTimerInSeconds(int32(v3.HelloTimerInSeconds.ValueInt64()))
+		}
+		if utils.IsKnown(v3.DeadTimerInSeconds) {
+			v3Payload.SetDeadTimerInSeconds(int32(v3.DeadTimerInSeconds.ValueInt64()))
+		}
+		v3Payload.Areas, areaDiags = areasPayload(ctx, v3.Areas)
+		diags.Append(areaDiags...)
+		payload.SetV3(v3Payload)
+	}
+
+	if utils.IsKnown(data.Md5AuthenticationEnabled) {
+		payload.SetMd5AuthenticationEnabled(data.Md5AuthenticationEnabled.ValueBool())
+	}
+	if utils.IsKnown(data.Md5AuthenticationKey) {
+		var key md5AuthenticationKeyModel
+		diags.Append(data.Md5AuthenticationKey.As(ctx, &key, basetypes.ObjectAsOptions{})...)
+
+		keyPayload := openApiClient.UpdateNetworkSwitchRoutingOspfRequestMd5AuthenticationKey{}
+		keyPayload.SetId(int32(key.Id.ValueInt64()))
+		passphrase, _, err := keys.Decrypt(key.Passphrase.ValueString())
+		if err != nil {
+			diags.Append(utils.NewEncryptionDiagnostic(passphrasePath, err))
+			return payload, diags
+		}
+		keyPayload.SetPassphrase(passphrase)
+		payload.SetMd5AuthenticationKey(keyPayload)
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that restores the default OSPF settings of a network.
+func resetPayload() openApiClient.UpdateNetworkSwitchRoutingOspfRequest {
+	v3 := openApiClient.UpdateNetworkSwitchRoutingOspfRequestV3{}
+	v3.SetEnabled(false)
+	v3.Areas = []openApiClient.UpdateNetworkSwitchRoutingOspfRequestAreasInner{}
+
+	payload := *openApiClient.NewUpdateNetworkSwitchRoutingOspfRequest()
+	payload.SetEnabled(false)
+	payload.SetHelloTimerInSeconds(defaultHelloTimerInSeconds)
+	payload.SetDeadTimerInSeconds(defaultDeadTimerInSeconds)
+	payload.Areas = []openApiClient.UpdateNetworkSwitchRoutingOspfRequestAreasInner{}
+	payload.SetV3(v3)
+	payload.SetMd5AuthenticationEnabled(false)
+	return payload
+}
+
+func areasPayload(ctx context.Context, list types.List) ([]openApiClient.UpdateNetworkSwitchRoutingOspfRequestAreasInner, diag.Diagnostics) {
+	var areas []areaModel
+	diags := list.ElementsAs(ctx, &areas, false)
+
+	payload := []openApiClient.UpdateNetworkSwitchRoutingOspfRequestAreasInner{}
+	for _, area := range areas {
+		payload = append(payload, *openApiClient.NewUpdateNetworkSwitchRoutingOspfRequestAreasInner(
+			area.AreaId.ValueString(), area.AreaName.ValueString(), area.AreaType.ValueString()))
+	}
+	return payload, diags
+}
+
+// readOspf sets data from the OSPF settings returned by the Dashboard API. The MD5 authentication key and its
+// passphrase are kept from data when the response does not include them, and so is an encrypted passphrase that keys
+// decrypt to the passphrase returned by the Dashboard.
+func readOspf(ctx context.Context, keys utils.EncryptionKeys, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var ospf apiOspf
+	if err := utils.ConvertJSON(response, &ospf); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the OSPF settings: %s", err))
+		return diags
+	}
+
+	data.Id = data.NetworkId
+	data.Enabled = types.BoolPointerValue(ospf.Enabled)
+	data.HelloTimerInSeconds = types.Int64PointerValue(ospf.HelloTimerInSeconds)
+	data.DeadTimerInSeconds = types.Int64PointerValue(ospf.DeadTimerInSeconds)
+	data.Md5AuthenticationEnabled = types.BoolPointerValue(ospf.Md5AuthenticationEnabled)
+
+	var listDiags diag.Diagnostics
+	data.Areas, listDiags = readAreas(ctx, ospf.Areas)
+	diags.Append(listDiags...)
+
+	data.V3 = types.ObjectNull(v3AttrTypes())
+	if ospf.V3 != nil {
+		v3 := v3Model{
+			Enabled:             types.BoolPointerValue(ospf.V3.Enabled),
+			HelloTimerInSeconds: types.Int64PointerValue(ospf.V3.HelloTimerInSeconds),
+			DeadTimerInSeconds:  types.Int64PointerValue(ospf.V3.DeadTimerInSeconds),
+		}
+		v3.Areas, listDiags = readAreas(ctx, ospf.V3.Areas)
+		diags.Append(listDiags...)
+
+		var objectDiags diag.Diagnostics
+		data.V3, objectDiags = types.ObjectValueFrom(ctx, v3AttrTypes(), v3)
+		diags.Append(objectDiags...)
+	}
+
+	// The key is kept from data when the response does not include it
+	if ospf.Md5AuthenticationKey == nil || ospf.Md5AuthenticationKey.Id == nil {
+		if !utils.IsKnown(data.Md5AuthenticationKey) {
+			data.Md5AuthenticationKey = types.ObjectNull(md5AuthenticationKeyAttrTypes())
+		}
+		return diags
+	}
+
+	key := md5AuthenticationKeyModel{
+		Id:         types.Int64PointerValue(ospf.Md5AuthenticationKey.Id),
+		Passphrase: types.StringPointerValue(ospf.Md5AuthenticationKey.Passphrase),
+	}
+	if utils.IsKnown(data.Md5AuthenticationKey) {
+		var prior md5AuthenticationKeyModel
+		diags.Append(data.Md5AuthenticationKey.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+		if key.Passphrase.IsNull() {
+			key.Passphrase = prior.Passphrase
+		} else {
+			key.Passphrase = keys.PreserveString(key.Passphrase, prior.Passphrase)
+		}
+	}
+
+	var objectDiags diag.Diagnostics
+	data.Md5AuthenticationKey, objectDiags = types.ObjectValueFrom(ctx, md5AuthenticationKeyAttrTypes(), key)
+	diags.Append(objectDiags...)
+
+	return diags
+}
+
+// sealPassphrase encrypts the MD5 authentication passphrase in state with the provider's current encryption key.
+// Values already encrypted with the current key are left untouched.
+func sealPassphrase(ctx context.Context, keys utils.EncryptionKeys, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !keys.Enabled() || !utils.IsKnown(data.Md5AuthenticationKey) {
+		return diags
+	}
+
+	var key md5AuthenticationKeyModel
+	diags.Append(data.Md5AuthenticationKey.As(ctx, &key, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var sealDiags diag.Diagnostics
+	key.Passphrase, sealDiags = keys.SealString(passphrasePath, key.Passphrase)
+	diags.Append(sealDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var objectDiags diag.Diagnostics
+	data.Md5AuthenticationKey, objectDiags = types.ObjectValueFrom(ctx, md5AuthenticationKeyAttrTypes(), key)
+	diags.Append(objectDiags...)
+	return diags
+}
+
+// preservePassphrase keeps the encrypted prior state value of the MD5 authentication passphrase in the plan when it
+// decrypts to the configured plaintext, so that encryption alone never produces a diff.
+func preservePassphrase(ctx context.Context, keys utils.EncryptionKeys, plan, state *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(plan.Md5AuthenticationKey) || !utils.IsKnown(state.Md5AuthenticationKey) {
+		return diags
+	}
+
+	var planKey, stateKey md5AuthenticationKeyModel
+	diags.Append(plan.Md5AuthenticationKey.As(ctx, &planKey, basetypes.ObjectAsOptions{})...)
+	diags.Append(state.Md5AuthenticationKey.As(ctx, &stateKey, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	planKey.Passphrase = keys.PreserveString(planKey.Passphrase, stateKey.Passphrase)
+
+	var objectDiags diag.Diagnostics
+	plan.Md5AuthenticationKey, objectDiags = types.ObjectValueFrom(ctx, md5AuthenticationKeyAttrTypes(), planKey)
+	diags.Append(objectDiags...)
+	return diags
+}
+
+func readAreas(ctx context.Context, areas []apiArea) (types.List, diag.Diagnostics) {
+	if len(areas) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: areaAttrTypes()}), nil
+	}
+
+	var models []areaModel
+	for _, area := range areas {
+		models = append(models, areaModel{
+			AreaId:   types.StringValue(area.AreaId),
+			AreaName: types.StringValue(area.AreaName),
+			AreaType: types.StringValue(area.AreaType),
+		})
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: areaAttrTypes()}, models)
+}
+
+// validateOspf checks at plan time that the dead timers are longer than the hello timers, that area IDs are unique
+// 32-bit integers and that MD5 authentication has a key.
+func validateOspf(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validateTimers(path.Root("dead_timer_in_seconds"), data.HelloTimerInSeconds, data.DeadTimerInSeconds)...)
+	diags.Append(validateAreas(ctx, path.Root("areas"), data.Areas)...)
+
+	if utils.IsKnown(data.V3) {
+		var v3 v3Model
+		diags.Append(data.V3.As(ctx, &v3, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		diags.Append(validateTimers(path.Root("v3").AtName("dead_timer_in_seconds"), v3.HelloTimerInSeconds, v3.DeadTimerInSeconds)...)
+		diags.Append(validateAreas(ctx, path.Root("v3").AtName("areas"), v3.Areas)...)
+	}
+
+	if utils.IsKnown(data.Md5AuthenticationEnabled) && data.Md5AuthenticationEnabled.ValueBool() && data.Md5AuthenticationKey.IsNull() {
+		diags.AddAttributeError(path.Root("md5_authentication_key"), "Missing MD5 Authentication Key",
+			"md5_authentication_key is required when md5_authentication_enabled is true")
+	}
+
+	return diags
+}
+
+func validateTimers(deadPath path.Path, hello, dead types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	helloSeconds, deadSeconds := int64(defaultHelloTimerInSeconds), int64(defaultDeadTimerInSeconds)
+	if hello.IsUnknown() || dead.IsUnknown() || (hello.IsNull() && dead.IsNull()) {
+		return diags
+	}
+	if !hello.IsNull() {
+		helloSeconds = hello.ValueInt64()
+	}
+	if !dead.IsNull() {
+		deadSeconds = dead.ValueInt64()
+	}
+
+	if deadSeconds <= helloSeconds {
+		diags.AddAttributeError(deadPath, "Invalid OSPF Dead Timer",
+			fmt.Sprintf("the dead timer of %d seconds must be longer than the hello timer of %d seconds", deadSeconds, helloSeconds))
+	}
+
+	return diags
+}
+
+func validateAreas(ctx context.Context, areasPath path.Path, list types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if list.IsNull() || list.IsUnknown() {
+		return diags
+	}
+
+	var areas []areaModel
+	diags.Append(list.ElementsAs(ctx, &areas, false)...)
+
+	seen := map[uint64]bool{}
+	for i, area := range areas {
+		if !utils.IsKnown(area.AreaId) {
+			continue
+		}
+		id, err := strconv.ParseUint(area.AreaId.ValueString(), 10, 32)
+		if err != nil {
+			diags.AddAttributeError(areasPath.AtListIndex(i).AtName("area_id"), "Invalid OSPF Area ID",
+				fmt.Sprintf("%q is not an integer between 0 and 4294967295", area.AreaId.ValueString()))
+			continue
+		}
+		if seen[id] {
+			diags.AddAttributeError(areasPath.AtListIndex(i).AtName("area_id"), "Duplicate OSPF Area ID",
+				fmt.Sprintf("area %d is configured more than once", id))
+		}
+		seen[id] = true
+	}
+
+	return diags
+}
@@ -0,0 +1,218 @@
+package ospf
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testAreas(ids ...string) types.List {
+	var areas []attr.Value
+	for _, id := range ids {
+		areas = append(areas, types.ObjectValueMust(areaAttrTypes(), map[string]attr.Value{
+			"area_id":   types.StringValue(id),
+			"area_name": types.StringValue("area " + id),
+			"area_type": types.StringValue("normal"),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: areaAttrTypes()}, areas)
+}
+
+func testMd5AuthenticationKey(passphrase string) types.Object {
+	return types.ObjectValueMust(md5AuthenticationKeyAttrTypes(), map[string]attr.Value{
+		"id":         types.Int64Value(1),
+		"passphrase": types.StringValue(passphrase),
+	})
+}
+
+func testResourceModel() resourceModel {
+	return resourceModel{
+		Id:                       types.StringUnknown(),
+		NetworkId:                types.StringValue("N_1"),
+		Enabled:                  types.BoolValue(true),
+		HelloTimerInSeconds:      types.Int64Unknown(),
+		DeadTimerInSeconds:       types.Int64Unknown(),
+		Areas:                    types.ListNull(types.ObjectType{AttrTypes: areaAttrTypes()}),
+		V3:                       types.ObjectUnknown(v3AttrTypes()),
+		Md5AuthenticationEnabled: types.BoolValue(true),
+		Md5AuthenticationKey:     testMd5AuthenticationKey("secret"),
+	}
+}
+
+func TestOspfPayload(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	// Test case: Unknown values are left out and areas that are not configured are removed
+	data := testResourceModel()
+	payload, diags := ospfPayload(ctx, &data, keys)
+	require.False(t, diags.HasError())
+
+	assert.True(t, payload.GetEnabled())
+	assert.False(t, payload.HasHelloTimerInSeconds())
+	assert.False(t, payload.HasV3())
+	assert.Equal(t, "secret", payload.Md5AuthenticationKey.GetPassphrase())
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"areas":[]`)
+
+	// Test case: Configured areas are sent
+	data.Areas = testAreas("0", "10")
+	payload, diags = ospfPayload(ctx, &data, keys)
+	require.False(t, diags.HasError())
+	require.Len(t, payload.Areas, 2)
+	assert.Equal(t, "10", payload.Areas[1].AreaId)
+
+	// Test case: An encrypted passphrase is sent decrypted
+	sealed, err := keys.Encrypt("secret")
+	require.NoError(t, err)
+	data.Md5AuthenticationKey = testMd5AuthenticationKey(sealed)
+	payload, diags = ospfPayload(ctx, &data, keys)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "secret", payload.Md5AuthenticationKey.GetPassphrase())
+
+	// Test case: A passphrase that fails authentication is not sent
+	_, diags = ospfPayload(ctx, &data, utils.EncryptionKeys{Key: "other"})
+	assert.True(t, diags.HasError())
+}
+
+func TestReadOspf(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: The passphrase is kept when the response leaves it out
+	data := testResourceModel()
+	response := map[string]interface{}{
+		"enabled":                  true,
+		"helloTimerInSeconds":      float64(10),
+		"deadTimerInSeconds":       float64(40),
+		"areas":                    []interface{}{map[string]interface{}{"areaId": "0", "areaName": "Backbone", "areaType": "normal"}},
+		"v3":                       map[string]interface{}{"enabled": false, "helloTimerInSeconds": float64(10), "deadTimerInSeconds": float64(40)},
+		"md5AuthenticationEnabled": true,
+		"md5AuthenticationKey":     map[string]interface{}{"id": float64(1)},
+	}
+	require.False(t, readOspf(ctx, utils.EncryptionKeys{}, &data, response).HasError())
+
+	assert.Equal(t, "N_1", data.Id.ValueString())
+	assert.Equal(t, int64(40), data.DeadTimerInSeconds.ValueInt64())
+	assert.Len(t, data.Areas.Elements(), 1)
+
+	var v3 v3Model
+	require.False(t, data.V3.As(ctx, &v3, basetypes.ObjectAsOptions{}).HasError())
+	assert.False(t, v3.Enabled.ValueBool())
+	assert.True(t, v3.Areas.IsNull())
+
+	var key md5AuthenticationKeyModel
+	require.False(t, data.Md5AuthenticationKey.As(ctx, &key, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, int64(1), key.Id.ValueInt64())
+	assert.Equal(t, "secret", key.Passphrase.ValueString())
+}
+
+func TestSealAndPreservePassphrase(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	passphrase := func(data resourceModel) string {
+		var key md5AuthenticationKeyModel
+		require.False(t, data.Md5AuthenticationKey.As(ctx, &key, basetypes.ObjectAsOptions{}).HasError())
+		return key.Passphrase.ValueString()
+	}
+
+	state := testResourceModel()
+	require.False(t, sealPassphrase(ctx, keys, &state).HasError())
+	sealed := passphrase(state)
+	assert.True(t, utils.IsEncrypted(sealed))
+
+	// Test case: A passphrase returned by the Dashboard keeps its encrypted state value
+	require.False(t, readOspf(ctx, keys, &state, map[string]interface{}{
+		"md5AuthenticationKey": map[string]interface{}{"id": float64(1), "passphrase": "secret"},
+	}).HasError())
+	assert.Equal(t, sealed, passphrase(state))
+
+	// Test case: An unchanged passphrase keeps its encrypted state value in the plan
+	plan := testResourceModel()
+	require.False(t, preservePassphrase(ctx, keys, &plan, &state).HasError())
+	assert.Equal(t, sealed, passphrase(plan))
+
+	// Test case: A changed passphrase is planned in plaintext
+	plan.Md5AuthenticationKey = testMd5AuthenticationKey("changed")
+	require.False(t, preservePassphrase(ctx, keys, &plan, &state).HasError())
+	assert.Equal(t, "changed", passphrase(plan))
+}
+
+func TestValidateOspf(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		modify func(data *resourceModel)
+		err    string
+	}{
+		{
+			name:   "dead timer shorter than default hello timer",
+			modify: func(data *resourceModel) { data.DeadTimerInSeconds = types.Int64Value(5) },
+			err:    "Invalid OSPF Dead Timer",
+		},
+		{
+			name: "v3 dead timer equal to hello timer",
+			modify: func(data *resourceModel) {
+				data.V3 = types.ObjectValueMust(v3AttrTypes(), map[string]attr.Value{
+					"enabled":                types.BoolValue(true),
+					"hello_timer_in_seconds": types.Int64Value(30),
+					"dead_timer_in_seconds":  types.Int64Value(30),
+					"areas":                  types.ListNull(types.ObjectType{AttrTypes: areaAttrTypes()}),
+				})
+			},
+			err: "Invalid OSPF Dead Timer",
+		},
+		{
+			name:   "invalid area ID",
+			modify: func(data *resourceModel) { data.Areas = testAreas("backbone") },
+			err:    "Invalid OSPF Area ID",
+		},
+		{
+			name:   "duplicate area ID",
+			modify: func(data *resourceModel) { data.Areas = testAreas("1", "01") },
+			err:    "Duplicate OSPF Area ID",
+		},
+		{
+			name: "MD5 authentication without key",
+			modify: func(data *resourceModel) {
+				data.Md5AuthenticationKey = types.ObjectNull(md5AuthenticationKeyAttrTypes())
+			},
+			err: "Missing MD5 Authentication Key",
+		},
+		{
+			name: "valid",
+			modify: func(data *resourceModel) {
+				data.HelloTimerInSeconds = types.Int64Value(5)
+				data.DeadTimerInSeconds = types.Int64Value(20)
+				data.Areas = testAreas("0", "4294967295")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel()
+			data.HelloTimerInSeconds = types.Int64Null()
+			data.DeadTimerInSeconds = types.Int64Null()
+			data.V3 = types.ObjectNull(v3AttrTypes())
+			tt.modify(&data)
+
+			diags := validateOspf(ctx, &data)
+			if tt.err == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, tt.err, diags.Errors()[0].Summary())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package ospf
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the switch OSPF settings of a network.
+type resourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	NetworkId                types.String `tfsdk:"network_id"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	HelloTimerInSeconds      types.Int64  `tfsdk:"hello_timer_in_seconds"`
+	DeadTimerInSeconds       types.Int64  `tfsdk:"dead_timer_in_seconds"`
+	Areas                    types.List   `tfsdk:"areas"`
+	V3                       types.Object `tfsdk:"v3"`
+	Md5AuthenticationEnabled types.Bool   `tfsdk:"md5_authentication_enabled"`
+	Md5AuthenticationKey     types.Object `tfsdk:"md5_authentication_key"`
+}
+
+type areaModel struct {
+	AreaId   types.String `tfsdk:"area_id"`
+	AreaName types.String `tfsdk:"area_name"`
+	AreaType types.String `tfsdk:"area_type"`
+}
+
+func areaAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"area_id":   types.StringType,
+		"area_name": types.StringType,
+		"area_type": types.StringType,
+	}
+}
+
+type v3Model struct {
+	Enabled             types.Bool  `tfsdk:"enabled"`
+	HelloTimerInSeconds types.Int64 `tfsdk:"hello_timer_in_seconds"`
+	DeadTimerInSeconds  types.Int64 `tfsdk:"dead_timer_in_seconds"`
+	Areas               types.List  `tfsdk:"areas"`
+}
+
+func v3AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":                types.BoolType,
+		"hello_timer_in_seconds": types.Int64Type,
+		"dead_timer_in_seconds":  types.Int64Type,
+		"areas":                  types.ListType{ElemType: types.ObjectType{AttrTypes: areaAttrTypes()}},
+	}
+}
+
+type md5AuthenticationKeyModel struct {
+	Id         types.Int64  `tfsdk:"id"`
+	Passphrase types.String `tfsdk:"passphrase"`
+}
+
+func md5AuthenticationKeyAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.Int64Type,
+		"passphrase": types.StringType,
+	}
+}
+
+// apiOspf is the switch OSPF configuration in the format of the Dashboard API, which the generated client returns as
+// a map.
+type apiOspf struct {
+	Enabled             *bool     `json:"enabled,omitempty"`
+	HelloTimerInSeconds *int64    `json:"helloTimerInSeconds,omitempty"`
+	DeadTimerInSeconds  *int64    `json:"deadTimerInSeconds,omitempty"`
+	Areas               []apiArea `json:"areas,omitempty"`
+	V3                  *struct {
+		Enabled             *bool     `json:"enabled,omitempty"`
+		HelloTimerInSeconds *int64    `json:"helloTimerInSeconds,omitempty"`
+		DeadTimerInSeconds  *int64    `json:"deadTimerInSeconds,omitempty"`
+		Areas               []apiArea `json:"areas,omitempty"`
+	} `json:"v3,omitempty"`
+	Md5AuthenticationEnabled *bool `json:"md5AuthenticationEnabled,omitempty"`
+	Md5AuthenticationKey     *struct {
+		Id         *int64  `json:"id,omitempty"`
+		Passphrase *string `json:"passphrase,omitempty"`
+	} `json:"md5AuthenticationKey,omitempty"`
+}
+
+type apiArea struct {
+	AreaId   string `json:"areaId"`
+	AreaName string `json:"areaName"`
+	AreaType string `json:"areaType"`
+}
@@ -0,0 +1,81 @@
+package ospf_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksSwitchRoutingOspfResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_routing_ospf"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_switch_routing_ospf"),
+			},
+
+			// Create and Read OSPF
+			{
+				Config: NetworksSwitchRoutingOspfResourceConfig(10, 40),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_ospf.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_ospf.test", "hello_timer_in_seconds", "10"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_ospf.test", "areas.#", "1"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_ospf.test", "areas.0.area_type", "stub"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_ospf.test", "md5_authentication_key.id", "1"),
+				),
+			},
+
+			// Update and Read OSPF
+			{
+				Config: NetworksSwitchRoutingOspfResourceConfig(5, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_ospf.test", "hello_timer_in_seconds", "5"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_routing_ospf.test", "dead_timer_in_seconds", "20"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:            "meraki_networks_switch_routing_ospf.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"md5_authentication_key"},
+			},
+		},
+	})
+}
+
+func NetworksSwitchRoutingOspfResourceConfig(helloTimer, deadTimer int) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_switch_routing_ospf" "test" {
+    network_id = resource.meraki_network.test.network_id
+    enabled = true
+    hello_timer_in_seconds = %d
+    dead_timer_in_seconds = %d
+    areas = [{
+        area_id = "1"
+        area_name = "test_acc_area"
+        area_type = "stub"
+    }]
+    md5_authentication_enabled = true
+    md5_authentication_key = {
+        id = 1
+        passphrase = "test_acc_key"
+    }
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_routing_ospf"),
+		helloTimer, deadTimer,
+	)
+}
@@ -0,0 +1,161 @@
+package ospf
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the layer 3 OSPF routing configuration of the switches in a network. Deleting this resource disables OSPF and restores the default timers. " +
+			"The MD5 authentication passphrase is encrypted in state when the provider has an `encryption_key`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Boolean value to enable or disable OSPF routing. OSPF routing is disabled by default.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hello_timer_in_seconds": helloTimerAttribute(),
+			"dead_timer_in_seconds":  deadTimerAttribute(),
+			"areas":                  areasAttribute(),
+			"v3": schema.SingleNestedAttribute{
+				MarkdownDescription: "The OSPFv3 routing settings for IPv6",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Boolean value to enable or disable OSPFv3 routing. OSPFv3 routing is disabled by default.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"hello_timer_in_seconds": helloTimerAttribute(),
+					"dead_timer_in_seconds":  deadTimerAttribute(),
+					"areas":                  areasAttribute(),
+				},
+			},
+			"md5_authentication_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Boolean value to enable or disable MD5 authentication. MD5 authentication is disabled by default.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5_authentication_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "MD5 authentication credentials. Required if `md5_authentication_enabled` is true.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "MD5 authentication key index. Key index must be between 1 to 255.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 255),
+						},
+					},
+					"passphrase": schema.StringAttribute{
+						MarkdownDescription: "MD5 authentication passphrase",
+						Required:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 16),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func helloTimerAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "Time interval in seconds at which hello packet will be sent to OSPF neighbors to maintain connectivity. Value must be between 1 and 255. Default is 10 seconds.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(1, 255),
+		},
+	}
+}
+
+func deadTimerAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "Time interval to determine when the peer will be declared inactive or dead. Value must be between 1 and 65535 and longer than the hello timer. Default is 40 seconds.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(1, 65535),
+		},
+	}
+}
+
+func areasAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "OSPF areas. Areas that are not configured are removed.",
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"area_id": schema.StringAttribute{
+					MarkdownDescription: "OSPF area ID, an integer between 0 and 4294967295",
+					Required:            true,
+				},
+				"area_name": schema.StringAttribute{
+					MarkdownDescription: "Name of the OSPF area",
+					Required:            true,
+				},
+				"area_type": schema.StringAttribute{
+					MarkdownDescription: "Area types in OSPF. Must be one of: 'normal', 'stub' or 'nssa'.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("normal", "stub", "nssa"),
+					},
+				},
+			},
+		},
+	}
+}
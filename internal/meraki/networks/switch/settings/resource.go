@@ -110,18 +110,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -150,12 +150,9 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	} else {
 		data.PowerExceptions = nil
 	}
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkSwitchSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkSwitchSettingsRequest(updateNetworksSwitchSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkSwitchSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkSwitchSettingsRequest(updateNetworksSwitchSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -200,12 +197,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.SettingsApi.GetNetworkSwitchSettings(context.Background(), data.NetworkId.ValueString()).Execute()
+	_, httpResp, err := r.client.SettingsApi.GetNetworkSwitchSettings(ctx, data.NetworkId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 	}
 
 	// Check for API success response code
@@ -268,12 +262,9 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		data.PowerExceptions = nil
 	}
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkSwitchSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkSwitchSettingsRequest(updateNetworksSwitchSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkSwitchSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkSwitchSettingsRequest(updateNetworksSwitchSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -335,12 +326,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		data.PowerExceptions = nil
 	}
 
-	_, httpResp, err := r.client.SettingsApi.UpdateNetworkSwitchSettings(context.Background(), data.NetworkId.ValueString()).UpdateNetworkSwitchSettingsRequest(updateNetworksSwitchSettings).Execute()
+	_, httpResp, err := r.client.SettingsApi.UpdateNetworkSwitchSettings(ctx, data.NetworkId.ValueString()).UpdateNetworkSwitchSettingsRequest(updateNetworksSwitchSettings).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -0,0 +1,127 @@
+package stacks
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource defines the data source implementation.
+type DataSource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_switch_stacks"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the switch stacks in a network",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Switch stacks data source Id",
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network Id",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+			},
+			"list": schema.ListNestedAttribute{
+				MarkdownDescription: "The switch stacks in the network",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"switch_stack_id": schema.StringAttribute{
+							MarkdownDescription: "Switch stack ID",
+							Computed:            true,
+							CustomType:          jsontypes.StringType,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the stack",
+							Computed:            true,
+							CustomType:          jsontypes.StringType,
+						},
+						"serials": schema.SetAttribute{
+							MarkdownDescription: "The serials of the switches in the stack",
+							ElementType:         jsontypes.StringType,
+							CustomType:          jsontypes.SetType[jsontypes.String](),
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+	d.retry = providerData.Retry
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *dataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stacks, httpResp, err := utils.CustomHttpRequestRetry(ctx, d.retry, func() ([]openApiClient.GetNetworkSwitchStacks200ResponseInner, *http.Response, error) {
+		return d.client.SwitchApi.GetNetworkSwitchStacks(ctx, data.NetworkId.ValueString()).Execute()
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to read switch stacks", httpResp, err))
+		return
+	}
+
+	data.List = []dataSourceModelStacks{}
+	for _, stack := range stacks {
+		data.List = append(data.List, dataSourceModelStacks{
+			SwitchStackId: jsontypes.StringValue(stack.GetId()),
+			Name:          jsontypes.StringValue(stack.GetName()),
+			Serials:       serialsFromStack(&stack, nil),
+		})
+	}
+
+	data.Id = data.NetworkId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read data source")
+}
@@ -0,0 +1,66 @@
+package stacks
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"strings"
+)
+
+// serialValues returns the serials as upper case strings, which is how the Dashboard API reports them.
+func serialValues(serials []jsontypes.String) []string {
+	values := make([]string, 0, len(serials))
+	for _, serial := range serials {
+		values = append(values, strings.ToUpper(serial.ValueString()))
+	}
+	return values
+}
+
+// memberChanges returns the serials that have to be added to and removed from a stack with the current members to
+// end up with the planned members.
+func memberChanges(current, planned []string) (add, remove []string) {
+	for _, serial := range planned {
+		if !containsSerial(current, serial) {
+			add = append(add, serial)
+		}
+	}
+	for _, serial := range current {
+		if !containsSerial(planned, serial) {
+			remove = append(remove, serial)
+		}
+	}
+	return add, remove
+}
+
+func containsSerial(serials []string, serial string) bool {
+	for _, s := range serials {
+		if strings.EqualFold(s, serial) {
+			return true
+		}
+	}
+	return false
+}
+
+// serialsFromStack returns the members of a stack. Members that were configured in a different case keep the
+// configured value, so that they do not show up as changes.
+func serialsFromStack(stack *openApiClient.GetNetworkSwitchStacks200ResponseInner, configured []jsontypes.String) []jsontypes.String {
+	serials := []jsontypes.String{}
+	for _, serial := range stack.GetSerials() {
+		value := jsontypes.StringValue(serial)
+		for _, c := range configured {
+			if strings.EqualFold(c.ValueString(), serial) {
+				value = c
+				break
+			}
+		}
+		serials = append(serials, value)
+	}
+	return serials
+}
+
+// updateResourceModel sets the attributes of the resource from the stack returned by the Dashboard API.
+func updateResourceModel(data *resourceModel, stack *openApiClient.GetNetworkSwitchStacks200ResponseInner) {
+	data.SwitchStackId = jsontypes.StringValue(stack.GetId())
+	data.Name = jsontypes.StringValue(stack.GetName())
+	data.Serials = serialsFromStack(stack, data.Serials)
+	data.Id = jsontypes.StringValue(data.NetworkId.ValueString() + "," + stack.GetId())
+}
@@ -0,0 +1,42 @@
+package stacks
+
+import (
+	"testing"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberChanges(t *testing.T) {
+	// Test case: New members are added and missing members removed, ignoring the case of serials
+	add, remove := memberChanges(
+		[]string{"Q2AA-AAAA-AAAA", "Q2BB-BBBB-BBBB", "Q2CC-CCCC-CCCC"},
+		[]string{"q2aa-aaaa-aaaa", "Q2CC-CCCC-CCCC", "Q2DD-DDDD-DDDD"},
+	)
+	assert.Equal(t, []string{"Q2DD-DDDD-DDDD"}, add)
+	assert.Equal(t, []string{"Q2BB-BBBB-BBBB"}, remove)
+
+	// Test case: Unchanged members need no calls
+	add, remove = memberChanges([]string{"Q2AA-AAAA-AAAA"}, []string{"Q2AA-AAAA-AAAA"})
+	assert.Empty(t, add)
+	assert.Empty(t, remove)
+}
+
+func TestUpdateResourceModel(t *testing.T) {
+	stack := openApiClient.GetNetworkSwitchStacks200ResponseInner{}
+	stack.SetId("8473")
+	stack.SetName("core")
+	stack.SetSerials([]string{"Q2AA-AAAA-AAAA", "Q2BB-BBBB-BBBB"})
+
+	data := &resourceModel{
+		NetworkId: jsontypes.StringValue("N_1"),
+		Serials:   []jsontypes.String{jsontypes.StringValue("q2aa-aaaa-aaaa")},
+	}
+	updateResourceModel(data, &stack)
+
+	assert.Equal(t, "N_1,8473", data.Id.ValueString())
+	assert.Equal(t, "8473", data.SwitchStackId.ValueString())
+	assert.Equal(t, "core", data.Name.ValueString())
+	assert.Equal(t, []jsontypes.String{jsontypes.StringValue("q2aa-aaaa-aaaa"), jsontypes.StringValue("Q2BB-BBBB-BBBB")}, data.Serials)
+}
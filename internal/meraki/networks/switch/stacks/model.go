@@ -0,0 +1,26 @@
+package stacks
+
+import "github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+
+// resourceModel describes the resource data model.
+type resourceModel struct {
+	Id            jsontypes.String   `tfsdk:"id"`
+	NetworkId     jsontypes.String   `tfsdk:"network_id"`
+	SwitchStackId jsontypes.String   `tfsdk:"switch_stack_id"`
+	Name          jsontypes.String   `tfsdk:"name"`
+	Serials       []jsontypes.String `tfsdk:"serials"`
+}
+
+// dataSourceModel describes the data source data model.
+type dataSourceModel struct {
+	Id        jsontypes.String        `tfsdk:"id"`
+	NetworkId jsontypes.String        `tfsdk:"network_id"`
+	List      []dataSourceModelStacks `tfsdk:"list"`
+}
+
+// dataSourceModelStacks describes a switch stack in the data source data model.
+type dataSourceModelStacks struct {
+	SwitchStackId jsontypes.String   `tfsdk:"switch_stack_id"`
+	Name          jsontypes.String   `tfsdk:"name"`
+	Serials       []jsontypes.String `tfsdk:"serials"`
+}
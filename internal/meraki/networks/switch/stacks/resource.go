@@ -0,0 +1,291 @@
+package stacks
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                = &Resource{}
+	_ resource.ResourceWithConfigure   = &Resource{}
+	_ resource.ResourceWithImportState = &Resource{}
+)
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Resource defines the resource implementation.
+type Resource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_switch_stack"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a switch stack. Switches added to `serials` join the stack and switches removed from it leave the stack.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The network ID and switch stack ID, separated by a comma",
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Network ID",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"switch_stack_id": schema.StringAttribute{
+				MarkdownDescription: "Switch stack ID",
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the stack. The Dashboard API cannot rename a stack, so changing it replaces the stack.",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serials": schema.SetAttribute{
+				MarkdownDescription: "The serials of the switches in the stack",
+				ElementType:         jsontypes.StringType,
+				CustomType:          jsontypes.SetType[jsontypes.String](),
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(2),
+					setvalidator.ValueStringsAre(utils.SerialValidator()),
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *resourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkId := data.NetworkId.ValueString()
+	payload := *openApiClient.NewCreateNetworkSwitchStackRequest(data.Name.ValueString(), serialValues(data.Serials))
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (map[string]interface{}, *http.Response, error) {
+		return r.client.SwitchApi.CreateNetworkSwitchStack(ctx, networkId).CreateNetworkSwitchStackRequest(payload).Execute()
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to create switch stack", httpResp, err))
+		return
+	}
+
+	switchStackId, _ := inlineResp["id"].(string)
+	if switchStackId == "" {
+		resp.Diagnostics.AddError(
+			"Missing Switch Stack Id",
+			fmt.Sprintf("The Dashboard API did not return the id of switch stack %q", data.Name.ValueString()),
+		)
+		return
+	}
+
+	// Read the stack back to record its members as reported by the Dashboard API
+	stack, diags := r.getStack(ctx, networkId, switchStackId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResourceModel(data, stack)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "create resource")
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *resourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stack, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetNetworkSwitchStacks200ResponseInner, *http.Response, error) {
+		return r.client.SwitchApi.GetNetworkSwitchStack(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString()).Execute()
+	})
+
+	// The stack was deleted outside of Terraform
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to read switch stack", httpResp, err))
+		return
+	}
+
+	updateResourceModel(data, stack)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read resource")
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkId := state.NetworkId.ValueString()
+	switchStackId := state.SwitchStackId.ValueString()
+
+	// Members are added before others are removed, so that the stack never drops below two switches
+	add, remove := memberChanges(serialValues(state.Serials), serialValues(plan.Serials))
+
+	for _, serial := range add {
+		payload := *openApiClient.NewAddNetworkSwitchStackRequest(serial)
+		_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetNetworkSwitchStacks200ResponseInner, *http.Response, error) {
+			return r.client.SwitchApi.AddNetworkSwitchStack(ctx, networkId, switchStackId).AddNetworkSwitchStackRequest(payload).Execute()
+		})
+		if err != nil {
+			resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic(fmt.Sprintf("Failed to add switch %s to stack", serial), httpResp, err))
+			return
+		}
+	}
+
+	for _, serial := range remove {
+		payload := *openApiClient.NewRemoveNetworkSwitchStackRequest(serial)
+		_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (map[string]interface{}, *http.Response, error) {
+			return r.client.SwitchApi.RemoveNetworkSwitchStack(ctx, networkId, switchStackId).RemoveNetworkSwitchStackRequest(payload).Execute()
+		})
+		if err != nil {
+			resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic(fmt.Sprintf("Failed to remove switch %s from stack", serial), httpResp, err))
+			return
+		}
+	}
+
+	stack, diags := r.getStack(ctx, networkId, switchStackId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResourceModel(plan, stack)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "updated resource")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (map[string]interface{}, *http.Response, error) {
+		httpResp, err := r.client.SwitchApi.DeleteNetworkSwitchStack(ctx, data.NetworkId.ValueString(), data.SwitchStackId.ValueString()).Execute()
+		return nil, httpResp, err
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to delete switch stack", httpResp, err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "removed resource")
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: network_id, switch_stack_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("switch_stack_id"), idParts[1])...)
+}
+
+// getStack reads a switch stack.
+func (r *Resource) getStack(ctx context.Context, networkId, switchStackId string) (*openApiClient.GetNetworkSwitchStacks200ResponseInner, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stack, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, func() (*openApiClient.GetNetworkSwitchStacks200ResponseInner, *http.Response, error) {
+		return r.client.SwitchApi.GetNetworkSwitchStack(ctx, networkId, switchStackId).Execute()
+	})
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic("Failed to read switch stack", httpResp, err))
+	}
+
+	return stack, diags
+}
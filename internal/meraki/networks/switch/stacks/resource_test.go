@@ -0,0 +1,77 @@
+package stacks_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccNetworksSwitchStackResource(t *testing.T) {
+	serial := os.Getenv("TF_ACC_MERAKI_MS_SERIAL")
+	stackSerial := os.Getenv("TF_ACC_MERAKI_MS_STACK_SERIAL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+			if serial == "" || stackSerial == "" {
+				t.Skip("TF_ACC_MERAKI_MS_SERIAL and TF_ACC_MERAKI_MS_STACK_SERIAL must both be set to test switch stacks")
+			}
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_stack"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_networks_switch_stack"),
+			},
+
+			// Create and Read Switch Stack
+			{
+				Config: NetworksSwitchStackResourceConfig(serial, stackSerial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meraki_networks_switch_stack.test", "name", "test_acc_stack"),
+					resource.TestCheckResourceAttr("meraki_networks_switch_stack.test", "serials.#", "2"),
+					resource.TestCheckResourceAttrSet("meraki_networks_switch_stack.test", "switch_stack_id"),
+					resource.TestCheckResourceAttr("data.meraki_networks_switch_stacks.test", "list.#", "1"),
+				),
+			},
+
+			// Import testing
+			{
+				ResourceName:      "meraki_networks_switch_stack.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func NetworksSwitchStackResourceConfig(serial, stackSerial string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_networks_devices_claim" "test" {
+    network_id = resource.meraki_network.test.network_id
+    serials = ["%s", "%s"]
+}
+
+resource "meraki_networks_switch_stack" "test" {
+    depends_on = [resource.meraki_networks_devices_claim.test]
+    network_id = resource.meraki_network.test.network_id
+    name = "test_acc_stack"
+    serials = ["%s", "%s"]
+}
+
+data "meraki_networks_switch_stacks" "test" {
+    depends_on = [resource.meraki_networks_switch_stack.test]
+    network_id = resource.meraki_network.test.network_id
+}
+`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_networks_switch_stack"),
+		serial, stackSerial,
+		serial, stackSerial,
+	)
+}
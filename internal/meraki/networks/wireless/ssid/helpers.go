@@ -6,6 +6,8 @@ import (
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -414,8 +416,15 @@ func updateNetworksWirelessSsidsResourceState(ctx context.Context, plan *resourc
 		}
 	}
 
-	// Ensure the PSK value from the state is preserved if the API does not return it
-	if state.PSK.IsNull() || state.PSK.IsUnknown() {
+	// The write-only PSK is never stored, only the version that was last sent
+	state.PSKWriteOnly = types.StringNull()
+	state.PSKWriteOnlyVersion = plan.PSKWriteOnlyVersion
+
+	// Keep the PSK returned by the API out of state when it is managed through psk_wo
+	if !plan.PSKWriteOnlyVersion.IsNull() {
+		state.PSK = types.StringNull()
+	} else if state.PSK.IsNull() || state.PSK.IsUnknown() {
+		// Ensure the PSK value from the state is preserved if the API does not return it
 		state.PSK, diags = utils.ExtractStringAttr(rawResp, "psk")
 		if diags.HasError() {
 			diags.AddError("PSK Attribute", "Error extracting PSK attribute")
@@ -721,7 +730,7 @@ func updateNetworksWirelessSsidsResourceState(ctx context.Context, plan *resourc
 	return diags
 }
 
-func updateNetworksWirelessSsidsResourcePayload(ctx context.Context, plan *resourceModel) (openApiClient.UpdateNetworkWirelessSsidRequest, diag.Diagnostics) {
+func updateNetworksWirelessSsidsResourcePayload(ctx context.Context, plan *resourceModel, keys utils.EncryptionKeys) (openApiClient.UpdateNetworkWirelessSsidRequest, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var payload openApiClient.UpdateNetworkWirelessSsidRequest
 
@@ -742,8 +751,15 @@ func updateNetworksWirelessSsidsResourcePayload(ctx context.Context, plan *resou
 		payload.SetEncryptionMode(plan.EncryptionMode.ValueString())
 	}
 
-	if !plan.PSK.IsNull() && !plan.PSK.IsUnknown() {
-		payload.SetPsk(plan.PSK.ValueString())
+	if !plan.PSKWriteOnly.IsNull() && !plan.PSKWriteOnly.IsUnknown() {
+		payload.SetPsk(plan.PSKWriteOnly.ValueString())
+	} else if !plan.PSK.IsNull() && !plan.PSK.IsUnknown() {
+		psk, _, err := keys.Decrypt(plan.PSK.ValueString())
+		if err != nil {
+			diags.Append(utils.NewEncryptionDiagnostic(path.Root("psk"), err))
+		} else {
+			payload.SetPsk(psk)
+		}
 	}
 
 	if !plan.WPAEncryptionMode.IsNull() && !plan.WPAEncryptionMode.IsUnknown() {
@@ -1002,13 +1018,13 @@ func updateNetworksWirelessSsidsResourcePayload(ctx context.Context, plan *resou
 	}
 	payload.ActiveDirectory = activeDirectory
 
-	radiusServers, err := RadiusServersPayload(ctx, plan.RadiusServers)
+	radiusServers, err := RadiusServersPayload(ctx, plan.RadiusServers, keys)
 	if err.HasError() {
 		diags.Append(err...)
 	}
 	payload.RadiusServers = radiusServers
 
-	radiusAccountingServers, err := RadiusAccountingServersPayload(ctx, plan.RadiusAccountingServers)
+	radiusAccountingServers, err := RadiusAccountingServersPayload(ctx, plan.RadiusAccountingServers, keys)
 	if err.HasError() {
 		diags.Append(err...)
 	}
@@ -1225,7 +1241,7 @@ func ActiveDirectoryPayload(input types.Object) (*openApiClient.UpdateNetworkWir
 	}, diags
 }
 
-func RadiusServersPayload(ctx context.Context, input types.List) ([]openApiClient.UpdateNetworkWirelessSsidRequestRadiusServersInner, diag.Diagnostics) {
+func RadiusServersPayload(ctx context.Context, input types.List, keys utils.EncryptionKeys) ([]openApiClient.UpdateNetworkWirelessSsidRequestRadiusServersInner, diag.Diagnostics) {
 	if input.IsNull() || input.IsUnknown() {
 		return nil, nil
 	}
@@ -1240,14 +1256,7 @@ func RadiusServersPayload(ctx context.Context, input types.List) ([]openApiClien
 		return nil, diags
 	}
 
-	// Retrieve the encryption key from the context
-	encryptionKey, ok := ctx.Value("encryption_key").(string)
-	if !ok {
-		// If encryption key is not available, log a warning and proceed without decryption
-		tflog.Warn(ctx, "The encryption key is not available in the context, proceeding without decryption")
-	}
-
-	for _, server := range radiusServers {
+	for i, server := range radiusServers {
 
 		var serverPayload openApiClient.UpdateNetworkWirelessSsidRequestRadiusServersInner
 
@@ -1262,15 +1271,12 @@ func RadiusServersPayload(ctx context.Context, input types.List) ([]openApiClien
 		serverPayload.SetPort(*port)
 
 		// Secret
-		if encryptionKey != "" {
-			decryptedSecret, err := utils.Decrypt(encryptionKey, server.Secret.ValueString())
-			if err != nil {
-				diags = append(diags, diag.NewErrorDiagnostic("Error Decrypting Secret", err.Error()))
-			} else {
-				serverPayload.SetSecret(decryptedSecret)
-			}
+		if !server.SecretWriteOnly.IsNull() && !server.SecretWriteOnly.IsUnknown() {
+			serverPayload.SetSecret(server.SecretWriteOnly.ValueString())
+		} else if decryptedSecret, _, decryptErr := keys.Decrypt(server.Secret.ValueString()); decryptErr != nil {
+			diags = append(diags, utils.NewEncryptionDiagnostic(path.Root("radius_servers").AtListIndex(i).AtName("secret"), decryptErr))
 		} else {
-			serverPayload.SetSecret(server.Secret.ValueString())
+			serverPayload.SetSecret(decryptedSecret)
 		}
 
 		// RadSecEnabled
@@ -1299,7 +1305,7 @@ func RadiusServersPayload(ctx context.Context, input types.List) ([]openApiClien
 	return servers, diags
 }
 
-func RadiusAccountingServersPayload(ctx context.Context, input types.List) ([]openApiClient.UpdateNetworkWirelessSsidRequestRadiusAccountingServersInner, diag.Diagnostics) {
+func RadiusAccountingServersPayload(ctx context.Context, input types.List, keys utils.EncryptionKeys) ([]openApiClient.UpdateNetworkWirelessSsidRequestRadiusAccountingServersInner, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var servers []openApiClient.UpdateNetworkWirelessSsidRequestRadiusAccountingServersInner
 	var radiusServers []RadiusServer
@@ -1310,14 +1316,7 @@ func RadiusAccountingServersPayload(ctx context.Context, input types.List) ([]op
 		return nil, diags
 	}
 
-	// Retrieve the encryption key from the context
-	encryptionKey, ok := ctx.Value("encryption_key").(string)
-	if !ok {
-		// If encryption key is not available, log a warning and proceed without decryption
-		tflog.Warn(ctx, "The encryption key is not available in the context, proceeding without decryption")
-	}
-
-	for _, server := range radiusServers {
+	for i, server := range radiusServers {
 
 		var serverPayload openApiClient.UpdateNetworkWirelessSsidRequestRadiusAccountingServersInner
 
@@ -1332,15 +1331,12 @@ func RadiusAccountingServersPayload(ctx context.Context, input types.List) ([]op
 		serverPayload.SetPort(*port)
 
 		// Secret
-		if encryptionKey != "" {
-			decryptedSecret, err := utils.Decrypt(encryptionKey, server.Secret.ValueString())
-			if err != nil {
-				diags = append(diags, diag.NewErrorDiagnostic("Error Decrypting Secret", err.Error()))
-			} else {
-				serverPayload.SetSecret(decryptedSecret)
-			}
+		if !server.SecretWriteOnly.IsNull() && !server.SecretWriteOnly.IsUnknown() {
+			serverPayload.SetSecret(server.SecretWriteOnly.ValueString())
+		} else if decryptedSecret, _, decryptErr := keys.Decrypt(server.Secret.ValueString()); decryptErr != nil {
+			diags = append(diags, utils.NewEncryptionDiagnostic(path.Root("radius_accounting_servers").AtListIndex(i).AtName("secret"), decryptErr))
 		} else {
-			serverPayload.SetSecret(server.Secret.ValueString())
+			serverPayload.SetSecret(decryptedSecret)
 		}
 
 		// RadSecEnabled
@@ -1648,18 +1644,13 @@ func RadiusServersState(ctx context.Context, plan resourceModel, httpResp map[st
 		// "server_id":                   types.StringType,  // not in api spec and changes all the time
 		"port":                        types.Int64Type,
 		"secret":                      types.StringType,
+		"secret_wo":                   types.StringType,
+		"secret_wo_version":           types.Int64Type,
 		"rad_sec_enabled":             types.BoolType,
 		"open_roaming_certificate_id": types.Int64Type,
 		"ca_certificate":              types.StringType,
 	}
 
-	// Retrieve the encryption key from the context
-	encryptionKey, ok := ctx.Value("encryption_key").(string)
-	if !ok {
-		// If encryption key is not available, log a warning and proceed without encryption
-		tflog.Warn(ctx, "The encryption key is not available in the context, proceeding without encryption")
-	}
-
 	// Process the response from the API
 	if radiusServersResp, ok := httpResp["radiusServers"].([]interface{}); ok {
 		for _, rsr := range radiusServersResp {
@@ -1698,7 +1689,7 @@ func RadiusServersState(ctx context.Context, plan resourceModel, httpResp map[st
 		diags.Append(err...)
 	}
 
-	// Process the plan to extract secret then handle encryption
+	// Process the plan to extract the secret, which is encrypted on refresh by sealSensitiveState
 	for i, radiusServerPlan := range radiusServersPlan {
 
 		if i < len(radiusServers) {
@@ -1710,25 +1701,9 @@ func RadiusServersState(ctx context.Context, plan resourceModel, httpResp map[st
 				radiusServers[i].Secret = types.StringNull()
 			}
 
-			// Encrypt the secret if the encryption key is available
-			if encryptionKey != "" {
-				encryptedSecret, err := utils.Encrypt(encryptionKey, radiusServerPlan.Secret.ValueString())
-				if err != nil {
-					diags.Append(diag.NewErrorDiagnostic("Error Encrypting Secret", err.Error()))
-				} else {
-					radiusServers[i].Secret = types.StringValue(encryptedSecret)
-				}
-			}
-
-			// Encrypt the ca_certificate if the encryption key is available
-			if encryptionKey != "" {
-				encryptedCaCertificate, err := utils.Encrypt(encryptionKey, radiusServers[i].CaCertificate.ValueString())
-				if err != nil {
-					diags.Append(diag.NewErrorDiagnostic("Error Encrypting CA Certificate", err.Error()))
-				} else {
-					radiusServers[i].CaCertificate = types.StringValue(encryptedCaCertificate)
-				}
-			}
+			// The write-only secret is never stored, only the version that was last sent
+			radiusServers[i].SecretWriteOnly = types.StringNull()
+			radiusServers[i].SecretWriteOnlyVersion = radiusServerPlan.SecretWriteOnlyVersion
 
 			// Convert the RadiusServer object to a types.ObjectValue
 			radiusServerObject, radiusServerObjectErr := types.ObjectValueFrom(ctx, radiusServerAttr, radiusServers[i])
@@ -1767,18 +1742,13 @@ func RadiusAccountingServersState(ctx context.Context, plan resourceModel, httpR
 		// "server_id":                   types.StringType,  // not in api spec and changes all the time
 		"port":                        types.Int64Type,
 		"secret":                      types.StringType,
+		"secret_wo":                   types.StringType,
+		"secret_wo_version":           types.Int64Type,
 		"rad_sec_enabled":             types.BoolType,
 		"open_roaming_certificate_id": types.Int64Type,
 		"ca_certificate":              types.StringType,
 	}
 
-	// Retrieve the encryption key from the context
-	encryptionKey, ok := ctx.Value("encryption_key").(string)
-	if !ok {
-		// If encryption key is not available, log a warning and proceed without encryption
-		tflog.Warn(ctx, "The encryption key is not available in the context, proceeding without encryption")
-	}
-
 	// Process the response from the API
 	if radiusServersResp, ok := httpResp["radiusAccountingServers"].([]interface{}); ok {
 		for _, rsr := range radiusServersResp {
@@ -1817,7 +1787,7 @@ func RadiusAccountingServersState(ctx context.Context, plan resourceModel, httpR
 		diags.Append(err...)
 	}
 
-	// Process the plan to extract secret then handle encryption
+	// Process the plan to extract the secret, which is encrypted on refresh by sealSensitiveState
 	for i, radiusServerPlan := range radiusServersPlan {
 
 		if i < len(radiusServers) {
@@ -1829,25 +1799,9 @@ func RadiusAccountingServersState(ctx context.Context, plan resourceModel, httpR
 				radiusServers[i].Secret = types.StringNull()
 			}
 
-			// Encrypt the secret if the encryption key is available
-			if encryptionKey != "" {
-				encryptedSecret, err := utils.Encrypt(encryptionKey, radiusServerPlan.Secret.ValueString())
-				if err != nil {
-					diags.Append(diag.NewErrorDiagnostic("Error Encrypting Secret", err.Error()))
-				} else {
-					radiusServers[i].Secret = types.StringValue(encryptedSecret)
-				}
-			}
-
-			// Encrypt the ca_certificate if the encryption key is available
-			if encryptionKey != "" {
-				encryptedCaCertificate, err := utils.Encrypt(encryptionKey, radiusServers[i].CaCertificate.ValueString())
-				if err != nil {
-					diags.Append(diag.NewErrorDiagnostic("Error Encrypting CA Certificate", err.Error()))
-				} else {
-					radiusServers[i].CaCertificate = types.StringValue(encryptedCaCertificate)
-				}
-			}
+			// The write-only secret is never stored, only the version that was last sent
+			radiusServers[i].SecretWriteOnly = types.StringNull()
+			radiusServers[i].SecretWriteOnlyVersion = radiusServerPlan.SecretWriteOnlyVersion
 
 			// Convert the RadiusServer object to a types.ObjectValue
 			radiusServerObject, radiusServerObjectErr := types.ObjectValueFrom(ctx, radiusServerAttr, radiusServers[i])
@@ -2750,3 +2704,139 @@ func NamedVlansState(httpResp map[string]interface{}) (types.Object, diag.Diagno
 	return namedVlansObj, diags
 
 }
+
+// radiusSecrets describes the secrets of the RADIUS servers in the list attribute of the given name.
+func radiusSecrets(attribute string) utils.SensitiveList[RadiusServer] {
+	return utils.SensitiveList[RadiusServer]{
+		Path:       path.Root(attribute),
+		SecretPath: []string{"secret"},
+		Secret:     func(server *RadiusServer) *types.String { return &server.Secret },
+	}
+}
+
+// sealSensitiveState encrypts the PSK and RADIUS secrets in state with the provider's current encryption key.
+// Values already encrypted with the current key are left untouched, while plaintext values, legacy AES-CFB values
+// and values encrypted with one of the previous_encryption_keys are re-encrypted.
+func sealSensitiveState(ctx context.Context, keys utils.EncryptionKeys, state *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var d diag.Diagnostics
+	state.PSK, d = keys.SealString(path.Root("psk"), state.PSK)
+	diags.Append(d...)
+
+	state.RadiusServers, d = radiusSecrets("radius_servers").Seal(ctx, keys, state.RadiusServers)
+	diags.Append(d...)
+
+	state.RadiusAccountingServers, d = radiusSecrets("radius_accounting_servers").Seal(ctx, keys, state.RadiusAccountingServers)
+	diags.Append(d...)
+
+	return diags
+}
+
+// preserveSensitivePlan keeps the encrypted prior state value of the PSK and RADIUS secrets in the plan when it
+// decrypts to the configured plaintext, so that encryption alone never produces a diff.
+func preserveSensitivePlan(ctx context.Context, keys utils.EncryptionKeys, plan, state *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plan.PSK = keys.PreserveString(plan.PSK, state.PSK)
+
+	var d diag.Diagnostics
+	plan.RadiusServers, d = radiusSecrets("radius_servers").Preserve(ctx, keys, plan.RadiusServers, state.RadiusServers)
+	diags.Append(d...)
+
+	plan.RadiusAccountingServers, d = radiusSecrets("radius_accounting_servers").Preserve(ctx, keys, plan.RadiusAccountingServers, state.RadiusAccountingServers)
+	diags.Append(d...)
+
+	return diags
+}
+
+// planWriteOnlySecrets plans a null PSK and RADIUS secret wherever the value is managed through a write-only
+// argument, since the prior state value would otherwise be carried into the plan and never match the result.
+func planWriteOnlySecrets(ctx context.Context, plan *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.PSKWriteOnlyVersion.IsNull() {
+		plan.PSK = types.StringNull()
+	}
+
+	clearSecret := func(i int, server *RadiusServer) diag.Diagnostics {
+		if !server.SecretWriteOnlyVersion.IsNull() {
+			server.Secret = types.StringNull()
+		}
+		return nil
+	}
+
+	var d diag.Diagnostics
+	plan.RadiusServers, d = mapRadiusServers(ctx, plan.RadiusServers, clearSecret)
+	diags.Append(d...)
+
+	plan.RadiusAccountingServers, d = mapRadiusServers(ctx, plan.RadiusAccountingServers, clearSecret)
+	diags.Append(d...)
+
+	return diags
+}
+
+// readWriteOnlyConfig copies psk_wo and the secret_wo of each RADIUS server from the configuration into the plan.
+// Terraform always plans write-only arguments as null, so the configuration is the only place to read them from.
+func readWriteOnlyConfig(ctx context.Context, config tfsdk.Config, plan *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(config.GetAttribute(ctx, path.Root("psk_wo"), &plan.PSKWriteOnly)...)
+
+	readSecrets := func(attribute string, planServers types.List) types.List {
+		var configured types.List
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &configured)...)
+		if diags.HasError() || configured.IsNull() || configured.IsUnknown() {
+			return planServers
+		}
+
+		var configServers []RadiusServer
+		diags.Append(configured.ElementsAs(ctx, &configServers, true)...)
+		if diags.HasError() {
+			return planServers
+		}
+
+		servers, d := mapRadiusServers(ctx, planServers, func(i int, server *RadiusServer) diag.Diagnostics {
+			if i < len(configServers) {
+				server.SecretWriteOnly = configServers[i].SecretWriteOnly
+			}
+			return nil
+		})
+		diags.Append(d...)
+		return servers
+	}
+
+	plan.RadiusServers = readSecrets("radius_servers", plan.RadiusServers)
+	plan.RadiusAccountingServers = readSecrets("radius_accounting_servers", plan.RadiusAccountingServers)
+
+	return diags
+}
+
+// mapRadiusServers applies fn to every server in a radius_servers or radius_accounting_servers list.
+func mapRadiusServers(ctx context.Context, input types.List, fn func(i int, server *RadiusServer) diag.Diagnostics) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if input.IsNull() || input.IsUnknown() {
+		return input, diags
+	}
+
+	var servers []RadiusServer
+	diags.Append(input.ElementsAs(ctx, &servers, true)...)
+	if diags.HasError() {
+		return input, diags
+	}
+
+	for i := range servers {
+		diags.Append(fn(i, &servers[i])...)
+	}
+	if diags.HasError() {
+		return input, diags
+	}
+
+	output, d := types.ListValueFrom(ctx, input.ElementType(ctx), servers)
+	diags.Append(d...)
+	if diags.HasError() {
+		return input, diags
+	}
+	return output, diags
+}
@@ -0,0 +1,97 @@
+package ssid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRadiusServerList builds a radius_servers list value from the given servers.
+func newRadiusServerList(t *testing.T, servers ...RadiusServer) types.List {
+	t.Helper()
+
+	attrTypes := map[string]attr.Type{
+		"host":                        types.StringType,
+		"port":                        types.Int64Type,
+		"secret":                      types.StringType,
+		"secret_wo":                   types.StringType,
+		"secret_wo_version":           types.Int64Type,
+		"rad_sec_enabled":             types.BoolType,
+		"open_roaming_certificate_id": types.Int64Type,
+		"ca_certificate":              types.StringType,
+	}
+
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: attrTypes}, servers)
+	require.False(t, diags.HasError(), diags)
+	return list
+}
+
+func newRadiusServer(secret, secretWriteOnly types.String, version types.Int64) RadiusServer {
+	return RadiusServer{
+		Host:                     types.StringValue("radius.example.com"),
+		Port:                     types.Int64Value(1812),
+		Secret:                   secret,
+		SecretWriteOnly:          secretWriteOnly,
+		SecretWriteOnlyVersion:   version,
+		RadSecEnabled:            types.BoolValue(false),
+		OpenRoamingCertificateID: types.Int64Null(),
+		CaCertificate:            types.StringNull(),
+	}
+}
+
+func TestRadiusServersPayloadWriteOnly(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{}
+
+	servers := newRadiusServerList(t,
+		newRadiusServer(types.StringNull(), types.StringValue("write-only-secret"), types.Int64Value(1)),
+		newRadiusServer(types.StringValue("stored-secret"), types.StringNull(), types.Int64Null()),
+	)
+
+	// Test case: secret_wo is sent in place of the stored secret, which is still used when no write-only value is set
+	t.Run("radius servers", func(t *testing.T) {
+		payload, diags := RadiusServersPayload(ctx, servers, keys)
+		require.False(t, diags.HasError(), diags)
+		require.Len(t, payload, 2)
+		assert.Equal(t, "write-only-secret", payload[0].GetSecret())
+		assert.Equal(t, "stored-secret", payload[1].GetSecret())
+	})
+
+	t.Run("radius accounting servers", func(t *testing.T) {
+		payload, diags := RadiusAccountingServersPayload(ctx, servers, keys)
+		require.False(t, diags.HasError(), diags)
+		require.Len(t, payload, 2)
+		assert.Equal(t, "write-only-secret", payload[0].GetSecret())
+		assert.Equal(t, "stored-secret", payload[1].GetSecret())
+	})
+}
+
+func TestPlanWriteOnlySecrets(t *testing.T) {
+	ctx := context.Background()
+
+	// The prior state values are carried into the plan by the sensitive plan modifier
+	plan := resourceModel{
+		PSK:                 types.StringValue("previous-psk"),
+		PSKWriteOnlyVersion: types.Int64Value(2),
+		RadiusServers: newRadiusServerList(t,
+			newRadiusServer(types.StringValue("previous-secret"), types.StringNull(), types.Int64Value(1)),
+			newRadiusServer(types.StringValue("stored-secret"), types.StringNull(), types.Int64Null()),
+		),
+		RadiusAccountingServers: types.ListNull(types.ObjectType{}),
+	}
+
+	diags := planWriteOnlySecrets(ctx, &plan)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, plan.PSK.IsNull())
+
+	var servers []RadiusServer
+	require.False(t, plan.RadiusServers.ElementsAs(ctx, &servers, true).HasError())
+	require.Len(t, servers, 2)
+	assert.True(t, servers[0].Secret.IsNull())
+	assert.Equal(t, "stored-secret", servers[1].Secret.ValueString())
+}
@@ -66,6 +66,8 @@ type resourceModel struct {
 	EnterpriseAdminAccess            types.String `tfsdk:"enterprise_admin_access" json:"enterpriseAdminAccess"`
 	EncryptionMode                   types.String `tfsdk:"encryption_mode" json:"encryptionMode"`
 	PSK                              types.String `tfsdk:"psk" json:"psk"`
+	PSKWriteOnly                     types.String `tfsdk:"psk_wo" json:"-"`
+	PSKWriteOnlyVersion              types.Int64  `tfsdk:"psk_wo_version" json:"-"`
 	WPAEncryptionMode                types.String `tfsdk:"wpa_encryption_mode" json:"wpaEncryptionMode"`
 	Dot11w                           types.Object `tfsdk:"dot11w" json:"dot11w"`
 	Dot11r                           types.Object `tfsdk:"dot11r" json:"dot11r"`
@@ -218,6 +220,8 @@ type RadiusServer struct {
 	Host                     types.String `tfsdk:"host" json:"host"`
 	Port                     types.Int64  `tfsdk:"port" json:"port"`
 	Secret                   types.String `tfsdk:"secret" json:"secret"`
+	SecretWriteOnly          types.String `tfsdk:"secret_wo" json:"-"`
+	SecretWriteOnlyVersion   types.Int64  `tfsdk:"secret_wo_version" json:"-"`
 	RadSecEnabled            types.Bool   `tfsdk:"rad_sec_enabled" json:"radSecEnabled"`
 	OpenRoamingCertificateID types.Int64  `tfsdk:"open_roaming_certificate_id" json:"openRoamingCertificateId"`
 	CaCertificate            types.String `tfsdk:"ca_certificate" json:"caCertificate"`
@@ -11,7 +11,6 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -19,6 +18,7 @@ var (
 	_ resource.Resource                = &Resource{}
 	_ resource.ResourceWithConfigure   = &Resource{}
 	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
 )
 
 func NewResource() resource.Resource {
@@ -29,9 +29,10 @@ func NewResource() resource.Resource {
 
 // Resource defines the resource implementation.
 type Resource struct {
-	client        *openApiClient.APIClient
-	typeName      string
-	encryptionKey string
+	client     *openApiClient.APIClient
+	retry      utils.RetryPolicy
+	typeName   string
+	encryption utils.EncryptionKeys
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -46,26 +47,51 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 
 	// Retrieve the encryption key and client from the provider configuration
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Client Type",
-			fmt.Sprintf("Expected *openApiClient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
+	r.encryption = providerData.Encryption
+}
+
+// ModifyPlan keeps encrypted sensitive values from the prior state when they match the configuration, and plans
+// no stored value for secrets managed through write-only arguments.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-	// Since we are passing only the client directly for resources, we need to handle the encryption key separately
-	// Retrieve the encryption key from the context
-	encryptionKey, ok := ctx.Value("encryption_key").(string)
-	if ok {
-		r.encryptionKey = encryptionKey
-	} else {
-		r.encryptionKey = ""
+	var plan resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	// Nothing to compare against on create
+	if !req.State.Raw.IsNull() {
+		var state resourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(preserveSensitivePlan(ctx, r.encryption, &plan, &state)...)
+	}
+
+	resp.Diagnostics.Append(planWriteOnlySecrets(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -79,8 +105,14 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
+	// Write-only arguments are only available in the configuration
+	resp.Diagnostics.Append(readWriteOnlyConfig(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Prepare the request payload
-	payload, payloadDiags := updateNetworksWirelessSsidsResourcePayload(ctx, &plan)
+	payload, payloadDiags := updateNetworksWirelessSsidsResourcePayload(ctx, &plan, r.encryption)
 	if payloadDiags.HasError() {
 		tflog.Error(ctx, "Failed to create resource payload", map[string]interface{}{
 			"error": payloadDiags,
@@ -92,11 +124,8 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, maxRetries, retryDelay, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
-		inline, respHttp, err := r.client.WirelessApi.UpdateNetworkWirelessSsid(context.Background(), plan.NetworkId.ValueString(), fmt.Sprint(plan.Number.ValueInt64())).UpdateNetworkWirelessSsidRequest(payload).Execute()
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, r.retry, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
+		inline, respHttp, err := r.client.WirelessApi.UpdateNetworkWirelessSsid(ctx, plan.NetworkId.ValueString(), fmt.Sprint(plan.Number.ValueInt64())).UpdateNetworkWirelessSsidRequest(payload).Execute()
 		return inline, respHttp, err
 	})
 
@@ -119,10 +148,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 				"error":        err.Error(),
 				"responseBody": responseBody,
 			})
-			resp.Diagnostics.AddError(
-				"HTTP Call Failed",
-				fmt.Sprintf("Details: %s", responseBody),
-			)
+			resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Create Failure", httpResp, err))
 			return
 		}
 
@@ -134,10 +160,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 				"error":        err.Error(),
 				"responseBody": responseBody,
 			})
-			resp.Diagnostics.AddError(
-				"HTTP Call Failed",
-				fmt.Sprintf("Details: %s", err.Error()),
-			)
+			resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Create Failure", httpResp, err))
 		}
 		return
 	}
@@ -175,11 +198,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, maxRetries, retryDelay, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
-		inline, respHttp, err := r.client.WirelessApi.GetNetworkWirelessSsid(context.Background(), plan.NetworkId.ValueString(), fmt.Sprint(plan.Number.ValueInt64())).Execute()
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, r.retry, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
+		inline, respHttp, err := r.client.WirelessApi.GetNetworkWirelessSsid(ctx, plan.NetworkId.ValueString(), fmt.Sprint(plan.Number.ValueInt64())).Execute()
 		if err != nil {
 			// Check for specific error
 			if strings.Contains(err.Error(), "json: cannot unmarshal number") && strings.Contains(err.Error(), "GetNetworkWirelessSsids200ResponseInner.minBitrate") {
@@ -197,10 +217,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
 		return
 	}
 
@@ -223,11 +240,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 
 	// Check for API success response code
 	if httpResp.StatusCode != 200 {
-		responseBody, _ := utils.ReadAndCloseBody(httpResp)
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			utils.NewHttpDiagnostics(httpResp, responseBody),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Unexpected HTTP Response Status Code", httpResp, nil))
 	}
 
 	diags = updateNetworksWirelessSsidsResourceState(ctx, &plan, &state, inlineResp, httpResp)
@@ -235,6 +248,13 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Encrypt sensitive values, re-encrypting any written with a previous key
+	resp.Diagnostics.Append(sealSensitiveState(ctx, r.encryption, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -253,8 +273,14 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	// Write-only arguments are only available in the configuration
+	resp.Diagnostics.Append(readWriteOnlyConfig(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Prepare the request payload
-	payload, payloadDiags := updateNetworksWirelessSsidsResourcePayload(ctx, &plan)
+	payload, payloadDiags := updateNetworksWirelessSsidsResourcePayload(ctx, &plan, r.encryption)
 	if payloadDiags.HasError() {
 		tflog.Error(ctx, "Failed to create resource payload", map[string]interface{}{
 			"error": payloadDiags,
@@ -266,11 +292,8 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, maxRetries, retryDelay, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
-		inline, respHttp, err := r.client.WirelessApi.UpdateNetworkWirelessSsid(context.Background(), plan.NetworkId.ValueString(), fmt.Sprint(plan.Number.ValueInt64())).UpdateNetworkWirelessSsidRequest(payload).Execute()
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, r.retry, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
+		inline, respHttp, err := r.client.WirelessApi.UpdateNetworkWirelessSsid(ctx, plan.NetworkId.ValueString(), fmt.Sprint(plan.Number.ValueInt64())).UpdateNetworkWirelessSsidRequest(payload).Execute()
 		if err != nil {
 			// Check for specific error
 			if strings.Contains(err.Error(), "json: cannot unmarshal number") && strings.Contains(err.Error(), "GetNetworkWirelessSsids200ResponseInner.minBitrate") {
@@ -289,19 +312,13 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Update Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Update Call Failed", httpResp, err))
+		return
 	}
 
 	// Check for API success response code
 	if httpResp != nil && httpResp.StatusCode != 200 {
-		responseBody, _ := utils.ReadAndCloseBody(httpResp)
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			utils.NewHttpDiagnostics(httpResp, responseBody),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Unexpected HTTP Response Status Code", httpResp, nil))
 	}
 
 	diags = updateNetworksWirelessSsidsResourceState(ctx, &plan, &state, inlineResp, httpResp)
@@ -333,11 +350,8 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	payload.SetAuthMode("open")
 	payload.SetVlanId(1)
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	_, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, maxRetries, retryDelay, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
-		inline, respHttp, err := r.client.WirelessApi.UpdateNetworkWirelessSsid(context.Background(), state.NetworkId.ValueString(), fmt.Sprint(state.Number.ValueInt64())).UpdateNetworkWirelessSsidRequest(payload).Execute()
+	_, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsids200ResponseInner](ctx, r.retry, func() (*openApiClient.GetNetworkWirelessSsids200ResponseInner, *http.Response, error) {
+		inline, respHttp, err := r.client.WirelessApi.UpdateNetworkWirelessSsid(ctx, state.NetworkId.ValueString(), fmt.Sprint(state.Number.ValueInt64())).UpdateNetworkWirelessSsidRequest(payload).Execute()
 		if err != nil {
 			// Check for specific error
 			if strings.Contains(err.Error(), "json: cannot unmarshal number") && strings.Contains(err.Error(), "GetNetworkWirelessSsids200ResponseInner.minBitrate") {
@@ -356,19 +370,13 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Call Failed",
-			fmt.Sprintf("Details: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Delete Failure", httpResp, err))
+		return
 	}
 
 	// Check for API success response code
 	if httpResp != nil && httpResp.StatusCode != 200 {
-		responseBody, _ := utils.ReadAndCloseBody(httpResp)
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			utils.NewHttpDiagnostics(httpResp, responseBody),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Unexpected HTTP Response Status Code", httpResp, nil))
 	}
 	if resp.Diagnostics.HasError() {
 		return
@@ -3,7 +3,9 @@ package ssid
 import (
 	"context"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -766,10 +768,27 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
-					utils.NewSensitivePlanModifier(r.encryptionKey),
+					utils.NewSensitivePlanModifier(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"psk_wo": schema.StringAttribute{
+				MarkdownDescription: `Write-only passkey for the SSID, sent to the Dashboard API but never stored in state. Conflicts with psk. Change psk_wo_version to send a new value.`,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("psk")),
+					stringvalidator.AlsoRequires(path.MatchRoot("psk_wo_version")),
+				},
+			},
+			"psk_wo_version": schema.Int64Attribute{
+				MarkdownDescription: `Version of psk_wo. Terraform cannot detect changes to write-only values, so change this value to update the passkey.`,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("psk_wo")),
+				},
+			},
 			"radius_accounting_enabled": schema.BoolAttribute{
 				MarkdownDescription: `Whether or not RADIUS accounting is enabled`,
 				Computed:            true,
@@ -809,7 +828,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							Computed:            true,
 							Sensitive:           true,
 							PlanModifiers: []planmodifier.String{
-								utils.NewSensitivePlanModifier(r.encryptionKey),
+								utils.NewSensitivePlanModifier(),
 							},
 						},
 						"host": schema.StringAttribute{
@@ -850,7 +869,24 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							Computed:            true,
 							Sensitive:           true,
 							PlanModifiers: []planmodifier.String{
-								utils.NewSensitivePlanModifier(r.encryptionKey),
+								utils.NewSensitivePlanModifier(),
+							},
+						},
+						"secret_wo": schema.StringAttribute{
+							MarkdownDescription: `Write-only RADIUS client shared secret, sent to the Dashboard API but never stored in state. Conflicts with secret. Change secret_wo_version to send a new value.`,
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("secret")),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_wo_version")),
+							},
+						},
+						"secret_wo_version": schema.Int64Attribute{
+							MarkdownDescription: `Version of secret_wo. Terraform cannot detect changes to write-only values, so change this value to update the secret.`,
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_wo")),
 							},
 						},
 					},
@@ -1000,7 +1036,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							Computed:            true,
 							Sensitive:           true,
 							PlanModifiers: []planmodifier.String{
-								utils.NewSensitivePlanModifier(r.encryptionKey),
+								utils.NewSensitivePlanModifier(),
 							},
 						},
 						/*
@@ -1050,7 +1086,24 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 							Computed:            true,
 							Sensitive:           true,
 							PlanModifiers: []planmodifier.String{
-								utils.NewSensitivePlanModifier(r.encryptionKey),
+								utils.NewSensitivePlanModifier(),
+							},
+						},
+						"secret_wo": schema.StringAttribute{
+							MarkdownDescription: `Write-only RADIUS client shared secret, sent to the Dashboard API but never stored in state. Conflicts with secret. Change secret_wo_version to send a new value.`,
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("secret")),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_wo_version")),
+							},
+						},
+						"secret_wo_version": schema.Int64Attribute{
+							MarkdownDescription: `Version of secret_wo. Terraform cannot detect changes to write-only values, so change this value to update the secret.`,
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_wo")),
 							},
 						},
 					},
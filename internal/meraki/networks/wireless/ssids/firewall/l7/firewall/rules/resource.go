@@ -133,21 +133,21 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
 	// This allows the resource to use the configured provider for any API calls it needs to make.
-	r.client = client
+	r.client = providerData.Client
 }
 
 // Create method is responsible for creating a new resource.
@@ -176,14 +176,11 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 	updateNetworkWirelessSsidFirewallL7FirewallRules.SetRules(rules)
 
-	_, httpResp, err := r.client.FirewallApi.UpdateNetworkWirelessSsidFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString(), data.Number.ValueString()).UpdateNetworkWirelessSsidFirewallL7FirewallRulesRequest(updateNetworkWirelessSsidFirewallL7FirewallRules).Execute()
+	_, httpResp, err := r.client.FirewallApi.UpdateNetworkWirelessSsidFirewallL7FirewallRules(ctx, data.NetworkId.ValueString(), data.Number.ValueString()).UpdateNetworkWirelessSsidFirewallL7FirewallRulesRequest(updateNetworkWirelessSsidFirewallL7FirewallRules).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -234,14 +231,11 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.FirewallApi.GetNetworkWirelessSsidFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString(), data.Number.ValueString()).Execute()
+	_, httpResp, err := r.client.FirewallApi.GetNetworkWirelessSsidFirewallL7FirewallRules(ctx, data.NetworkId.ValueString(), data.Number.ValueString()).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -305,14 +299,11 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 	updateNetworkWirelessSsidFirewallL7FirewallRules.SetRules(rules)
 
-	_, httpResp, err := r.client.FirewallApi.UpdateNetworkWirelessSsidFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString(), data.Number.ValueString()).UpdateNetworkWirelessSsidFirewallL7FirewallRulesRequest(updateNetworkWirelessSsidFirewallL7FirewallRules).Execute()
+	_, httpResp, err := r.client.FirewallApi.UpdateNetworkWirelessSsidFirewallL7FirewallRules(ctx, data.NetworkId.ValueString(), data.Number.ValueString()).UpdateNetworkWirelessSsidFirewallL7FirewallRulesRequest(updateNetworkWirelessSsidFirewallL7FirewallRules).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -376,14 +367,11 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 	updateNetworkWirelessSsidFirewallL7FirewallRules.SetRules(rules)
 
-	_, httpResp, err := r.client.FirewallApi.UpdateNetworkWirelessSsidFirewallL7FirewallRules(context.Background(), data.NetworkId.ValueString(), data.Number.ValueString()).UpdateNetworkWirelessSsidFirewallL7FirewallRulesRequest(updateNetworkWirelessSsidFirewallL7FirewallRules).Execute()
+	_, httpResp, err := r.client.FirewallApi.UpdateNetworkWirelessSsidFirewallL7FirewallRules(ctx, data.NetworkId.ValueString(), data.Number.ValueString()).UpdateNetworkWirelessSsidFirewallL7FirewallRulesRequest(updateNetworkWirelessSsidFirewallL7FirewallRules).Execute()
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -0,0 +1,90 @@
+package psk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralResource{}
+)
+
+// NewEphemeralResource initializes a new SSID identity PSK ephemeral resource.
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{}
+}
+
+// EphemeralResource reads the passphrase of an identity PSK without storing it in plan or state.
+type EphemeralResource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+// Metadata sets the ephemeral resource type name.
+func (e *EphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_wireless_ssids_identity_psk"
+}
+
+// Schema sets the ephemeral resource schema.
+func (e *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = EphemeralSchema
+}
+
+// Configure initializes the API client for the ephemeral resource.
+func (e *EphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Ensure the provider has been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configuration",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = providerData.Client
+	e.retry = providerData.Retry
+}
+
+// Open fetches the identity PSK and returns its passphrase as an ephemeral result.
+func (e *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "[ssids_identity_psk] Calling API to retrieve identity PSK")
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry[*openApiClient.GetNetworkWirelessSsidIdentityPsks200ResponseInner](ctx, e.retry, func() (*openApiClient.GetNetworkWirelessSsidIdentityPsks200ResponseInner, *http.Response, error) {
+		return e.client.WirelessApi.GetNetworkWirelessSsidIdentityPsk(ctx, data.NetworkId.ValueString(), data.Number.ValueString(), data.IdentityPskId.ValueString()).Execute()
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Error reading identity PSK", httpResp, err))
+		return
+	}
+
+	data.Name = types.StringPointerValue(inlineResp.Name)
+	data.Passphrase = types.StringPointerValue(inlineResp.Passphrase)
+	data.GroupPolicyId = types.StringPointerValue(inlineResp.GroupPolicyId)
+	data.ExpiresAt = types.StringNull()
+	if inlineResp.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(inlineResp.ExpiresAt.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,29 @@
+package psk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/*
+
+// Sample API Response v1.52.0
+{
+  "id": "1284392014819",
+  "name": "Sample Identity PSK",
+  "passphrase": "secret",
+  "groupPolicyId": "101",
+  "expiresAt": "2018-02-11T00:00:00.090210Z"
+}
+
+*/
+
+// EphemeralModel maps the ephemeral resource schema data.
+type EphemeralModel struct {
+	NetworkId     types.String `tfsdk:"network_id"`
+	Number        types.String `tfsdk:"number"`
+	IdentityPskId types.String `tfsdk:"identity_psk_id"`
+	Name          types.String `tfsdk:"name"`
+	Passphrase    types.String `tfsdk:"passphrase"`
+	GroupPolicyId types.String `tfsdk:"group_policy_id"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
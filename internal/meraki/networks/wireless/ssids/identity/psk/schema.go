@@ -0,0 +1,42 @@
+package psk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+// EphemeralSchema defines the schema for reading an identity PSK passphrase.
+var EphemeralSchema = schema.Schema{
+	MarkdownDescription: "Reads the passphrase of an identity PSK without storing it in plan or state.",
+
+	Attributes: map[string]schema.Attribute{
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "Network ID",
+			Required:            true,
+		},
+		"number": schema.StringAttribute{
+			MarkdownDescription: "SSID number",
+			Required:            true,
+		},
+		"identity_psk_id": schema.StringAttribute{
+			MarkdownDescription: "Identity PSK ID",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the identity PSK.",
+			Computed:            true,
+		},
+		"passphrase": schema.StringAttribute{
+			MarkdownDescription: "The passphrase for client authentication.",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"group_policy_id": schema.StringAttribute{
+			MarkdownDescription: "The group policy applied to clients.",
+			Computed:            true,
+		},
+		"expires_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp for when the identity PSK expires, or null if it never expires.",
+			Computed:            true,
+		},
+	},
+}
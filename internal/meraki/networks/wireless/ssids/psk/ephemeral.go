@@ -0,0 +1,94 @@
+package psk
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// MinLength and MaxLength are the passphrase lengths accepted for WPA2 and WPA3 personal SSIDs.
+	MinLength = 8
+	MaxLength = 63
+
+	// DefaultLength is used when length is not configured.
+	DefaultLength = 32
+
+	charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResource = &EphemeralResource{}
+
+// NewEphemeralResource initializes a new generated SSID PSK ephemeral resource.
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{}
+}
+
+// EphemeralResource generates a random SSID passkey that is never stored in plan or state.
+// The passkey is generated locally, so the ephemeral resource does not need the API client.
+type EphemeralResource struct{}
+
+// Metadata sets the ephemeral resource type name.
+func (e *EphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks_wireless_ssids_psk"
+}
+
+// Schema sets the ephemeral resource schema.
+func (e *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = EphemeralSchema
+}
+
+// Open generates a new passkey each time Terraform opens the ephemeral resource.
+func (e *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(DefaultLength)
+	if !data.Length.IsNull() && !data.Length.IsUnknown() {
+		length = data.Length.ValueInt64()
+	}
+
+	psk, err := Generate(int(length))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating PSK", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "[ssids_psk] Generated passkey", map[string]interface{}{
+		"length": length,
+	})
+
+	data.Length = types.Int64Value(length)
+	data.PSK = types.StringValue(psk)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Generate returns a random alphanumeric passkey of the given length using crypto/rand.
+func Generate(length int) (string, error) {
+	if length < MinLength || length > MaxLength {
+		return "", fmt.Errorf("length must be between %d and %d, got %d", MinLength, MaxLength, length)
+	}
+
+	max := big.NewInt(int64(len(charset)))
+	psk := make([]byte, length)
+	for i := range psk {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("reading random bytes: %w", err)
+		}
+		psk[i] = charset[n.Int64()]
+	}
+
+	return string(psk), nil
+}
@@ -0,0 +1,38 @@
+package psk_test
+
+import (
+	"testing"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/wireless/ssids/psk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	// Test case: Passkeys have the requested length and only use alphanumeric characters
+	t.Run("valid lengths", func(t *testing.T) {
+		for _, length := range []int{psk.MinLength, psk.DefaultLength, psk.MaxLength} {
+			generated, err := psk.Generate(length)
+			require.NoError(t, err)
+			assert.Len(t, generated, length)
+			assert.Regexp(t, "^[a-zA-Z0-9]+$", generated)
+		}
+	})
+
+	// Test case: Each call returns a new passkey
+	t.Run("unique", func(t *testing.T) {
+		first, err := psk.Generate(psk.DefaultLength)
+		require.NoError(t, err)
+		second, err := psk.Generate(psk.DefaultLength)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	// Test case: Lengths outside of what WPA accepts are rejected
+	t.Run("invalid lengths", func(t *testing.T) {
+		for _, length := range []int{0, psk.MinLength - 1, psk.MaxLength + 1} {
+			_, err := psk.Generate(length)
+			assert.Error(t, err)
+		}
+	})
+}
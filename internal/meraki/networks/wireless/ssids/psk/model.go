@@ -0,0 +1,11 @@
+package psk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EphemeralModel maps the ephemeral resource schema data.
+type EphemeralModel struct {
+	Length types.Int64  `tfsdk:"length"`
+	PSK    types.String `tfsdk:"psk"`
+}
@@ -0,0 +1,28 @@
+package psk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// EphemeralSchema defines the schema for a generated SSID passkey.
+var EphemeralSchema = schema.Schema{
+	MarkdownDescription: "Generates a random passkey for a PSK SSID without storing it in plan or state. Pass `psk` to the `psk_wo` argument of `meraki_networks_wireless_ssids`.",
+
+	Attributes: map[string]schema.Attribute{
+		"length": schema.Int64Attribute{
+			MarkdownDescription: "Number of characters in the passkey, between 8 and 63. Defaults to `32`.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(MinLength, MaxLength),
+			},
+		},
+		"psk": schema.StringAttribute{
+			MarkdownDescription: "The generated alphanumeric passkey.",
+			Computed:            true,
+			Sensitive:           true,
+		},
+	},
+}
@@ -6,11 +6,9 @@ import (
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"io"
 	"net/http"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -29,6 +27,7 @@ func NewDataSource() datasource.DataSource {
 
 type DataSource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -154,22 +153,22 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
+	d.retry = providerData.Retry
 }
 
 func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data dataSourceModel
-	var diags diag.Diagnostics
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -178,21 +177,15 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	maxRetries := d.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(d.client.GetConfig().Retry4xxErrorWaitTime)
-
 	// usage of CustomHttpRequestRetry with a slice of strongly typed structs
-	apiCallSlice := func() ([]openApiClient.GetOrganizationAdmins200ResponseInner, *http.Response, error, diag.Diagnostics) {
-		inline, httpResp, err := d.client.AdminsApi.GetOrganizationAdmins(context.Background(), data.OrganizationId.ValueString()).Execute()
-		return inline, httpResp, err, diags
+	apiCallSlice := func() ([]openApiClient.GetOrganizationAdmins200ResponseInner, *http.Response, error) {
+		inline, httpResp, err := d.client.AdminsApi.GetOrganizationAdmins(ctx, data.OrganizationId.ValueString()).Execute()
+		return inline, httpResp, err
 	}
 
-	resultSlice, httpResp, errSlice, tfDiag := utils.CustomHttpRequestRetryStronglyTyped(ctx, maxRetries, retryDelay, apiCallSlice)
+	resultSlice, httpResp, errSlice := utils.CustomHttpRequestRetry(ctx, d.retry, apiCallSlice)
 	if errSlice != nil {
 
-		if tfDiag.HasError() {
-			fmt.Printf(": %s\n", tfDiag.Errors())
-		}
 		fmt.Printf("Error creating group policy: %s\n", errSlice)
 		if httpResp != nil {
 			var responseBody string
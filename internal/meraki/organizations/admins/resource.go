@@ -6,12 +6,10 @@ import (
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"io"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -36,6 +34,7 @@ func NewResource() resource.Resource {
 
 type Resource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -184,23 +183,23 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected NewResource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *resourceModel
-	var diags diag.Diagnostics
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -243,27 +242,20 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		createOrganizationAdmin.SetAuthenticationMethod(data.AuthenticationMethod.ValueString())
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// API call function to be passed to retryOn4xx
-	apiCall := func() (*openApiClient.GetOrganizationAdmins200ResponseInner, *http.Response, error, diag.Diagnostics) {
-		inline, httpResp, err := r.client.AdminsApi.CreateOrganizationAdmin(context.Background(), data.OrgId.ValueString()).CreateOrganizationAdminRequest(createOrganizationAdmin).Execute()
-		return inline, httpResp, err, diags
+	// API call function to be passed to CustomHttpRequestRetry
+	apiCall := func() (*openApiClient.GetOrganizationAdmins200ResponseInner, *http.Response, error) {
+		inline, httpResp, err := r.client.AdminsApi.CreateOrganizationAdmin(ctx, data.OrgId.ValueString()).CreateOrganizationAdminRequest(createOrganizationAdmin).Execute()
+		return inline, httpResp, err
 	}
 
-	// Use retryOn4xx for the API call as the meraki API backend returns HTTP 400 messages as a result of collision issues with rapid creation of postgres GroupPolicyIds.
-	_, httpResp, err, tfDiags := utils.CustomHttpRequestRetryStronglyTyped(ctx, maxRetries, retryDelay, apiCall)
+	// Retry the API call on rate limiting and server errors
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating admin",
 			fmt.Sprintf("Could not create admin, unexpected error: %s", err),
 		)
 
-		if tfDiags.HasError() {
-			fmt.Printf(": %s\n", tfDiags.Errors())
-		}
-
 		if httpResp != nil {
 			var responseBody string
 			if httpResp != nil && httpResp.Body != nil {
@@ -324,17 +316,14 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
 	// Usage of CustomHttpRequestRetry with a slice of strongly typed structs
 	apiCallSlice := func() ([]openApiClient.GetOrganizationAdmins200ResponseInner, *http.Response, error) {
-		inline, httpResp, err := r.client.AdminsApi.GetOrganizationAdmins(context.Background(), data.OrgId.ValueString()).Execute()
+		inline, httpResp, err := r.client.AdminsApi.GetOrganizationAdmins(ctx, data.OrgId.ValueString()).Execute()
 		return inline, httpResp, err
 	}
 
 	// Directly use the type returned by the function
-	resultSlice, httpRespSlice, errSlice := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCallSlice)
+	resultSlice, httpRespSlice, errSlice := utils.CustomHttpRequestRetry(ctx, r.retry, apiCallSlice)
 	if errSlice != nil {
 		resp.Diagnostics.AddError(
 			"Error reading admins",
@@ -423,7 +412,6 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *resourceModel
-	var diags diag.Diagnostics
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -460,27 +448,20 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		updateOrganizationAdmin.SetNetworks(networks)
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// API call function to be passed to retryOn4xx
-	apiCall := func() (*openApiClient.GetOrganizationAdmins200ResponseInner, *http.Response, error, diag.Diagnostics) {
-		inline, httpResp, err := r.client.AdminsApi.UpdateOrganizationAdmin(context.Background(), data.OrgId.ValueString(), data.AdminId.ValueString()).UpdateOrganizationAdminRequest(updateOrganizationAdmin).Execute()
-		return inline, httpResp, err, diags
+	// API call function to be passed to CustomHttpRequestRetry
+	apiCall := func() (*openApiClient.GetOrganizationAdmins200ResponseInner, *http.Response, error) {
+		inline, httpResp, err := r.client.AdminsApi.UpdateOrganizationAdmin(ctx, data.OrgId.ValueString(), data.AdminId.ValueString()).UpdateOrganizationAdminRequest(updateOrganizationAdmin).Execute()
+		return inline, httpResp, err
 	}
 
-	// Use retryOn4xx for the API call as the meraki API backend returns HTTP 400 messages as a result of collision issues with rapid creation of postgres GroupPolicyIds.
-	_, httpResp, err, tfDiags := utils.CustomHttpRequestRetryStronglyTyped(ctx, maxRetries, retryDelay, apiCall)
+	// Retry the API call on rate limiting and server errors
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating group policy",
 			fmt.Sprintf("Could not create group policy, unexpected error: %s", err),
 		)
 
-		if tfDiags.HasError() {
-			fmt.Printf(": %s\n", tfDiags.Errors())
-		}
-
 		if httpResp != nil {
 			var responseBody string
 			if httpResp != nil && httpResp.Body != nil {
@@ -542,18 +523,15 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	maxRetries := r.client.GetConfig().MaximumRetries
-	retryDelay := time.Duration(r.client.GetConfig().Retry4xxErrorWaitTime)
-
-	// API call function to be passed to retryOn4xx
+	// API call function to be passed to CustomHttpRequestRetry
 	apiCall := func() (map[string]interface{}, *http.Response, error) {
-		httpResp, err := r.client.AdminsApi.DeleteOrganizationAdmin(context.Background(), data.OrgId.ValueString(), data.AdminId.ValueString()).Execute()
+		httpResp, err := r.client.AdminsApi.DeleteOrganizationAdmin(ctx, data.OrgId.ValueString(), data.AdminId.ValueString()).Execute()
 
 		return nil, httpResp, err
 	}
 
-	// Use retryOn4xx for the API call as the meraki API backend returns HTTP 400 messages as a result of collision issues with rapid creation of postgres GroupPolicyIds.
-	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, maxRetries, retryDelay, apiCall)
+	// Retry the API call on rate limiting and server errors
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting admin",
@@ -0,0 +1,81 @@
+package intrusion
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// intrusionPayload returns the allowed rules payload for the planned data. The messages are set by the Dashboard.
+func intrusionPayload(ctx context.Context, data *resourceModel) (openApiClient.UpdateOrganizationApplianceSecurityIntrusionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var rules []allowedRuleModel
+	diags.Append(data.AllowedRules.ElementsAs(ctx, &rules, false)...)
+
+	allowedRules := []openApiClient.UpdateOrganizationApplianceSecurityIntrusionRequestAllowedRulesInner{}
+	for _, rule := range rules {
+		allowedRules = append(allowedRules, *openApiClient.NewUpdateOrganizationApplianceSecurityIntrusionRequestAllowedRulesInner(rule.RuleId.ValueString()))
+	}
+
+	return *openApiClient.NewUpdateOrganizationApplianceSecurityIntrusionRequest(allowedRules), diags
+}
+
+// resetPayload returns the payload that removes every allowed rule of an organization.
+func resetPayload() openApiClient.UpdateOrganizationApplianceSecurityIntrusionRequest {
+	return *openApiClient.NewUpdateOrganizationApplianceSecurityIntrusionRequest([]openApiClient.UpdateOrganizationApplianceSecurityIntrusionRequestAllowedRulesInner{})
+}
+
+// readIntrusion sets data from the allowed rules returned by the Dashboard API.
+func readIntrusion(ctx context.Context, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var intrusion apiIntrusion
+	if err := utils.ConvertJSON(response, &intrusion); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the allowed intrusion rules: %s", err))
+		return diags
+	}
+
+	data.Id = data.OrganizationId
+
+	rules := []allowedRuleModel{}
+	for _, rule := range intrusion.AllowedRules {
+		rules = append(rules, allowedRuleModel{RuleId: types.StringValue(rule.RuleId), Message: types.StringValue(rule.Message)})
+	}
+
+	var listDiags diag.Diagnostics
+	data.AllowedRules, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: allowedRuleAttrTypes()}, rules)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// validateIntrusion checks at plan time that no rule is allowed twice.
+func validateIntrusion(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.AllowedRules) {
+		return diags
+	}
+
+	var rules []allowedRuleModel
+	diags.Append(data.AllowedRules.ElementsAs(ctx, &rules, false)...)
+
+	seen := map[string]bool{}
+	for i, rule := range rules {
+		if !utils.IsKnown(rule.RuleId) {
+			continue
+		}
+		if seen[rule.RuleId.ValueString()] {
+			diags.AddAttributeError(path.Root("allowed_rules").AtListIndex(i).AtName("rule_id"), "Duplicate Allowed Rule",
+				fmt.Sprintf("%q is allowed more than once", rule.RuleId.ValueString()))
+		}
+		seen[rule.RuleId.ValueString()] = true
+	}
+
+	return diags
+}
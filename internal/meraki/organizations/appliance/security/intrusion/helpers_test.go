@@ -0,0 +1,58 @@
+package intrusion
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testResourceModel(ruleIds ...string) resourceModel {
+	rules := []attr.Value{}
+	for _, ruleId := range ruleIds {
+		rules = append(rules, types.ObjectValueMust(allowedRuleAttrTypes(), map[string]attr.Value{
+			"rule_id": types.StringValue(ruleId),
+			"message": types.StringUnknown(),
+		}))
+	}
+
+	return resourceModel{
+		Id:             types.StringUnknown(),
+		OrganizationId: types.StringValue("2930418"),
+		AllowedRules:   types.ListValueMust(types.ObjectType{AttrTypes: allowedRuleAttrTypes()}, rules),
+	}
+}
+
+func TestReadIntrusion(t *testing.T) {
+	ctx := context.Background()
+
+	data := testResourceModel("meraki:intrusion/snort/GID/1/SID/688")
+	response := map[string]interface{}{
+		"allowedRules": []interface{}{
+			map[string]interface{}{"ruleId": "meraki:intrusion/snort/GID/1/SID/688", "message": "SQL sa login failed"},
+		},
+	}
+	require.False(t, readIntrusion(ctx, &data, response).HasError())
+
+	assert.Equal(t, "2930418", data.Id.ValueString())
+	require.Len(t, data.AllowedRules.Elements(), 1)
+	assert.Equal(t, types.StringValue("SQL sa login failed"), data.AllowedRules.Elements()[0].(types.Object).Attributes()["message"])
+
+	// Test case: No allowed rules
+	require.False(t, readIntrusion(ctx, &data, map[string]interface{}{}).HasError())
+	assert.Empty(t, data.AllowedRules.Elements())
+}
+
+func TestValidateIntrusion(t *testing.T) {
+	ctx := context.Background()
+
+	data := testResourceModel("meraki:intrusion/snort/GID/1/SID/688", "meraki:intrusion/snort/GID/1/SID/10443")
+	assert.False(t, validateIntrusion(ctx, &data).HasError())
+
+	data = testResourceModel("meraki:intrusion/snort/GID/1/SID/688", "meraki:intrusion/snort/GID/1/SID/688")
+	diags := validateIntrusion(ctx, &data)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Duplicate Allowed Rule", diags.Errors()[0].Summary())
+}
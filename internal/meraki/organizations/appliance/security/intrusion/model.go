@@ -0,0 +1,33 @@
+package intrusion
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the intrusion rules allowed across the networks of an organization.
+type resourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	AllowedRules   types.List   `tfsdk:"allowed_rules"`
+}
+
+type allowedRuleModel struct {
+	RuleId  types.String `tfsdk:"rule_id"`
+	Message types.String `tfsdk:"message"`
+}
+
+func allowedRuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rule_id": types.StringType,
+		"message": types.StringType,
+	}
+}
+
+// apiIntrusion is the allowed intrusion rules of an organization in the format of the Dashboard API.
+type apiIntrusion struct {
+	AllowedRules []struct {
+		RuleId  string `json:"ruleId"`
+		Message string `json:"message"`
+	} `json:"allowedRules"`
+}
@@ -0,0 +1,65 @@
+package intrusion_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccOrganizationsApplianceSecurityIntrusionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create test Organization
+			{
+				Config: utils.CreateOrganizationConfig("test_acc_meraki_organizations_appliance_security_intrusion"),
+				Check:  utils.OrganizationTestChecks("test_acc_meraki_organizations_appliance_security_intrusion"),
+			},
+
+			// Create and Read Allowed Rules
+			{
+				Config: OrganizationsApplianceSecurityIntrusionResourceConfig(`"meraki:intrusion/snort/GID/1/SID/688"`),
+				Check: utils.ResourceTestCheck("meraki_organizations_appliance_security_intrusion.test", map[string]string{
+					"allowed_rules.#":         "1",
+					"allowed_rules.0.rule_id": "meraki:intrusion/snort/GID/1/SID/688",
+				}),
+			},
+
+			// Update and Read Allowed Rules
+			{
+				Config: OrganizationsApplianceSecurityIntrusionResourceConfig(`"meraki:intrusion/snort/GID/1/SID/688", "meraki:intrusion/snort/GID/1/SID/10443"`),
+				Check: utils.ResourceTestCheck("meraki_organizations_appliance_security_intrusion.test", map[string]string{
+					"allowed_rules.#":         "2",
+					"allowed_rules.1.rule_id": "meraki:intrusion/snort/GID/1/SID/10443",
+				}),
+			},
+
+			// Import State testing
+			{
+				ResourceName:      "meraki_organizations_appliance_security_intrusion.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// OrganizationsApplianceSecurityIntrusionResourceConfig returns the configuration string for allowing the given intrusion rules
+func OrganizationsApplianceSecurityIntrusionResourceConfig(ruleIds string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_organizations_appliance_security_intrusion" "test" {
+    organization_id = resource.meraki_organization.test.organization_id
+    allowed_rules = [for rule_id in [%s] : { rule_id = rule_id }]
+}
+`,
+		utils.CreateOrganizationConfig("test_acc_meraki_organizations_appliance_security_intrusion"),
+		ruleIds,
+	)
+}
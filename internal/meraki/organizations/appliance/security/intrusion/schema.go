@@ -0,0 +1,53 @@
+package intrusion
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"regexp"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the intrusion rules that are allowed across the appliances of an organization. The rules replace any allowed rules configured in the Dashboard, and deleting this resource removes every allowed rule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The intrusion rules that are never blocked or alerted on",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the rule, e.g. `meraki:intrusion/snort/GID/1/SID/688`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^meraki:intrusion/snort/GID/\d+/SID/\d+$`), "must be an intrusion rule ID such as meraki:intrusion/snort/GID/1/SID/688"),
+							},
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The message of the rule",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
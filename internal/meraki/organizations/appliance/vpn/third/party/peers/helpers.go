@@ -0,0 +1,241 @@
+package peers
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// customIpsecPoliciesPreset is the preset the Dashboard API reports for peers with custom IPsec policies. It cannot be
+// sent as a preset.
+const customIpsecPoliciesPreset = "custom"
+
+// peersPayload returns the third party VPN peers payload for the planned data, with the secrets decrypted with keys.
+// The peers are always sent, so that peers removed from the configuration are removed from the Dashboard.
+func peersPayload(ctx context.Context, data *resourceModel, keys utils.EncryptionKeys) (openApiClient.UpdateOrganizationApplianceVpnThirdPartyVPNPeersRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var payload openApiClient.UpdateOrganizationApplianceVpnThirdPartyVPNPeersRequest
+
+	var peers []peerModel
+	diags.Append(data.Peers.ElementsAs(ctx, &peers, false)...)
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	apiPeers := apiPeers{Peers: []apiPeer{}}
+	for i, peer := range peers {
+		secret, _, err := keys.Decrypt(peer.Secret.ValueString())
+		if err != nil {
+			diags.Append(utils.NewEncryptionDiagnostic(path.Root("peers").AtListIndex(i).AtName("secret"), err))
+			continue
+		}
+
+		apiPeer := apiPeer{
+			Name:     peer.Name.ValueString(),
+			PublicIp: peer.PublicIp.ValueStringPointer(),
+			RemoteId: peer.RemoteId.ValueStringPointer(),
+			LocalId:  peer.LocalId.ValueStringPointer(),
+			Secret:   &secret,
+		}
+		if utils.IsKnown(peer.IkeVersion) {
+			apiPeer.IkeVersion = peer.IkeVersion.ValueStringPointer()
+		}
+		diags.Append(peer.PrivateSubnets.ElementsAs(ctx, &apiPeer.PrivateSubnets, false)...)
+		if utils.IsKnown(peer.NetworkTags) {
+			diags.Append(peer.NetworkTags.ElementsAs(ctx, &apiPeer.NetworkTags, false)...)
+		}
+
+		// The Dashboard API ignores the IPsec policies of peers with a preset
+		if utils.IsKnown(peer.IpsecPoliciesPreset) && peer.IpsecPoliciesPreset.ValueString() != customIpsecPoliciesPreset {
+			apiPeer.IpsecPoliciesPreset = peer.IpsecPoliciesPreset.ValueStringPointer()
+		} else if policies := peer.IpsecPolicies; policies != nil {
+			apiPeer.IpsecPolicies = &apiIpsecPolicies{
+				IkeLifetime:   policies.IkeLifetime.ValueInt64Pointer(),
+				ChildLifetime: policies.ChildLifetime.ValueInt64Pointer(),
+			}
+			diags.Append(policies.IkeCipherAlgo.ElementsAs(ctx, &apiPeer.IpsecPolicies.IkeCipherAlgo, false)...)
+			diags.Append(policies.IkeAuthAlgo.ElementsAs(ctx, &apiPeer.IpsecPolicies.IkeAuthAlgo, false)...)
+			if utils.IsKnown(policies.IkePrfAlgo) {
+				diags.Append(policies.IkePrfAlgo.ElementsAs(ctx, &apiPeer.IpsecPolicies.IkePrfAlgo, false)...)
+			}
+			diags.Append(policies.IkeDiffieHellmanGroup.ElementsAs(ctx, &apiPeer.IpsecPolicies.IkeDiffieHellmanGroup, false)...)
+			diags.Append(policies.ChildCipherAlgo.ElementsAs(ctx, &apiPeer.IpsecPolicies.ChildCipherAlgo, false)...)
+			diags.Append(policies.ChildAuthAlgo.ElementsAs(ctx, &apiPeer.IpsecPolicies.ChildAuthAlgo, false)...)
+			diags.Append(policies.ChildPfsGroup.ElementsAs(ctx, &apiPeer.IpsecPolicies.ChildPfsGroup, false)...)
+		}
+
+		apiPeers.Peers = append(apiPeers.Peers, apiPeer)
+	}
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	if err := utils.ConvertJSON(apiPeers, &payload); err != nil {
+		diags.AddError("Resource Payload Error", fmt.Sprintf("Could not create the third party VPN peers payload: %s", err))
+	}
+
+	return payload, diags
+}
+
+// resetPayload returns the payload that removes every third party VPN peer of an organization.
+func resetPayload() openApiClient.UpdateOrganizationApplianceVpnThirdPartyVPNPeersRequest {
+	return *openApiClient.NewUpdateOrganizationApplianceVpnThirdPartyVPNPeersRequest([]openApiClient.UpdateOrganizationApplianceVpnThirdPartyVPNPeersRequestPeersInner{})
+}
+
+// readPeers sets data from the third party VPN peers returned by the Dashboard API, in the order of the Dashboard.
+// The secret of a peer keeps the value of the peer with the same name in data when it decrypts with keys to the
+// secret returned by the Dashboard, so that a secret changed outside of Terraform is detected without encryption
+// producing a diff.
+func readPeers(ctx context.Context, keys utils.EncryptionKeys, data *resourceModel, response interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiPeers apiPeers
+	if err := utils.ConvertJSON(response, &apiPeers); err != nil {
+		diags.AddError("Resource Response Error", fmt.Sprintf("Could not read the third party VPN peers: %s", err))
+		return diags
+	}
+
+	priorSecrets := map[string]types.String{}
+	if utils.IsKnown(data.Peers) {
+		var priorPeers []peerModel
+		diags.Append(data.Peers.ElementsAs(ctx, &priorPeers, true)...)
+		for _, peer := range priorPeers {
+			priorSecrets[peer.Name.ValueString()] = peer.Secret
+		}
+	}
+
+	peers := []peerModel{}
+	for _, apiPeer := range apiPeers.Peers {
+		peer := peerModel{
+			Name:                types.StringValue(apiPeer.Name),
+			PublicIp:            types.StringPointerValue(apiPeer.PublicIp),
+			RemoteId:            types.StringPointerValue(apiPeer.RemoteId),
+			LocalId:             types.StringPointerValue(apiPeer.LocalId),
+			IpsecPoliciesPreset: types.StringPointerValue(apiPeer.IpsecPoliciesPreset),
+			IkeVersion:          types.StringPointerValue(apiPeer.IkeVersion),
+		}
+
+		prior, ok := priorSecrets[apiPeer.Name]
+		switch {
+		case apiPeer.Secret == nil && ok:
+			peer.Secret = prior
+		case apiPeer.Secret == nil:
+			peer.Secret = types.StringNull()
+		case ok && utils.IsKnown(prior) && keys.Equivalent(prior.ValueString(), *apiPeer.Secret):
+			peer.Secret = prior
+		default:
+			peer.Secret = types.StringValue(*apiPeer.Secret)
+		}
+
+		peer.PrivateSubnets = stringList(ctx, apiPeer.PrivateSubnets, &diags)
+		peer.NetworkTags = types.SetNull(types.StringType)
+		if apiPeer.NetworkTags != nil {
+			var setDiags diag.Diagnostics
+			peer.NetworkTags, setDiags = types.SetValueFrom(ctx, types.StringType, apiPeer.NetworkTags)
+			diags.Append(setDiags...)
+		}
+
+		// The IPsec policies of peers with a preset are those of the preset, so only custom policies are read
+		preset := apiPeer.IpsecPoliciesPreset
+		if policies := apiPeer.IpsecPolicies; policies != nil && (preset == nil || *preset == customIpsecPoliciesPreset) {
+			peer.IpsecPolicies = &ipsecPoliciesModel{
+				IkeCipherAlgo:         stringList(ctx, policies.IkeCipherAlgo, &diags),
+				IkeAuthAlgo:           stringList(ctx, policies.IkeAuthAlgo, &diags),
+				IkePrfAlgo:            types.ListNull(types.StringType),
+				IkeDiffieHellmanGroup: stringList(ctx, policies.IkeDiffieHellmanGroup, &diags),
+				IkeLifetime:           types.Int64PointerValue(policies.IkeLifetime),
+				ChildCipherAlgo:       stringList(ctx, policies.ChildCipherAlgo, &diags),
+				ChildAuthAlgo:         stringList(ctx, policies.ChildAuthAlgo, &diags),
+				ChildPfsGroup:         stringList(ctx, policies.ChildPfsGroup, &diags),
+				ChildLifetime:         types.Int64PointerValue(policies.ChildLifetime),
+			}
+			if policies.IkePrfAlgo != nil {
+				peer.IpsecPolicies.IkePrfAlgo = stringList(ctx, policies.IkePrfAlgo, &diags)
+			}
+		}
+
+		peers = append(peers, peer)
+	}
+
+	data.Id = data.OrganizationId
+
+	var listDiags diag.Diagnostics
+	data.Peers, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: peerAttrTypes()}, peers)
+	diags.Append(listDiags...)
+
+	return diags
+}
+
+// peerSecrets describes the secrets of the peers, which are matched by name across the plan and the prior state.
+var peerSecrets = utils.SensitiveList[peerModel]{
+	Path:       path.Root("peers"),
+	SecretPath: []string{"secret"},
+	Secret:     func(peer *peerModel) *types.String { return &peer.Secret },
+	Key:        func(peer peerModel) string { return peer.Name.ValueString() },
+}
+
+// sealSecrets encrypts the peer secrets in state with the provider's current encryption key. Values already
+// encrypted with the current key are left untouched.
+func sealSecrets(ctx context.Context, keys utils.EncryptionKeys, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.Peers, diags = peerSecrets.Seal(ctx, keys, data.Peers)
+	return diags
+}
+
+// preserveSecrets keeps the encrypted prior state value of the secret of each planned peer when the peer with the
+// same name in state decrypts to the configured plaintext, so that encryption alone never produces a diff.
+func preserveSecrets(ctx context.Context, keys utils.EncryptionKeys, plan, state *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	plan.Peers, diags = peerSecrets.Preserve(ctx, keys, plan.Peers, state.Peers)
+	return diags
+}
+
+// validatePeers checks that every configured peer has a secret and a name that no other peer uses, since the
+// secrets are matched to the peers by name.
+func validatePeers(ctx context.Context, data *resourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !utils.IsKnown(data.Peers) {
+		return diags
+	}
+
+	var peers []peerModel
+	diags.Append(data.Peers.ElementsAs(ctx, &peers, true)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	names := map[string]bool{}
+	for i, peer := range peers {
+		peerPath := path.Root("peers").AtListIndex(i)
+		if peer.Secret.IsNull() {
+			diags.AddAttributeError(peerPath.AtName("secret"), "Missing VPN Peer Secret",
+				"Every third party VPN peer requires a secret")
+		}
+
+		if !utils.IsKnown(peer.Name) {
+			continue
+		}
+		if names[peer.Name.ValueString()] {
+			diags.AddAttributeError(peerPath.AtName("name"), "Duplicate VPN Peer",
+				fmt.Sprintf("The name %q is used by more than one third party VPN peer", peer.Name.ValueString()))
+		}
+		names[peer.Name.ValueString()] = true
+	}
+
+	return diags
+}
+
+// stringList returns values as a list, appending any conversion error to diags.
+func stringList(ctx context.Context, values []string, diags *diag.Diagnostics) types.List {
+	if values == nil {
+		values = []string{}
+	}
+	list, listDiags := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(listDiags...)
+	return list
+}
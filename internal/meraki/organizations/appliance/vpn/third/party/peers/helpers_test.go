@@ -0,0 +1,210 @@
+package peers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testPeer(name, secret string, policies *ipsecPoliciesModel) peerModel {
+	peer := peerModel{
+		Name:                types.StringValue(name),
+		PublicIp:            types.StringValue("203.0.113.10"),
+		RemoteId:            types.StringNull(),
+		LocalId:             types.StringNull(),
+		Secret:              types.StringValue(secret),
+		PrivateSubnets:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("192.168.10.0/24")}),
+		IpsecPolicies:       policies,
+		IpsecPoliciesPreset: types.StringUnknown(),
+		IkeVersion:          types.StringValue("2"),
+		NetworkTags:         types.SetUnknown(types.StringType),
+	}
+	if secret == "" {
+		peer.Secret = types.StringNull()
+	}
+	return peer
+}
+
+func testIpsecPolicies() *ipsecPoliciesModel {
+	list := func(value string) types.List {
+		return types.ListValueMust(types.StringType, []attr.Value{types.StringValue(value)})
+	}
+	return &ipsecPoliciesModel{
+		IkeCipherAlgo:         list("aes256"),
+		IkeAuthAlgo:           list("sha256"),
+		IkePrfAlgo:            types.ListUnknown(types.StringType),
+		IkeDiffieHellmanGroup: list("group14"),
+		IkeLifetime:           types.Int64Value(28800),
+		ChildCipherAlgo:       list("aes256"),
+		ChildAuthAlgo:         list("sha256"),
+		ChildPfsGroup:         list("disabled"),
+		ChildLifetime:         types.Int64Value(3600),
+	}
+}
+
+func testResourceModel(t *testing.T, peers ...peerModel) resourceModel {
+	if peers == nil {
+		peers = []peerModel{}
+	}
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: peerAttrTypes()}, peers)
+	require.False(t, diags.HasError())
+
+	return resourceModel{
+		Id:             types.StringUnknown(),
+		OrganizationId: types.StringValue("123456"),
+		Peers:          list,
+	}
+}
+
+func testPeers(t *testing.T, data resourceModel) []peerModel {
+	var peers []peerModel
+	require.False(t, data.Peers.ElementsAs(context.Background(), &peers, false).HasError())
+	return peers
+}
+
+func TestPeersPayload(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	encrypted, err := keys.Encrypt("first")
+	require.NoError(t, err)
+
+	// Test case: Secrets are decrypted and custom IPsec policies are sent
+	data := testResourceModel(t, testPeer("Peer 1", encrypted, testIpsecPolicies()), testPeer("Peer 2", "second", nil))
+	payload, diags := peersPayload(ctx, &data, keys)
+	require.False(t, diags.HasError(), diags)
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"peers": [
+		{"name": "Peer 1", "publicIp": "203.0.113.10", "secret": "first", "privateSubnets": ["192.168.10.0/24"], "ikeVersion": "2",
+		 "ipsecPolicies": {"ikeCipherAlgo": ["aes256"], "ikeAuthAlgo": ["sha256"], "ikeDiffieHellmanGroup": ["group14"], "ikeLifetime": 28800,
+		                   "childCipherAlgo": ["aes256"], "childAuthAlgo": ["sha256"], "childPfsGroup": ["disabled"], "childLifetime": 3600}},
+		{"name": "Peer 2", "publicIp": "203.0.113.10", "secret": "second", "privateSubnets": ["192.168.10.0/24"], "ikeVersion": "2"}
+	]}`, string(body))
+
+	// Test case: A preset is sent instead of the IPsec policies
+	peer := testPeer("Peer 1", "first", nil)
+	peer.IpsecPoliciesPreset = types.StringValue("aws")
+	data = testResourceModel(t, peer)
+	payload, diags = peersPayload(ctx, &data, keys)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, payload.Peers, 1)
+	assert.Equal(t, "aws", payload.Peers[0].GetIpsecPoliciesPreset())
+	assert.Nil(t, payload.Peers[0].IpsecPolicies)
+
+	// Test case: A secret encrypted with another key is an error
+	data = testResourceModel(t, testPeer("Peer 1", encrypted, nil))
+	_, diags = peersPayload(ctx, &data, utils.EncryptionKeys{Key: "other"})
+	assert.True(t, diags.HasError())
+}
+
+func TestReadPeers(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	encrypted, err := keys.Encrypt("first")
+	require.NoError(t, err)
+
+	data := testResourceModel(t, testPeer("Peer 1", encrypted, nil), testPeer("Peer 2", "second", nil))
+	response := map[string]interface{}{
+		"peers": []interface{}{
+			map[string]interface{}{
+				"name": "Peer 2", "publicIp": "203.0.113.20", "secret": "changed", "privateSubnets": []interface{}{"192.168.20.0/24"},
+				"ipsecPoliciesPreset": "custom", "ikeVersion": "1", "networkTags": []interface{}{"all"},
+				"ipsecPolicies": map[string]interface{}{
+					"ikeCipherAlgo": []interface{}{"aes128"}, "ikeAuthAlgo": []interface{}{"sha1"}, "ikeDiffieHellmanGroup": []interface{}{"group2"}, "ikeLifetime": 28800,
+					"childCipherAlgo": []interface{}{"aes128"}, "childAuthAlgo": []interface{}{"sha1"}, "childPfsGroup": []interface{}{"disabled"}, "childLifetime": 3600,
+				},
+			},
+			map[string]interface{}{
+				"name": "Peer 1", "publicIp": "203.0.113.10", "secret": "first", "privateSubnets": []interface{}{"192.168.10.0/24"},
+				"ipsecPoliciesPreset": "aws", "ikeVersion": "2", "networkTags": []interface{}{"all"},
+				"ipsecPolicies": map[string]interface{}{"ikeCipherAlgo": []interface{}{"aes128"}},
+			},
+		},
+	}
+	require.False(t, readPeers(ctx, keys, &data, response).HasError())
+
+	peers := testPeers(t, data)
+	assert.Equal(t, "123456", data.Id.ValueString())
+	require.Len(t, peers, 2)
+
+	// The peers keep the order of the Dashboard and the secrets are matched by name
+	assert.Equal(t, "Peer 2", peers[0].Name.ValueString())
+	assert.Equal(t, "changed", peers[0].Secret.ValueString(), "a secret changed in the Dashboard is read")
+	assert.Equal(t, encrypted, peers[1].Secret.ValueString(), "an unchanged encrypted secret is kept")
+
+	// Only custom IPsec policies are read
+	require.NotNil(t, peers[0].IpsecPolicies)
+	assert.Equal(t, int64(3600), peers[0].IpsecPolicies.ChildLifetime.ValueInt64())
+	assert.True(t, peers[0].IpsecPolicies.IkePrfAlgo.IsNull())
+	assert.Nil(t, peers[1].IpsecPolicies)
+	assert.Equal(t, "aws", peers[1].IpsecPoliciesPreset.ValueString())
+}
+
+func TestSealAndPreserveSecrets(t *testing.T) {
+	ctx := context.Background()
+	keys := utils.EncryptionKeys{Key: "key"}
+
+	state := testResourceModel(t, testPeer("Peer 1", "secret", nil))
+	require.False(t, sealSecrets(ctx, keys, &state).HasError())
+
+	sealed := testPeers(t, state)[0].Secret.ValueString()
+	assert.True(t, utils.IsEncrypted(sealed))
+
+	// Test case: An unchanged secret keeps its encrypted state value in the plan
+	plan := testResourceModel(t, testPeer("Other", "other", nil), testPeer("Peer 1", "secret", nil))
+	require.False(t, preserveSecrets(ctx, keys, &plan, &state).HasError())
+	assert.Equal(t, sealed, testPeers(t, plan)[1].Secret.ValueString())
+	assert.Equal(t, "other", testPeers(t, plan)[0].Secret.ValueString())
+
+	// Test case: A changed secret is planned in plaintext
+	plan = testResourceModel(t, testPeer("Peer 1", "changed", nil))
+	require.False(t, preserveSecrets(ctx, keys, &plan, &state).HasError())
+	assert.Equal(t, "changed", testPeers(t, plan)[0].Secret.ValueString())
+}
+
+func TestValidatePeers(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		peers []peerModel
+		err   string
+	}{
+		{
+			name:  "valid",
+			peers: []peerModel{testPeer("Peer 1", "secret", nil), testPeer("Peer 2", "secret", testIpsecPolicies())},
+		},
+		{
+			name:  "missing secret",
+			peers: []peerModel{testPeer("Peer 1", "", nil)},
+			err:   "Missing VPN Peer Secret",
+		},
+		{
+			name:  "duplicate name",
+			peers: []peerModel{testPeer("Peer 1", "secret", nil), testPeer("Peer 1", "secret", nil)},
+			err:   "Duplicate VPN Peer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testResourceModel(t, tt.peers...)
+
+			diags := validatePeers(ctx, &data)
+			if tt.err == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, tt.err, diags.Errors()[0].Summary())
+		})
+	}
+}
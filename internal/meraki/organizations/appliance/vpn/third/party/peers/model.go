@@ -0,0 +1,99 @@
+package peers
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceModel describes the data model of the third party VPN peers of an organization.
+type resourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Peers          types.List   `tfsdk:"peers"`
+}
+
+// peerModel describes a third party VPN peer. The secret is encrypted in state when the provider has an
+// encryption_key.
+type peerModel struct {
+	Name                types.String        `tfsdk:"name"`
+	PublicIp            types.String        `tfsdk:"public_ip"`
+	RemoteId            types.String        `tfsdk:"remote_id"`
+	LocalId             types.String        `tfsdk:"local_id"`
+	Secret              types.String        `tfsdk:"secret"`
+	PrivateSubnets      types.List          `tfsdk:"private_subnets"`
+	IpsecPolicies       *ipsecPoliciesModel `tfsdk:"ipsec_policies"`
+	IpsecPoliciesPreset types.String        `tfsdk:"ipsec_policies_preset"`
+	IkeVersion          types.String        `tfsdk:"ike_version"`
+	NetworkTags         types.Set           `tfsdk:"network_tags"`
+}
+
+type ipsecPoliciesModel struct {
+	IkeCipherAlgo         types.List  `tfsdk:"ike_cipher_algo"`
+	IkeAuthAlgo           types.List  `tfsdk:"ike_auth_algo"`
+	IkePrfAlgo            types.List  `tfsdk:"ike_prf_algo"`
+	IkeDiffieHellmanGroup types.List  `tfsdk:"ike_diffie_hellman_group"`
+	IkeLifetime           types.Int64 `tfsdk:"ike_lifetime"`
+	ChildCipherAlgo       types.List  `tfsdk:"child_cipher_algo"`
+	ChildAuthAlgo         types.List  `tfsdk:"child_auth_algo"`
+	ChildPfsGroup         types.List  `tfsdk:"child_pfs_group"`
+	ChildLifetime         types.Int64 `tfsdk:"child_lifetime"`
+}
+
+func ipsecPoliciesAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ike_cipher_algo":          types.ListType{ElemType: types.StringType},
+		"ike_auth_algo":            types.ListType{ElemType: types.StringType},
+		"ike_prf_algo":             types.ListType{ElemType: types.StringType},
+		"ike_diffie_hellman_group": types.ListType{ElemType: types.StringType},
+		"ike_lifetime":             types.Int64Type,
+		"child_cipher_algo":        types.ListType{ElemType: types.StringType},
+		"child_auth_algo":          types.ListType{ElemType: types.StringType},
+		"child_pfs_group":          types.ListType{ElemType: types.StringType},
+		"child_lifetime":           types.Int64Type,
+	}
+}
+
+func peerAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":                  types.StringType,
+		"public_ip":             types.StringType,
+		"remote_id":             types.StringType,
+		"local_id":              types.StringType,
+		"secret":                types.StringType,
+		"private_subnets":       types.ListType{ElemType: types.StringType},
+		"ipsec_policies":        types.ObjectType{AttrTypes: ipsecPoliciesAttrTypes()},
+		"ipsec_policies_preset": types.StringType,
+		"ike_version":           types.StringType,
+		"network_tags":          types.SetType{ElemType: types.StringType},
+	}
+}
+
+// apiPeers is the third party VPN peers of an organization in the format of the Dashboard API.
+type apiPeers struct {
+	Peers []apiPeer `json:"peers"`
+}
+
+type apiPeer struct {
+	Name                string            `json:"name"`
+	PublicIp            *string           `json:"publicIp,omitempty"`
+	RemoteId            *string           `json:"remoteId,omitempty"`
+	LocalId             *string           `json:"localId,omitempty"`
+	Secret              *string           `json:"secret,omitempty"`
+	PrivateSubnets      []string          `json:"privateSubnets"`
+	IpsecPolicies       *apiIpsecPolicies `json:"ipsecPolicies,omitempty"`
+	IpsecPoliciesPreset *string           `json:"ipsecPoliciesPreset,omitempty"`
+	IkeVersion          *string           `json:"ikeVersion,omitempty"`
+	NetworkTags         []string          `json:"networkTags,omitempty"`
+}
+
+type apiIpsecPolicies struct {
+	IkeCipherAlgo         []string `json:"ikeCipherAlgo"`
+	IkeAuthAlgo           []string `json:"ikeAuthAlgo"`
+	IkePrfAlgo            []string `json:"ikePrfAlgo,omitempty"`
+	IkeDiffieHellmanGroup []string `json:"ikeDiffieHellmanGroup"`
+	IkeLifetime           *int64   `json:"ikeLifetime,omitempty"`
+	ChildCipherAlgo       []string `json:"childCipherAlgo"`
+	ChildAuthAlgo         []string `json:"childAuthAlgo"`
+	ChildPfsGroup         []string `json:"childPfsGroup"`
+	ChildLifetime         *int64   `json:"childLifetime,omitempty"`
+}
@@ -0,0 +1,95 @@
+package peers_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccOrganizationsApplianceVpnThirdPartyPeersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create test Organization
+			{
+				Config: utils.CreateOrganizationConfig("test_acc_meraki_organizations_appliance_vpn_third_party_peers"),
+				Check:  utils.OrganizationTestChecks("test_acc_meraki_organizations_appliance_vpn_third_party_peers"),
+			},
+
+			// Create and Read Third Party VPN Peers
+			{
+				Config: OrganizationsApplianceVpnThirdPartyPeersResourceConfig("28800"),
+				Check: utils.ResourceTestCheck("meraki_organizations_appliance_vpn_third_party_peers.test", map[string]string{
+					"peers.#":                                  "2",
+					"peers.0.name":                             "Peer 1",
+					"peers.0.secret":                           "Sample Password",
+					"peers.0.ipsec_policies_preset":            "aws",
+					"peers.1.ipsec_policies.ike_lifetime":      "28800",
+					"peers.1.ipsec_policies.child_pfs_group.0": "disabled",
+					"peers.1.ike_version":                      "2",
+				}),
+			},
+
+			// Update and Read Third Party VPN Peers
+			{
+				Config: OrganizationsApplianceVpnThirdPartyPeersResourceConfig("14400"),
+				Check: utils.ResourceTestCheck("meraki_organizations_appliance_vpn_third_party_peers.test", map[string]string{
+					"peers.1.ipsec_policies.ike_lifetime": "14400",
+				}),
+			},
+
+			// Import State testing
+			{
+				ResourceName:      "meraki_organizations_appliance_vpn_third_party_peers.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// OrganizationsApplianceVpnThirdPartyPeersResourceConfig returns the configuration string for two third party VPN
+// peers, the second with custom IPsec policies of the given phase 1 lifetime
+func OrganizationsApplianceVpnThirdPartyPeersResourceConfig(ikeLifetime string) string {
+	return fmt.Sprintf(`
+	%s
+resource "meraki_organizations_appliance_vpn_third_party_peers" "test" {
+    organization_id = resource.meraki_organization.test.organization_id
+    peers = [
+        {
+            name = "Peer 1"
+            public_ip = "203.0.113.10"
+            secret = "Sample Password"
+            private_subnets = ["192.168.10.0/24"]
+            ipsec_policies_preset = "aws"
+        },
+        {
+            name = "Peer 2"
+            public_ip = "203.0.113.20"
+            secret = "Another Password"
+            private_subnets = ["192.168.20.0/24", "192.168.21.0/24"]
+            ike_version = "2"
+            ipsec_policies = {
+                ike_cipher_algo = ["aes256"]
+                ike_auth_algo = ["sha256"]
+                ike_diffie_hellman_group = ["group14"]
+                ike_lifetime = %s
+                child_cipher_algo = ["aes256", "aes128"]
+                child_auth_algo = ["sha256"]
+                child_pfs_group = ["disabled"]
+                child_lifetime = 3600
+            }
+        }
+    ]
+}
+`,
+		utils.CreateOrganizationConfig("test_acc_meraki_organizations_appliance_vpn_third_party_peers"),
+		ikeLifetime,
+	)
+}
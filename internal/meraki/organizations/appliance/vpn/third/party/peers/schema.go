@@ -0,0 +1,191 @@
+package peers
+
+import (
+	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the third party (non-Meraki) IPsec VPN peers of an organization. " +
+			"The peers replace any third party VPN peers configured in the Dashboard, and deleting this resource removes every peer. " +
+			"Peer secrets are encrypted in state when the provider has an `encryption_key`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peers": schema.ListNestedAttribute{
+				MarkdownDescription: "The third party VPN peers",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the VPN peer. Must be unique.",
+							Required:            true,
+						},
+						"public_ip": schema.StringAttribute{
+							MarkdownDescription: "The public IP of the VPN peer",
+							Optional:            true,
+						},
+						"remote_id": schema.StringAttribute{
+							MarkdownDescription: "The remote ID that identifies the connecting VPN peer: an IPv4 address, FQDN or user FQDN",
+							Optional:            true,
+						},
+						"local_id": schema.StringAttribute{
+							MarkdownDescription: "The local ID that identifies the MX to the peer. Applies to all MXs the peer connects with.",
+							Optional:            true,
+						},
+						"secret": schema.StringAttribute{
+							MarkdownDescription: "The shared secret with the VPN peer. Required.",
+							Optional:            true,
+							Computed:            true,
+							Sensitive:           true,
+							PlanModifiers: []planmodifier.String{
+								utils.NewSensitivePlanModifier(),
+							},
+						},
+						"private_subnets": schema.ListAttribute{
+							MarkdownDescription: "The private subnets of the VPN peer",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"ipsec_policies": schema.SingleNestedAttribute{
+							MarkdownDescription: "Custom IPsec policies of the VPN peer. Conflicts with `ipsec_policies_preset`.",
+							Optional:            true,
+							Validators: []validator.Object{
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ipsec_policies_preset")),
+							},
+							Attributes: map[string]schema.Attribute{
+								"ike_cipher_algo": schema.ListAttribute{
+									MarkdownDescription: "The phase 1 cipher algorithm: 'aes256', 'aes192', 'aes128', 'tripledes' or 'des'",
+									ElementType:         types.StringType,
+									Required:            true,
+									Validators: []validator.List{
+										listvalidator.SizeBetween(1, 1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf("aes256", "aes192", "aes128", "tripledes", "des")),
+									},
+								},
+								"ike_auth_algo": schema.ListAttribute{
+									MarkdownDescription: "The phase 1 authentication algorithm: 'sha256', 'sha1' or 'md5'",
+									ElementType:         types.StringType,
+									Required:            true,
+									Validators: []validator.List{
+										listvalidator.SizeBetween(1, 1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf("sha256", "sha1", "md5")),
+									},
+								},
+								"ike_prf_algo": schema.ListAttribute{
+									MarkdownDescription: "The IKE_SA pseudo-random function: 'prfsha256', 'prfsha1', 'prfmd5', or 'default' to use the authentication algorithm",
+									ElementType:         types.StringType,
+									Optional:            true,
+									Computed:            true,
+									Validators: []validator.List{
+										listvalidator.SizeBetween(1, 1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf("prfsha256", "prfsha1", "prfmd5", "default")),
+									},
+								},
+								"ike_diffie_hellman_group": schema.ListAttribute{
+									MarkdownDescription: "The phase 1 Diffie-Hellman group: 'group14', 'group5', 'group2' or 'group1'",
+									ElementType:         types.StringType,
+									Required:            true,
+									Validators: []validator.List{
+										listvalidator.SizeBetween(1, 1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf("group14", "group5", "group2", "group1")),
+									},
+								},
+								"ike_lifetime": schema.Int64Attribute{
+									MarkdownDescription: "The lifetime of the phase 1 SA in seconds",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"child_cipher_algo": schema.ListAttribute{
+									MarkdownDescription: "The phase 2 cipher algorithms: 'aes256', 'aes192', 'aes128', 'tripledes', 'des' or 'null'",
+									ElementType:         types.StringType,
+									Required:            true,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf("aes256", "aes192", "aes128", "tripledes", "des", "null")),
+									},
+								},
+								"child_auth_algo": schema.ListAttribute{
+									MarkdownDescription: "The phase 2 authentication algorithm: 'sha256', 'sha1' or 'md5'",
+									ElementType:         types.StringType,
+									Required:            true,
+									Validators: []validator.List{
+										listvalidator.SizeBetween(1, 1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf("sha256", "sha1", "md5")),
+									},
+								},
+								"child_pfs_group": schema.ListAttribute{
+									MarkdownDescription: "The phase 2 Diffie-Hellman group for Perfect Forward Secrecy: 'disabled', 'group14', 'group5', 'group2' or 'group1'",
+									ElementType:         types.StringType,
+									Required:            true,
+									Validators: []validator.List{
+										listvalidator.SizeBetween(1, 1),
+										listvalidator.ValueStringsAre(stringvalidator.OneOf("disabled", "group14", "group5", "group2", "group1")),
+									},
+								},
+								"child_lifetime": schema.Int64Attribute{
+									MarkdownDescription: "The lifetime of the phase 2 SA in seconds",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+						"ipsec_policies_preset": schema.StringAttribute{
+							MarkdownDescription: "The IPsec policies preset: 'default', 'aws' or 'azure'. The Dashboard reports 'custom' for peers with `ipsec_policies`.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("default", "aws", "azure"),
+							},
+						},
+						"ike_version": schema.StringAttribute{
+							MarkdownDescription: "The IKE version: '1' or '2'",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("1", "2"),
+							},
+						},
+						"network_tags": schema.SetAttribute{
+							MarkdownDescription: "The tags of the networks that connect with the peer. `[\"all\"]` for all networks, `[\"none\"]` for no networks. Defaults to all networks.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,196 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DataSource{}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource defines the organization site-to-site VPN topology data source implementation.
+type DataSource struct {
+	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations_appliance_vpn_topology"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the site-to-site VPN mode and hubs of every appliance network of an organization as a graph of spokes and hubs. " +
+			"`issues` lists the hubs that cannot carry the traffic of their spokes, to validate the topology with a precondition.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID",
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID",
+				Required:            true,
+			},
+			"networks": schema.ListNestedAttribute{
+				MarkdownDescription: "The appliance networks of the organization",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network_id": schema.StringAttribute{
+							MarkdownDescription: "Network ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the network",
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							MarkdownDescription: "The site-to-site VPN mode: 'hub', 'spoke' or 'none'",
+							Computed:            true,
+						},
+						"hubs": schema.ListNestedAttribute{
+							MarkdownDescription: "The VPN hubs of the network, in order of preference",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"hub_id": schema.StringAttribute{
+										MarkdownDescription: "The network ID of the hub",
+										Computed:            true,
+									},
+									"use_default_route": schema.BoolAttribute{
+										MarkdownDescription: "Whether default route traffic is sent to the hub",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				MarkdownDescription: "The connections of the networks to their hubs",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"spoke_network_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the network that uses the hub",
+							Computed:            true,
+						},
+						"hub_network_id": schema.StringAttribute{
+							MarkdownDescription: "The network ID of the hub",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The preference of the hub for the network, starting at 1",
+							Computed:            true,
+						},
+						"use_default_route": schema.BoolAttribute{
+							MarkdownDescription: "Whether default route traffic is sent to the hub",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"hub_network_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the networks in hub mode",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"issues": schema.ListAttribute{
+				MarkdownDescription: "Problems found in the topology: spokes without hubs, and hubs that are repeated, missing from the organization or not in hub mode",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.retry = providerData.Retry
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *dataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Follow the next page links until every network has been read
+	inlineResp, httpResp, err := utils.PaginateAll(ctx, utils.PaginationOptions{}, func(ctx context.Context, startingAfter string) ([]openApiClient.GetNetwork200Response, *http.Response, error) {
+		return utils.CustomHttpRequestRetry(ctx, d.retry, func() ([]openApiClient.GetNetwork200Response, *http.Response, error) {
+			request := d.client.OrganizationsApi.GetOrganizationNetworks(ctx, data.OrganizationId.ValueString()).PerPage(1000)
+			if startingAfter != "" {
+				request = request.StartingAfter(startingAfter)
+			}
+			return request.Execute()
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+		return
+	}
+
+	// Only appliance networks have site-to-site VPN settings
+	var networks []topologyNetwork
+	for _, network := range inlineResp {
+		if !slices.Contains(network.ProductTypes, "appliance") {
+			continue
+		}
+
+		vpnResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, d.retry, func() (*openApiClient.GetNetworkApplianceVpnSiteToSiteVpn200Response, *http.Response, error) {
+			return d.client.ApplianceApi.GetNetworkApplianceVpnSiteToSiteVpn(ctx, network.GetId()).Execute()
+		})
+		if err != nil {
+			resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Read Failure", httpResp, err))
+			return
+		}
+
+		topologyNetwork := topologyNetwork{Id: network.GetId(), Name: network.GetName()}
+		if err := utils.ConvertJSON(vpnResp, &topologyNetwork.Vpn); err != nil {
+			resp.Diagnostics.AddError("Data Source Response Error", fmt.Sprintf("Could not read the site-to-site VPN settings of network %s: %s", network.GetId(), err))
+			return
+		}
+		networks = append(networks, topologyNetwork)
+	}
+
+	resp.Diagnostics.Append(readTopology(ctx, data, networks)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read data source")
+}
@@ -0,0 +1,50 @@
+package topology_test
+
+import (
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccOrganizationsApplianceVpnTopologyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.TestAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+
+			// Create and Read Network
+			{
+				Config: utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_organizations_appliance_vpn_topology"),
+				Check:  utils.NetworkOrgIdTestChecks("test_acc_organizations_appliance_vpn_topology"),
+			},
+
+			// Read VPN Topology
+			{
+				Config: OrganizationsApplianceVpnTopologyDataSourceConfigRead(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meraki_organizations_appliance_vpn_topology.test", "id", os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID")),
+					resource.TestCheckResourceAttrSet("data.meraki_organizations_appliance_vpn_topology.test", "networks.0.network_id"),
+					resource.TestCheckResourceAttrSet("data.meraki_organizations_appliance_vpn_topology.test", "networks.0.mode"),
+					resource.TestCheckResourceAttrSet("data.meraki_organizations_appliance_vpn_topology.test", "issues.#"),
+				),
+			},
+		},
+	})
+}
+
+func OrganizationsApplianceVpnTopologyDataSourceConfigRead() string {
+	return fmt.Sprintf(`
+	%s
+
+data "meraki_organizations_appliance_vpn_topology" "test" {
+	organization_id = resource.meraki_network.test.organization_id
+}
+	`,
+		utils.CreateNetworkOrgIdConfig(os.Getenv("TF_ACC_MERAKI_ORGANIZATION_ID"), "test_acc_organizations_appliance_vpn_topology"),
+	)
+}
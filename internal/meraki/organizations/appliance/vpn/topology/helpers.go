@@ -0,0 +1,90 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	hubMode   = "hub"
+	spokeMode = "spoke"
+)
+
+// readTopology sets data from the site-to-site VPN settings of the appliance networks of an organization. Every hub
+// of a spoke becomes an edge of the graph, and the hubs that cannot carry the traffic of their spokes are reported as
+// issues, so that configurations can check the topology with a precondition.
+func readTopology(ctx context.Context, data *dataSourceModel, networks []topologyNetwork) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	modes := map[string]string{}
+	names := map[string]string{}
+	for _, network := range networks {
+		modes[network.Id] = network.Vpn.Mode
+		names[network.Id] = network.Name
+	}
+
+	networkList := []networkModel{}
+	edges := []edgeModel{}
+	hubIds := []string{}
+	issues := []string{}
+
+	for _, network := range networks {
+		model := networkModel{
+			NetworkId: types.StringValue(network.Id),
+			Name:      types.StringValue(network.Name),
+			Mode:      types.StringValue(network.Vpn.Mode),
+			Hubs:      []hubModel{},
+		}
+		if network.Vpn.Mode == hubMode {
+			hubIds = append(hubIds, network.Id)
+		}
+		if network.Vpn.Mode == spokeMode && len(network.Vpn.Hubs) == 0 {
+			issues = append(issues, fmt.Sprintf("Spoke network %q (%s) has no hubs", network.Name, network.Id))
+		}
+
+		seen := map[string]bool{}
+		for i, hub := range network.Vpn.Hubs {
+			model.Hubs = append(model.Hubs, hubModel{
+				HubId:           types.StringValue(hub.HubId),
+				UseDefaultRoute: types.BoolValue(hub.UseDefaultRoute),
+			})
+			edges = append(edges, edgeModel{
+				SpokeNetworkId:  types.StringValue(network.Id),
+				HubNetworkId:    types.StringValue(hub.HubId),
+				Priority:        types.Int64Value(int64(i + 1)),
+				UseDefaultRoute: types.BoolValue(hub.UseDefaultRoute),
+			})
+
+			mode, ok := modes[hub.HubId]
+			switch {
+			case seen[hub.HubId]:
+				issues = append(issues, fmt.Sprintf("Network %q (%s) lists hub %s more than once", network.Name, network.Id, hub.HubId))
+			case hub.HubId == network.Id:
+				issues = append(issues, fmt.Sprintf("Network %q (%s) lists itself as a hub", network.Name, network.Id))
+			case !ok:
+				issues = append(issues, fmt.Sprintf("Network %q (%s) uses %s as a hub, which is not an appliance network of the organization", network.Name, network.Id, hub.HubId))
+			case mode != hubMode:
+				issues = append(issues, fmt.Sprintf("Network %q (%s) uses %q (%s) as a hub, but its VPN mode is %q", network.Name, network.Id, names[hub.HubId], hub.HubId, mode))
+			}
+			seen[hub.HubId] = true
+		}
+
+		networkList = append(networkList, model)
+	}
+
+	data.Id = data.OrganizationId
+
+	var listDiags diag.Diagnostics
+	data.Networks, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: networkAttrTypes()}, networkList)
+	diags.Append(listDiags...)
+	data.Edges, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: edgeAttrTypes()}, edges)
+	diags.Append(listDiags...)
+	data.HubNetworkIds, listDiags = types.ListValueFrom(ctx, types.StringType, hubIds)
+	diags.Append(listDiags...)
+	data.Issues, listDiags = types.ListValueFrom(ctx, types.StringType, issues)
+	diags.Append(listDiags...)
+
+	return diags
+}
@@ -0,0 +1,60 @@
+package topology
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testNetwork(id, name, mode string, hubIds ...string) topologyNetwork {
+	network := topologyNetwork{Id: id, Name: name, Vpn: apiSiteToSiteVpn{Mode: mode}}
+	for _, hubId := range hubIds {
+		network.Vpn.Hubs = append(network.Vpn.Hubs, struct {
+			HubId           string `json:"hubId"`
+			UseDefaultRoute bool   `json:"useDefaultRoute"`
+		}{HubId: hubId})
+	}
+	return network
+}
+
+func TestReadTopology(t *testing.T) {
+	ctx := context.Background()
+
+	data := dataSourceModel{OrganizationId: types.StringValue("123456")}
+	networks := []topologyNetwork{
+		testNetwork("N_1", "Hub", "hub"),
+		testNetwork("N_2", "Branch", "spoke", "N_1", "N_3"),
+		testNetwork("N_3", "Standalone", "none"),
+		testNetwork("N_4", "Orphan", "spoke"),
+		testNetwork("N_5", "Remote", "spoke", "N_1", "N_1", "N_9"),
+	}
+	require.False(t, readTopology(ctx, &data, networks).HasError())
+
+	assert.Equal(t, "123456", data.Id.ValueString())
+	assert.Len(t, data.Networks.Elements(), 5)
+
+	var edges []edgeModel
+	require.False(t, data.Edges.ElementsAs(ctx, &edges, false).HasError())
+	require.Len(t, edges, 5)
+	assert.Equal(t, edgeModel{
+		SpokeNetworkId:  types.StringValue("N_2"),
+		HubNetworkId:    types.StringValue("N_3"),
+		Priority:        types.Int64Value(2),
+		UseDefaultRoute: types.BoolValue(false),
+	}, edges[1])
+
+	var hubIds []string
+	require.False(t, data.HubNetworkIds.ElementsAs(ctx, &hubIds, false).HasError())
+	assert.Equal(t, []string{"N_1"}, hubIds)
+
+	var issues []string
+	require.False(t, data.Issues.ElementsAs(ctx, &issues, false).HasError())
+	assert.Equal(t, []string{
+		`Network "Branch" (N_2) uses "Standalone" (N_3) as a hub, but its VPN mode is "none"`,
+		`Spoke network "Orphan" (N_4) has no hubs`,
+		`Network "Remote" (N_5) lists hub N_1 more than once`,
+		`Network "Remote" (N_5) uses N_9 as a hub, which is not an appliance network of the organization`,
+	}, issues)
+}
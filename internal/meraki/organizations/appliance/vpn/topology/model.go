@@ -0,0 +1,76 @@
+package topology
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dataSourceModel describes the data model of the site-to-site VPN topology of an organization.
+type dataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Networks       types.List   `tfsdk:"networks"`
+	Edges          types.List   `tfsdk:"edges"`
+	HubNetworkIds  types.List   `tfsdk:"hub_network_ids"`
+	Issues         types.List   `tfsdk:"issues"`
+}
+
+type networkModel struct {
+	NetworkId types.String `tfsdk:"network_id"`
+	Name      types.String `tfsdk:"name"`
+	Mode      types.String `tfsdk:"mode"`
+	Hubs      []hubModel   `tfsdk:"hubs"`
+}
+
+type hubModel struct {
+	HubId           types.String `tfsdk:"hub_id"`
+	UseDefaultRoute types.Bool   `tfsdk:"use_default_route"`
+}
+
+type edgeModel struct {
+	SpokeNetworkId  types.String `tfsdk:"spoke_network_id"`
+	HubNetworkId    types.String `tfsdk:"hub_network_id"`
+	Priority        types.Int64  `tfsdk:"priority"`
+	UseDefaultRoute types.Bool   `tfsdk:"use_default_route"`
+}
+
+func hubAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"hub_id":            types.StringType,
+		"use_default_route": types.BoolType,
+	}
+}
+
+func networkAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"network_id": types.StringType,
+		"name":       types.StringType,
+		"mode":       types.StringType,
+		"hubs":       types.ListType{ElemType: types.ObjectType{AttrTypes: hubAttrTypes()}},
+	}
+}
+
+func edgeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"spoke_network_id":  types.StringType,
+		"hub_network_id":    types.StringType,
+		"priority":          types.Int64Type,
+		"use_default_route": types.BoolType,
+	}
+}
+
+// topologyNetwork is an appliance network of an organization with its site-to-site VPN settings.
+type topologyNetwork struct {
+	Id   string
+	Name string
+	Vpn  apiSiteToSiteVpn
+}
+
+// apiSiteToSiteVpn is the site-to-site VPN settings of a network in the format of the Dashboard API.
+type apiSiteToSiteVpn struct {
+	Mode string `json:"mode"`
+	Hubs []struct {
+		HubId           string `json:"hubId"`
+		UseDefaultRoute bool   `json:"useDefaultRoute"`
+	} `json:"hubs"`
+}
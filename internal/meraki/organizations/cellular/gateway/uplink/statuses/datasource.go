@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
 )
 
 var _ datasource.DataSource = &DataSource{}
@@ -19,6 +20,7 @@ func NewDataSource() datasource.DataSource {
 
 type DataSource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 // Metadata provides a way to define information about the data source.
@@ -38,20 +40,21 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// This allows the data source to use the configured provider for any API calls it needs to make.
-	d.client = client
+	d.client = providerData.Client
+	d.retry = providerData.Retry
 }
 
 // Read method is responsible for reading an existing data source's state.
@@ -66,73 +69,71 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	request := d.client.CellularGatewayApi.GetOrganizationCellularGatewayUplinkStatuses(context.Background(), data.OrganizationId.ValueString())
-
-	if !data.PerPage.IsUnknown() {
-		request.PerPage(int32(data.PerPage.ValueInt64()))
-	}
-	if !data.StartingAfter.IsUnknown() {
-		request.StartingAfter(data.StartingAfter.ValueString())
-	}
-	if !data.EndingBefore.IsUnknown() {
-		request.EndingBefore(data.EndingBefore.ValueString())
+	var serials []string
+	for _, serial := range data.Serials {
+		serials = append(serials, serial.ValueString())
 	}
 
-	if len(data.Serials) > 0 {
-		var serials []string
-		for _, serial := range data.Serials {
-			serials = append(serials, serial.String())
-		}
-		request.Serials(serials)
+	var networkIds []string
+	for _, networkId := range data.NetworkIds {
+		networkIds = append(networkIds, networkId.ValueString())
 	}
 
-	if len(data.NetworkIds) > 0 {
-		var networkIds []string
-		for _, networkId := range data.NetworkIds {
-			networkIds = append(networkIds, networkId.String())
-		}
-		request.NetworkIds(networkIds)
+	var iccids []string
+	for _, iccid := range data.Iccids {
+		iccids = append(iccids, iccid.ValueString())
 	}
 
-	if len(data.Iccids) > 0 {
-		var iccids []string
-		for _, iccid := range data.Iccids {
-			iccids = append(iccids, iccid.String())
-		}
-		request.Iccids(iccids)
+	fetch := func(ctx context.Context, startingAfter string) ([]dataSourceModelList, *http.Response, error) {
+		return utils.CustomHttpRequestRetry(ctx, d.retry, func() ([]dataSourceModelList, *http.Response, error) {
+			request := d.client.CellularGatewayApi.GetOrganizationCellularGatewayUplinkStatuses(ctx, data.OrganizationId.ValueString())
+
+			if !data.PerPage.IsNull() && !data.PerPage.IsUnknown() {
+				request = request.PerPage(int32(data.PerPage.ValueInt64()))
+			}
+			if startingAfter != "" {
+				request = request.StartingAfter(startingAfter)
+			} else if !data.StartingAfter.IsNull() && !data.StartingAfter.IsUnknown() {
+				request = request.StartingAfter(data.StartingAfter.ValueString())
+			}
+			if !data.EndingBefore.IsNull() && !data.EndingBefore.IsUnknown() {
+				request = request.EndingBefore(data.EndingBefore.ValueString())
+			}
+			if len(serials) > 0 {
+				request = request.Serials(serials)
+			}
+			if len(networkIds) > 0 {
+				request = request.NetworkIds(networkIds)
+			}
+			if len(iccids) > 0 {
+				request = request.Iccids(iccids)
+			}
+
+			_, httpResp, err := request.Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+
+			var page []dataSourceModelList
+			if err = json.NewDecoder(httpResp.Body).Decode(&page); err != nil {
+				return nil, httpResp, fmt.Errorf("JSON decoding error: %w", err)
+			}
+			return page, httpResp, nil
+		})
 	}
 
-	_, httpResp, err := request.Execute()
+	// Follow the next page links until every status has been read. The deprecated ending_before selects a single page.
+	var httpResp *http.Response
+	var err error
+	if !data.EndingBefore.IsNull() && !data.EndingBefore.IsUnknown() {
+		data.List, httpResp, err = fetch(ctx, "")
+	} else {
+		data.List, httpResp, err = utils.PaginateAll(ctx, utils.PaginationOptions{}, fetch)
+	}
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
-		return
-	}
-
-	// If it's not what you expect, add an error to diagnostics.
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			fmt.Sprintf("%v", httpResp.StatusCode),
-		)
-	}
-
-	// If there were any errors up to this point, log the state data and return.
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("State Data", fmt.Sprintf("\n%v", data))
-		return
-	}
-
-	// save into the Terraform state.
-	if err = json.NewDecoder(httpResp.Body).Decode(&data.List); err != nil {
-		resp.Diagnostics.AddError(
-			"JSON decoding error",
-			fmt.Sprintf("%v\n", err.Error()),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -2,16 +2,23 @@ package claim
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"time"
 )
 
 // The below var block ensures that the provider defined types fully satisfy the required
@@ -20,8 +27,9 @@ import (
 
 // OrganizationsClaimResource struct.
 var (
-	_ resource.Resource              = &Resource{} // Terraform resource interface
-	_ resource.ResourceWithConfigure = &Resource{} // Interface for resources with configuration methods
+	_ resource.Resource                   = &Resource{} // Terraform resource interface
+	_ resource.ResourceWithConfigure      = &Resource{} // Interface for resources with configuration methods
+	_ resource.ResourceWithValidateConfig = &Resource{} // Interface for resources with plan time validation
 )
 
 func NewResource() resource.Resource {
@@ -30,6 +38,7 @@ func NewResource() resource.Resource {
 
 type Resource struct {
 	client *openApiClient.APIClient // APIClient instance for making API requests
+	retry  utils.RetryPolicy
 }
 
 type resourceModel struct {
@@ -41,6 +50,8 @@ type resourceModel struct {
 	Orders         []jsontypes.String     `tfsdk:"orders"`
 	Serials        []jsontypes.String     `tfsdk:"serials"`
 	Licences       []resourceModelLicence `tfsdk:"licences"`
+	Triggers       types.Map              `tfsdk:"triggers"`
+	ExecutedAt     types.String           `tfsdk:"executed_at"`
 }
 
 type resourceModelLicence struct {
@@ -65,7 +76,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 
 		// It should provide a clear and concise description of the resource.
-		MarkdownDescription: "Claim a list of devices, licenses, and/or orders into an organization. When claiming by order, all devices and licenses in the order will be claimed; licenses will be added to the organization and devices will be placed in the organization's inventory.",
+		MarkdownDescription: "Claim a list of devices, licenses, and/or orders into an organization. When claiming by order, all devices and licenses in the order will be claimed; licenses will be added to the organization and devices will be placed in the organization's inventory. " +
+			"Orders, serials and licences added to the resource are claimed on update, serials removed from it are released, and everything is claimed again whenever `triggers` change.",
 
 		// The Attributes map describes the fields of the resource.
 		Attributes: map[string]schema.Attribute{
@@ -74,25 +86,32 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"id": schema.StringAttribute{
 				Computed:   true,
 				CustomType: jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"organization_id": schema.StringAttribute{
 				Required:            true,
 				CustomType:          jsontypes.StringType,
 				MarkdownDescription: "Organization ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"orders": schema.SetAttribute{
 				MarkdownDescription: "The numbers of the orders that should be claimed",
 				ElementType:         jsontypes.StringType,
 				CustomType:          jsontypes.SetType[jsontypes.String](),
-				Computed:            true,
 				Optional:            true,
 			},
 			"serials": schema.SetAttribute{
 				MarkdownDescription: "The serials of the devices that should be claimed",
 				ElementType:         jsontypes.StringType,
 				CustomType:          jsontypes.SetType[jsontypes.String](),
-				Computed:            true,
 				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(utils.SerialValidator()),
+				},
 			},
 			"licences": schema.ListNestedAttribute{
 				MarkdownDescription: "The licenses that should be claimed",
@@ -109,7 +128,6 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 								"All licenses must be claimed with the same mode, and at most one renewal can be claimed at a time. " +
 								"This parameter is legacy and does not apply to organizations with per-device licensing enabled.",
 							Optional:   true,
-							Computed:   true,
 							CustomType: jsontypes.StringType,
 							Validators: []validator.String{
 								stringvalidator.OneOf("addDevices", "renew"),
@@ -117,9 +135,18 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 						},
 					},
 				},
-				Computed: true,
 				Optional: true,
 			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, claims the orders, serials and licences again. " +
+					"Devices are not released before they are claimed again.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"executed_at": schema.StringAttribute{
+				MarkdownDescription: "When the orders, serials and licences were last claimed, in RFC3339 format",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -134,104 +161,76 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
 	// This allows the resource to use the configured provider for any API calls it needs to make.
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
-// Create method is responsible for creating a new resource.
-// It takes a CreateRequest containing the planned state of the new resource and returns a CreateResponse
-// with the final state of the new resource or an error.
-func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *resourceModel
+// ValidateConfig checks that there is something to claim, so that an empty claim fails at plan time instead of
+// being rejected by the Dashboard API.
+func (r *Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var orders, serials jsontypes.Set[jsontypes.String]
+	var licences types.List
 
-	// Unmarshal the plan data into the internal data model struct.
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("orders"), &orders)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("serials"), &serials)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("licences"), &licences)...)
 
-	// Check if there are any errors before proceeding.
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	claimIntoOrganizationInventoryRequest := *openApiClient.NewClaimIntoOrganizationInventoryRequest()
-
-	// orders
-	var orders []string
-	for _, order := range data.Orders {
-		orders = append(orders, order.ValueString())
-	}
-
-	// serials
-	var serials []string
-	for _, serial := range data.Serials {
-		serials = append(serials, serial.ValueString())
-	}
-
-	// licenses
-	var licenses []openApiClient.ClaimIntoOrganizationInventoryRequestLicensesInner
-	for _, license := range data.Licences {
-		var licenseMap openApiClient.ClaimIntoOrganizationInventoryRequestLicensesInner
-		licenseMap.SetKey(license.Key.ValueString())
-		licenseMap.SetMode(license.Mode.ValueString())
-		licenses = append(licenses, licenseMap)
+	// Values from other resources are only known during apply
+	if orders.IsUnknown() || serials.IsUnknown() || licences.IsUnknown() {
+		return
 	}
 
-	claimIntoOrganizationInventoryRequest.SetSerials(orders)
-	claimIntoOrganizationInventoryRequest.SetLicenses(licenses)
-	claimIntoOrganizationInventoryRequest.SetSerials(serials)
-
-	// Remember to handle any potential errors.
-	_, httpResp, err := r.client.ConfigureApi.ClaimIntoOrganizationInventory(ctx,
-		data.OrganizationId.ValueString()).ClaimIntoOrganizationInventoryRequest(claimIntoOrganizationInventoryRequest).Execute()
-
-	// If there was an error during API call, add it to diagnostics.
-	if err != nil {
-
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
+	if len(orders.Elements())+len(serials.Elements())+len(licences.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("serials"),
+			"Nothing To Claim",
+			"At least one order, serial or licence must be set.",
 		)
-		return
 	}
+}
 
-	// If it's not what you expect, add an error to diagnostics.
-	if httpResp.StatusCode != 200 {
+// Create method is responsible for creating a new resource.
+// It takes a CreateRequest containing the planned state of the new resource and returns a CreateResponse
+// with the final state of the new resource or an error.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *resourceModel
 
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			fmt.Sprintf("%v", httpResp.StatusCode),
-		)
-	}
+	// Unmarshal the plan data into the internal data model struct.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	// If there were any errors up to this point, log the plan data and return.
+	// Check if there are any errors before proceeding.
 	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("Plan Data", fmt.Sprintf("\n%v", data))
 		return
 	}
 
-	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(data); err != nil {
-		resp.Diagnostics.AddError(
-			"JSON decoding error",
-			fmt.Sprintf("%v\n", err.Error()),
-		)
+	resp.Diagnostics.Append(r.claim(ctx, data.OrganizationId.ValueString(), data.Orders, data.Serials, data.Licences)...)
+
+	// If there were any errors up to this point, return.
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set ID for the new resource.
 	data.Id = jsontypes.StringValue(data.OrganizationId.ValueString())
+	data.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 
 	// Now set the final state of the resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -253,27 +252,19 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	inlineRespSerials, httpResp, err := r.client.OrganizationsApi.GetOrganizationDevices(ctx, data.OrganizationId.ValueString()).Execute()
+	// Follow the next page links until every device of the organization has been read
+	inlineRespSerials, httpResp, err := utils.PaginateAll(ctx, utils.PaginationOptions{}, func(ctx context.Context, startingAfter string) ([]openApiClient.GetNetworkFloorPlans200ResponseInnerDevicesInner, *http.Response, error) {
+		return utils.CustomHttpRequestRetry(ctx, r.retry, func() ([]openApiClient.GetNetworkFloorPlans200ResponseInnerDevicesInner, *http.Response, error) {
+			request := r.client.OrganizationsApi.GetOrganizationDevices(ctx, data.OrganizationId.ValueString()).PerPage(1000)
+			if startingAfter != "" {
+				request = request.StartingAfter(startingAfter)
+			}
+			return request.Execute()
+		})
+	})
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
-		return
-	}
-
-	// If it's not what you expect, add an error to diagnostics.
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			fmt.Sprintf("%v", httpResp.StatusCode),
-		)
-	}
-
-	// If there were any errors up to this point, log the plan data and return.
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("Plan Data", fmt.Sprintf("\n%v", data))
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -310,19 +301,52 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 }
 
 // Update function is responsible for updating the state of an existing resource.
-// It uses an UpdateRequest and responds with an UpdateResponse which contains the updated state of the resource or an error.
+// Orders, serials and licences that were added are claimed and serials that were removed are released. When the
+// triggers change, everything in the plan is claimed again.
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *resourceModel
+	var plan, state *resourceModel
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	// If there was an error reading the plan, return early.
+	// If there was an error reading the plan or state, return early.
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	organizationId := plan.OrganizationId.ValueString()
+
+	// Release the serials that were removed from the resource.
+	if removed := difference(state.Serials, plan.Serials); len(removed) > 0 {
+		resp.Diagnostics.Append(r.release(ctx, organizationId, removed)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	orders, serials, licences := plan.Orders, plan.Serials, plan.Licences
+	if plan.Triggers.Equal(state.Triggers) {
+		orders = difference(plan.Orders, state.Orders)
+		serials = difference(plan.Serials, state.Serials)
+		licences = nil
+		for _, licence := range plan.Licences {
+			if !containsLicence(state.Licences, licence) {
+				licences = append(licences, licence)
+			}
+		}
+	}
+
+	plan.ExecutedAt = state.ExecutedAt
+	if len(orders)+len(serials)+len(licences) > 0 {
+		resp.Diagnostics.Append(r.claim(ctx, organizationId, orders, serials, licences)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
 	// Now set the updated state of the resource.
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
 	// Log that the resource was updated.
 	tflog.Trace(ctx, "updated resource")
@@ -336,42 +360,130 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	// serials
-	var serials []string
-	for _, serial := range data.Serials {
-		serials = append(serials, serial.ValueString())
+	// If there was an error reading the state, return early.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Serials) > 0 {
+		resp.Diagnostics.Append(r.release(ctx, data.OrganizationId.ValueString(), data.Serials)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	releaseFromOrganizationInventoryRequest := *openApiClient.NewReleaseFromOrganizationInventoryRequest() // ReleaseFromOrganizationInventoryRequest |  (optional)
-	releaseFromOrganizationInventoryRequest.SetSerials(serials)
+	resp.State.RemoveResource(ctx)
 
-	_, httpResp, err := r.client.ConfigureApi.ReleaseFromOrganizationInventory(ctx, data.OrganizationId.ValueString()).ReleaseFromOrganizationInventoryRequest(releaseFromOrganizationInventoryRequest).Execute()
+	// Log that the resource was deleted.
+	tflog.Trace(ctx, "removed resource")
+}
 
-	// If there was an error during API call, add it to diagnostics.
+// claim claims the given orders, serials and licences into the organization.
+func (r *Resource) claim(ctx context.Context, organizationId string, orders, serials []jsontypes.String, licences []resourceModelLicence) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	claimIntoOrganizationInventoryRequest := *openApiClient.NewClaimIntoOrganizationInventoryRequest()
+
+	if len(orders) > 0 {
+		claimIntoOrganizationInventoryRequest.SetOrders(values(orders))
+	}
+	if len(serials) > 0 {
+		claimIntoOrganizationInventoryRequest.SetSerials(values(serials))
+	}
+	if len(licences) > 0 {
+		var licenses []openApiClient.ClaimIntoOrganizationInventoryRequestLicensesInner
+		for _, licence := range licences {
+			license := *openApiClient.NewClaimIntoOrganizationInventoryRequestLicensesInner(licence.Key.ValueString())
+			if !licence.Mode.IsNull() && !licence.Mode.IsUnknown() {
+				license.SetMode(licence.Mode.ValueString())
+			}
+			licenses = append(licenses, license)
+		}
+		claimIntoOrganizationInventoryRequest.SetLicenses(licenses)
+	}
+
+	apiCall := func() (*openApiClient.ClaimIntoOrganization200Response, *http.Response, error) {
+		return r.client.ConfigureApi.ClaimIntoOrganizationInventory(ctx, organizationId).ClaimIntoOrganizationInventoryRequest(claimIntoOrganizationInventoryRequest).Execute()
+	}
+
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
+		diags.Append(utils.NewAPIErrorDiagnostic("Failed to claim into organization", httpResp, err))
+		return diags
+	}
+
+	// If it's not what you expect, add an error to diagnostics.
+	if httpResp.StatusCode != 200 {
+		diags.AddError(
+			"Unexpected HTTP Response Status Code",
+			fmt.Sprintf("%v", httpResp.StatusCode),
 		)
-		return
+	}
+
+	return diags
+}
+
+// release releases the given serials from the organization inventory.
+func (r *Resource) release(ctx context.Context, organizationId string, serials []jsontypes.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	releaseFromOrganizationInventoryRequest := *openApiClient.NewReleaseFromOrganizationInventoryRequest()
+	releaseFromOrganizationInventoryRequest.SetSerials(values(serials))
+
+	apiCall := func() (map[string]interface{}, *http.Response, error) {
+		return r.client.ConfigureApi.ReleaseFromOrganizationInventory(ctx, organizationId).ReleaseFromOrganizationInventoryRequest(releaseFromOrganizationInventoryRequest).Execute()
+	}
+
+	_, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
+	if err != nil {
+		diags.Append(utils.NewAPIErrorDiagnostic("Failed to release from organization", httpResp, err))
+		return diags
 	}
 
 	// If it's not what you expect, add an error to diagnostics.
 	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unexpected HTTP Response Status Code",
 			fmt.Sprintf("%v", httpResp.StatusCode),
 		)
 	}
 
-	// If there were any errors up to this point, log the plan data and return.
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("Plan Data", fmt.Sprintf("\n%v", data))
-		return
+	return diags
+}
+
+// values returns the string values of a set.
+func values(set []jsontypes.String) []string {
+	result := make([]string, 0, len(set))
+	for _, value := range set {
+		result = append(result, value.ValueString())
 	}
+	return result
+}
 
-	resp.State.RemoveResource(ctx)
+// difference returns the values of a that are not in b.
+func difference(a, b []jsontypes.String) []jsontypes.String {
+	var result []jsontypes.String
+	for _, value := range a {
+		found := false
+		for _, other := range b {
+			if value.ValueString() == other.ValueString() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, value)
+		}
+	}
+	return result
+}
 
-	// Log that the resource was deleted.
-	tflog.Trace(ctx, "removed resource")
+// containsLicence reports whether licences contains a licence with the same key and mode.
+func containsLicence(licences []resourceModelLicence, licence resourceModelLicence) bool {
+	for _, other := range licences {
+		if other.Key.ValueString() == licence.Key.ValueString() && other.Mode.ValueString() == licence.Mode.ValueString() {
+			return true
+		}
+	}
+	return false
 }
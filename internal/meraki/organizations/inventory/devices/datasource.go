@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
 )
 
 // OrganizationsInventoryDevicesDataSource struct. If not, implement them.
@@ -24,6 +25,7 @@ func NewDataSource() datasource.DataSource {
 // DataSource struct defines the structure for this data source.
 type DataSource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 // The DataSourceModel structure describes the data model.
@@ -186,20 +188,21 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// This allows the data source to use the configured provider for any API calls it needs to make.
-	d.client = client
+	d.client = providerData.Client
+	d.retry = providerData.Retry
 }
 
 // Read method is responsible for reading an existing data source's state.
@@ -214,27 +217,18 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	// Remember to handle any potential errors.
-	inlineResp, httpResp, err := d.client.InventoryApi.GetOrganizationInventoryDevices(ctx, data.OrganizationID.ValueString()).PerPage(1000).Execute()
+	// Follow the next page links until every device has been read
+	inlineResp, httpResp, err := utils.PaginateAll(ctx, utils.PaginationOptions{}, func(ctx context.Context, startingAfter string) ([]openApiClient.GetOrganizationInventoryDevices200ResponseInner, *http.Response, error) {
+		return utils.CustomHttpRequestRetry(ctx, d.retry, func() ([]openApiClient.GetOrganizationInventoryDevices200ResponseInner, *http.Response, error) {
+			request := d.client.InventoryApi.GetOrganizationInventoryDevices(ctx, data.OrganizationID.ValueString()).PerPage(1000)
+			if startingAfter != "" {
+				request = request.StartingAfter(startingAfter)
+			}
+			return request.Execute()
+		})
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
-		return
-	}
-
-	// Check for API success inlineResp code
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			fmt.Sprintf("%v", httpResp.StatusCode),
-		)
-	}
-
-	// Check for errors after diagnostics collected
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("State Data", fmt.Sprintf("\n%s", data))
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
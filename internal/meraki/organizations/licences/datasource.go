@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
 )
 
 var _ datasource.DataSource = &DataSource{}
@@ -18,6 +19,7 @@ func NewDataSource() datasource.DataSource {
 
 type DataSource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 // Metadata provides a way to define information about the data source.
@@ -37,20 +39,21 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// This allows the data source to use the configured provider for any API calls it needs to make.
-	d.client = client
+	d.client = providerData.Client
+	d.retry = providerData.Retry
 }
 
 // Read method is responsible for reading an existing data source's state.
@@ -65,49 +68,48 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
-	request := d.client.LicensesApi.GetOrganizationLicenses(context.Background(), data.OrganizationId.ValueString())
-
-	if !data.PerPage.IsUnknown() {
-		request.PerPage(int32(data.PerPage.ValueInt64()))
-	}
-	if !data.StartingAfter.IsUnknown() {
-		request.StartingAfter(data.StartingAfter.ValueString())
-	}
-	if !data.EndingBefore.IsUnknown() {
-		request.EndingBefore(data.EndingBefore.ValueString())
-	}
-	if !data.DeviceSerial.IsUnknown() {
-		request.DeviceSerial(data.DeviceSerial.ValueString())
-	}
-	if !data.NetworkId.IsUnknown() {
-		request.NetworkId(data.NetworkId.ValueString())
-	}
-	if !data.State.IsUnknown() {
-		request.State(data.State.ValueString())
+	fetch := func(ctx context.Context, startingAfter string) ([]openApiClient.GetOrganizationLicenses200ResponseInner, *http.Response, error) {
+		return utils.CustomHttpRequestRetry(ctx, d.retry, func() ([]openApiClient.GetOrganizationLicenses200ResponseInner, *http.Response, error) {
+			request := d.client.LicensesApi.GetOrganizationLicenses(ctx, data.OrganizationId.ValueString())
+
+			if !data.PerPage.IsNull() && !data.PerPage.IsUnknown() {
+				request = request.PerPage(int32(data.PerPage.ValueInt64()))
+			}
+			if startingAfter != "" {
+				request = request.StartingAfter(startingAfter)
+			} else if !data.StartingAfter.IsNull() && !data.StartingAfter.IsUnknown() {
+				request = request.StartingAfter(data.StartingAfter.ValueString())
+			}
+			if !data.EndingBefore.IsNull() && !data.EndingBefore.IsUnknown() {
+				request = request.EndingBefore(data.EndingBefore.ValueString())
+			}
+			if !data.DeviceSerial.IsNull() && !data.DeviceSerial.IsUnknown() {
+				request = request.DeviceSerial(data.DeviceSerial.ValueString())
+			}
+			if !data.NetworkId.IsNull() && !data.NetworkId.IsUnknown() {
+				request = request.NetworkId(data.NetworkId.ValueString())
+			}
+			if !data.State.IsNull() && !data.State.IsUnknown() {
+				request = request.State(data.State.ValueString())
+			}
+
+			return request.Execute()
+		})
 	}
 
-	inlineResp, httpResp, err := request.Execute()
+	// Follow the next page links until every license has been read. The deprecated ending_before selects a single page.
+	var inlineResp []openApiClient.GetOrganizationLicenses200ResponseInner
+	var httpResp *http.Response
+	var err error
+	if !data.EndingBefore.IsNull() && !data.EndingBefore.IsUnknown() {
+		inlineResp, httpResp, err = fetch(ctx, "")
+	} else {
+		inlineResp, httpResp, err = utils.PaginateAll(ctx, utils.PaginationOptions{}, fetch)
+	}
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
-		return
-	}
-
-	// If it's not what you expect, add an error to diagnostics.
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			fmt.Sprintf("%v", httpResp.StatusCode),
-		)
-	}
-
-	// If there were any errors up to this point, log the state data and return.
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("State Data", fmt.Sprintf("\n%v", data))
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
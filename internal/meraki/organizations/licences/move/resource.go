@@ -2,23 +2,29 @@ package move
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
-	"strings"
+	"net/http"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
 )
 
+// The licenses are moved when the resource is created and again whenever its triggers change. A move cannot be
+// read back or undone through this resource, so Read, Update and Delete only affect the Terraform state.
 var (
-	_ resource.Resource                = &Resource{} // Terraform resource interface
-	_ resource.ResourceWithConfigure   = &Resource{} // Interface for resources with configuration methods
-	_ resource.ResourceWithImportState = &Resource{} // Interface for resources with import state functionality
+	_ resource.Resource              = &Resource{} // Terraform resource interface
+	_ resource.ResourceWithConfigure = &Resource{} // Interface for resources with configuration methods
 )
 
 func NewResource() resource.Resource {
@@ -27,13 +33,17 @@ func NewResource() resource.Resource {
 
 type Resource struct {
 	client *openApiClient.APIClient // APIClient instance for making API requests
+	retry  utils.RetryPolicy
 }
 
 type resourceModel struct {
-	Id                 jsontypes.String   `tfsdk:"id" json:"-"`
+	Id                 jsontypes.String   `tfsdk:"id"`
 	OrganizationId     jsontypes.String   `tfsdk:"organization_id"`
 	DestOrganizationId jsontypes.String   `tfsdk:"dest_organization_id"`
 	LicenseIds         []jsontypes.String `tfsdk:"license_ids"`
+	Triggers           types.Map          `tfsdk:"triggers"`
+	MovedLicenseIds    []jsontypes.String `tfsdk:"moved_license_ids"`
+	ExecutedAt         types.String       `tfsdk:"executed_at"`
 }
 
 // Metadata provides a way to define information about the resource.
@@ -52,7 +62,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	// The Schema object defines the structure of the resource.
 	resp.Schema = schema.Schema{
 
-		MarkdownDescription: "Move licenses to another organization. This will also move any devices that the licenses are assigned to.",
+		MarkdownDescription: "Move licenses to another organization. This will also move any devices that the licenses are assigned to. " +
+			"The licenses are moved when the resource is created and again whenever `triggers` change.",
 
 		// The Attributes map describes the fields of the resource.
 		Attributes: map[string]schema.Attribute{
@@ -61,24 +72,49 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"id": schema.StringAttribute{
 				Computed:   true,
 				CustomType: jsontypes.StringType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: "Organization ID",
 				CustomType:          jsontypes.StringType,
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"dest_organization_id": schema.StringAttribute{
-				MarkdownDescription: "destOrganizationId",
+				MarkdownDescription: "The ID of the organization to move the licenses to",
 				CustomType:          jsontypes.StringType,
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"license_ids": schema.SetAttribute{
-				MarkdownDescription: "license ids",
+				MarkdownDescription: "A list of IDs of licenses to move to the new organization",
+				ElementType:         jsontypes.StringType,
+				CustomType:          jsontypes.SetType[jsontypes.String](),
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"triggers": utils.TriggersAttribute(),
+			"moved_license_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the licenses that were moved, as reported by the Dashboard API",
 				ElementType:         jsontypes.StringType,
 				CustomType:          jsontypes.SetType[jsontypes.String](),
 				Computed:            true,
-				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"executed_at": utils.ExecutedAtAttribute(),
 		},
 	}
 }
@@ -93,21 +129,22 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	}
 
 	// Here we expect the provider data to be of type *openApiClient.APIClient.
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	// This is a fatal error and the provider cannot proceed without it.
 	// If you see this error, it means there is an issue with the provider setup.
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
 	// This allows the resource to use the configured provider for any API calls it needs to make.
-	r.client = client
+	r.client = providerData.Client
+	r.retry = providerData.Retry
 }
 
 // Create method is responsible for creating a new resource.
@@ -136,14 +173,15 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		licenseIds,
 	)
 
-	_, httpResp, err := r.client.LicensesApi.MoveOrganizationLicenses(context.Background(), data.OrganizationId.ValueString()).MoveOrganizationLicensesRequest(moveOrganizationLicensesRequest).Execute()
+	apiCall := func() (*openApiClient.MoveOrganizationLicenses200Response, *http.Response, error) {
+		return r.client.LicensesApi.MoveOrganizationLicenses(ctx, data.OrganizationId.ValueString()).MoveOrganizationLicensesRequest(moveOrganizationLicensesRequest).Execute()
+	}
+
+	inlineResp, httpResp, err := utils.CustomHttpRequestRetry(ctx, r.retry, apiCall)
 
 	// If there was an error during API call, add it to diagnostics.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Failed to move licenses", httpResp, err))
 		return
 	}
 
@@ -153,24 +191,17 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 			"Unexpected HTTP Response Status Code",
 			fmt.Sprintf("%v", httpResp.StatusCode),
 		)
-	}
-
-	// If there were any errors up to this point, log the plan data and return.
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("Plan Data", fmt.Sprintf("\n%v", data))
 		return
 	}
 
-	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(&data); err != nil {
-		resp.Diagnostics.AddError(
-			"JSON decoding error",
-			fmt.Sprintf("%v\n", err.Error()),
-		)
-		return
+	// Record the licenses the Dashboard API reports as moved.
+	data.MovedLicenseIds = []jsontypes.String{}
+	for _, licenseId := range inlineResp.GetLicenseIds() {
+		data.MovedLicenseIds = append(data.MovedLicenseIds, jsontypes.StringValue(licenseId))
 	}
 
 	data.Id = jsontypes.StringValue(data.OrganizationId.ValueString())
+	data.ExecutedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 
 	// Now set the final state of the resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -237,31 +268,3 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	// Log that the resource was deleted.
 	tflog.Trace(ctx, "removed resource")
 }
-
-// ImportState function is used to import an existing resource into Terraform.
-// The function expects an ImportStateRequest and responds with an ImportStateResponse which contains
-// the new state of the resource or an error.
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-
-	// Pass through the ID directly from the ImportStateRequest to the ImportStateResponse
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: organization_id, license_id. Got: %q", req.ID),
-		)
-		return
-	}
-
-	// Set the attributes required for making a Read API call in the state.
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("license_id"), idParts[1])...)
-
-	// If there were any errors setting the attributes, return early.
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-}
@@ -82,9 +82,11 @@ func testAccOrganizationsLicenseMoveResourceConfigMoveLicenceToSourceOrganizatio
 
 // OrganizationsLicenseMoveResourceTestChecks returns the test check functions for verifying license move
 func OrganizationsLicenseMoveResourceTestChecks(moveType, licenceId string) resource.TestCheckFunc {
-	return utils.ResourceTestCheck(fmt.Sprintf("meraki_organizations_claim.test_%s_move", moveType), map[string]string{
-		"license_ids.#": "1",
-		"license_ids.0": licenceId,
+	return utils.ResourceTestCheck(fmt.Sprintf("meraki_organizations_license.test_%s_move", moveType), map[string]string{
+		"license_ids.#":       "1",
+		"license_ids.0":       licenceId,
+		"moved_license_ids.#": "1",
+		"moved_license_ids.0": licenceId,
 	})
 }
 */
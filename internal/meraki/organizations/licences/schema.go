@@ -30,7 +30,7 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				Required:            true,
 			},
 			"per_page": schema.Int64Attribute{
-				MarkdownDescription: "The number of entries per page returned. Acceptable range is 3 - 1000. Default is 1000.",
+				MarkdownDescription: "The number of entries per page requested while reading every page of licenses. Acceptable range is 3 - 1000. Default is 1000.",
 				CustomType:          jsontypes.Int64Type,
 				Optional:            true,
 				Computed:            true,
@@ -40,12 +40,14 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				CustomType:          jsontypes.StringType,
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  "All pages of licenses are now read by default. This attribute will be removed in a future release.",
 			},
 			"ending_before": schema.StringAttribute{
 				MarkdownDescription: "A token used by the server to indicate the end of the page. Often this is a timestamp or an ID but it is not limited to those. This parameter should not be defined by client applications. The link for the first, last, prev, or next page in the HTTP Link header should define it.",
 				CustomType:          jsontypes.StringType,
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  "All pages of licenses are now read by default. This attribute will be removed in a future release.",
 			},
 			"device_serial": schema.StringAttribute{
 				MarkdownDescription: "Filter the licenses to those assigned to a particular device. Returned in the same order that they are queued to the device",
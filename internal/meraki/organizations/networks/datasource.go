@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -27,6 +28,7 @@ func NewDataSource() datasource.DataSource {
 // DataSource defines the data source implementation.
 type DataSource struct {
 	client *openApiClient.APIClient
+	retry  utils.RetryPolicy
 }
 
 type DataSourceTagModel string
@@ -200,17 +202,18 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
+	d.retry = providerData.Retry
 }
 
 func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -251,43 +254,34 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 
 	*/
 
+	// Follow the next page links until every network has been read
 	perPage := int32(100000) // int32 | The number of entries per page returned. Acceptable range is 3 - 100000. Default is 1000. (optional)
-	_, httpResp, err := d.client.OrganizationsApi.GetOrganizationNetworks(context.Background(),
-		data.OrgId.ValueString()).PerPage(perPage).Execute()
+	list, httpResp, err := utils.PaginateAll(ctx, utils.PaginationOptions{}, func(ctx context.Context, startingAfter string) ([]dataSourceModelList, *http.Response, error) {
+		_, httpResp, err := utils.CustomHttpRequestRetry(ctx, d.retry, func() ([]openApiClient.GetNetwork200Response, *http.Response, error) {
+			request := d.client.OrganizationsApi.GetOrganizationNetworks(ctx, data.OrgId.ValueString()).PerPage(perPage)
+			if startingAfter != "" {
+				request = request.StartingAfter(startingAfter)
+			}
+			return request.Execute()
+		})
+		if err != nil {
+			return nil, httpResp, err
+		}
+
+		// Decoding errors are not retried, since the same page would fail to decode again
+		var page []dataSourceModelList
+		if err = json.NewDecoder(httpResp.Body).Decode(&page); err != nil {
+			return nil, httpResp, fmt.Errorf("JSON decoding error: %w", err)
+		}
+		return page, httpResp, nil
+	})
 	// .ConfigTemplateId(configTemplateId).IsBoundToConfigTemplate(IsBoundToConfigTemplate).Tags(tags).TagsFilterType(tagsFilterType)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
-		return
-	}
-
-	// Check for API success inlineResp code
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			fmt.Sprintf("%v", httpResp.StatusCode),
-		)
-		return
-	}
-
-	// Check for errors after diagnostics collected
-	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.AddError("State Data", fmt.Sprintf("\n%v", data))
-		return
-	}
-
-	// Save data into Terraform state
-	if err = json.NewDecoder(httpResp.Body).Decode(&data.List); err != nil {
-
-		resp.Diagnostics.AddError(
-			"JSON decoding error",
-			fmt.Sprintf("%v\n", err.Error()),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
+	data.List = list
 	data.Id = jsontypes.StringValue("example-id")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -134,17 +134,17 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -164,7 +164,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		cloneOrganization := *openApiClient.NewCloneOrganizationRequest(data.Name.ValueString())
 
 		// Initialize provider client and make API call
-		inlineResp, httpResp, err = r.client.OrganizationsApi.CloneOrganization(context.Background(), data.OrgToClone.ValueString()).CloneOrganizationRequest(cloneOrganization).Execute()
+		inlineResp, httpResp, err = r.client.OrganizationsApi.CloneOrganization(ctx, data.OrgToClone.ValueString()).CloneOrganizationRequest(cloneOrganization).Execute()
 	} else {
 		// Create HTTP request body
 		createOrganization := *openApiClient.NewCreateOrganizationRequest(data.Name.ValueString())
@@ -181,14 +181,11 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		createOrganization.SetManagement(organizationsManagement)
 
 		// Initialize provider client and make API call
-		inlineResp, httpResp, err = r.client.OrganizationsApi.CreateOrganization(context.Background()).CreateOrganizationRequest(createOrganization).Execute()
+		inlineResp, httpResp, err = r.client.OrganizationsApi.CreateOrganization(ctx).CreateOrganizationRequest(createOrganization).Execute()
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 	// Check for API success response code
@@ -250,12 +247,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	// Initialize provider client and make API call
-	inlineResp, httpResp, err := r.client.OrganizationsApi.GetOrganization(context.Background(), data.OrgId.ValueString()).Execute()
+	inlineResp, httpResp, err := r.client.OrganizationsApi.GetOrganization(ctx, data.OrgId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -338,13 +332,10 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	updateOrganization.SetManagement(organizationsManagement)
 
 	// Initialize provider client and make API call
-	inlineResp, httpResp, err := r.client.OrganizationsApi.UpdateOrganization(context.Background(),
+	inlineResp, httpResp, err := r.client.OrganizationsApi.UpdateOrganization(ctx,
 		data.OrgId.ValueString()).UpdateOrganizationRequest(*updateOrganization).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -406,12 +397,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	// Initialize provider client and make API call
-	httpResp, err := r.client.OrganizationsApi.DeleteOrganization(context.Background(), data.OrgId.ValueString()).Execute()
+	httpResp, err := r.client.OrganizationsApi.DeleteOrganization(ctx, data.OrgId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
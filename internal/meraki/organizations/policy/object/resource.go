@@ -54,18 +54,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	apiClient, ok := req.ProviderData.(*client.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = apiClient
+	r.client = providerData.Client
 }
 
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -167,11 +167,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	// Check for API success response code
 	if httpResp != nil && httpResp.StatusCode != 201 {
-		responseBody, _ := utils.ReadAndCloseBody(httpResp)
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			utils.NewHttpDiagnostics(httpResp, responseBody),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Unexpected HTTP Response Status Code", httpResp, nil))
 		return
 	}
 
@@ -210,11 +206,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 
 	// Check for API success response code
 	if httpResp != nil && httpResp.StatusCode != 200 {
-		responseBody, _ := utils.ReadAndCloseBody(httpResp)
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			utils.NewHttpDiagnostics(httpResp, responseBody),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Unexpected HTTP Response Status Code", httpResp, nil))
 		return
 	}
 
@@ -266,11 +258,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 
 	// Check for API success response code
 	if httpResp != nil && httpResp.StatusCode != 200 {
-		responseBody, _ := utils.ReadAndCloseBody(httpResp)
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP Response Status Code",
-			utils.NewHttpDiagnostics(httpResp, responseBody),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("Unexpected HTTP Response Status Code", httpResp, nil))
 		return
 	}
 
@@ -113,18 +113,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -141,16 +141,13 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	createOrganizationsSamlIdp.SetSloLogoutUrl(data.SloLogoutUrl.ValueString())
 
 	// Initialize provider client and make API call
-	_, httpResp, err := r.client.SamlApi.CreateOrganizationSamlIdp(context.Background(), data.OrganizationId.ValueString()).CreateOrganizationSamlIdpRequest(createOrganizationsSamlIdp).Execute()
+	_, httpResp, err := r.client.SamlApi.CreateOrganizationSamlIdp(ctx, data.OrganizationId.ValueString()).CreateOrganizationSamlIdpRequest(createOrganizationsSamlIdp).Execute()
 	//nolint:staticcheck
 	if err != nil {
 		// BUG - HTTP Client is unable to unmarshal data into typed response []client.InlineResponse20095, returns empty
 	}
 	if httpResp == nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -195,12 +192,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	}
 
 	// Initialize provider client and make API call
-	_, httpResp, err := r.client.SamlApi.GetOrganizationSamlIdp(context.Background(), data.OrganizationId.ValueString(), data.IdpId.ValueString()).Execute()
+	_, httpResp, err := r.client.SamlApi.GetOrganizationSamlIdp(ctx, data.OrganizationId.ValueString(), data.IdpId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -243,7 +237,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	updateOrganizationsSamlIdp.SetSloLogoutUrl(data.SloLogoutUrl.ValueString())
 
 	// Initialize provider client and make API call
-	_, httpResp, err := r.client.SamlApi.UpdateOrganizationSamlIdp(context.Background(),
+	_, httpResp, err := r.client.SamlApi.UpdateOrganizationSamlIdp(ctx,
 		data.OrganizationId.ValueString(), data.IdpId.ValueString()).UpdateOrganizationSamlIdpRequest(*updateOrganizationsSamlIdp).Execute()
 
 	//nolint:staticcheck
@@ -251,10 +245,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		// BUG - HTTP Client is unable to unmarshal data into typed response []client.InlineResponse20095, returns empty
 	}
 	if httpResp == nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -293,12 +284,9 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	// Initialize provider client and make API call
-	httpResp, err := r.client.SamlApi.DeleteOrganizationSamlIdp(context.Background(), data.OrganizationId.ValueString(), data.IdpId.ValueString()).Execute()
+	httpResp, err := r.client.SamlApi.DeleteOrganizationSamlIdp(ctx, data.OrganizationId.ValueString(), data.IdpId.ValueString()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"HTTP Client Failure",
-			utils.HttpDiagnostics(httpResp),
-		)
+		resp.Diagnostics.Append(utils.NewAPIErrorDiagnostic("HTTP Client Failure", httpResp, err))
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -113,18 +114,18 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	client, ok := req.ProviderData.(*openApiClient.APIClient)
+	providerData, ok := req.ProviderData.(*utils.ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *openApiClient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *utils.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -148,7 +149,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		updateSnmpRequest.PeerIps = append(updateSnmpRequest.PeerIps, peer.ValueString())
 	}
 
-	_, httpResp, err := r.client.OrganizationsApi.UpdateOrganizationSnmp(context.Background(), data.OrganizationId.ValueString()).UpdateOrganizationSnmpRequest(*updateSnmpRequest).Execute()
+	_, httpResp, err := r.client.OrganizationsApi.UpdateOrganizationSnmp(ctx, data.OrganizationId.ValueString()).UpdateOrganizationSnmpRequest(*updateSnmpRequest).Execute()
 	if err != nil {
 
 		// Extract additional information from the HTTP response
@@ -202,7 +203,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
-	_, httpResp, err := r.client.OrganizationsApi.GetOrganizationSnmp(context.Background(), data.OrganizationId.ValueString()).Execute()
+	_, httpResp, err := r.client.OrganizationsApi.GetOrganizationSnmp(ctx, data.OrganizationId.ValueString()).Execute()
 	if err != nil {
 
 		// Extract additional information from the HTTP response
@@ -267,7 +268,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		updateSnmpRequest.PeerIps = append(updateSnmpRequest.PeerIps, peer.ValueString())
 	}
 
-	_, httpResp, err := r.client.OrganizationsApi.UpdateOrganizationSnmp(context.Background(), data.OrganizationId.ValueString()).UpdateOrganizationSnmpRequest(*updateSnmpRequest).Execute()
+	_, httpResp, err := r.client.OrganizationsApi.UpdateOrganizationSnmp(ctx, data.OrganizationId.ValueString()).UpdateOrganizationSnmpRequest(*updateSnmpRequest).Execute()
 	if err != nil {
 
 		// Extract additional information from the HTTP response
@@ -327,7 +328,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	updateSnmpRequest.V2cEnabled = &defaultState
 	updateSnmpRequest.V3Enabled = &defaultState
 
-	_, httpResp, err := r.client.OrganizationsApi.UpdateOrganizationSnmp(context.Background(), data.OrganizationId.ValueString()).UpdateOrganizationSnmpRequest(*updateSnmpRequest).Execute()
+	_, httpResp, err := r.client.OrganizationsApi.UpdateOrganizationSnmp(ctx, data.OrganizationId.ValueString()).UpdateOrganizationSnmpRequest(*updateSnmpRequest).Execute()
 	if err != nil {
 
 		// Extract additional information from the HTTP response
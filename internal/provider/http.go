@@ -5,7 +5,9 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	openApiClient "github.com/meraki/dashboard-api-go/client"
@@ -49,8 +51,12 @@ func (p *CiscoMerakiProvider) Configure(ctx context.Context, req provider.Config
 	}
 
 	// MERAKI BASE URL
-	if !data.BaseUrl.IsNull() {
-		baseUrl, err := url.Parse(data.BaseUrl.ValueString())
+	baseUrlValue := data.BaseUrl.ValueString()
+	if data.BaseUrl.IsNull() {
+		baseUrlValue = os.Getenv("MERAKI_DASHBOARD_BASE_URL")
+	}
+	if baseUrlValue != "" {
+		baseUrl, err := url.Parse(baseUrlValue)
 		if err == nil {
 			configuration.Servers = openApiClient.ServerConfigurations{
 				{
@@ -73,6 +79,8 @@ func (p *CiscoMerakiProvider) Configure(ctx context.Context, req provider.Config
 	// Set certificate path
 	if !data.CertificatePath.IsNull() {
 		configuration.CertificatePath = data.CertificatePath.ValueString()
+	} else {
+		configuration.CertificatePath = os.Getenv("MERAKI_DASHBOARD_CERTIFICATE_PATH")
 	}
 
 	// Proxy
@@ -95,6 +103,11 @@ func (p *CiscoMerakiProvider) Configure(ctx context.Context, req provider.Config
 		configuration.Nginx429RetryWaitTime = int(data.Nginx429RetryWaitTime.ValueInt64())
 	}
 
+	// WaitOnRateLimit
+	if !data.WaitOnRateLimit.IsNull() {
+		configuration.WaitOnRateLimit = data.WaitOnRateLimit.ValueBool()
+	}
+
 	// New custom retryable retryClient
 	retryClient := retryablehttp.NewClient()
 
@@ -149,7 +162,20 @@ func (p *CiscoMerakiProvider) Configure(ctx context.Context, req provider.Config
 	} else {
 		authenticatedTransport.Token = os.Getenv("MERAKI_DASHBOARD_API_KEY")
 	}
-	retryClient.HTTPClient.Transport = authenticatedTransport
+
+	// Pace requests per organization and wait out 429 responses
+	requestsPerSecond := float64(defaultRequestsPerSecond)
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = float64(data.RequestsPerSecond.ValueInt64())
+	}
+	rateLimitTransport := NewRateLimitTransport(authenticatedTransport, requestsPerSecond)
+	rateLimitTransport.WaitOnRateLimit = configuration.WaitOnRateLimit
+	rateLimitTransport.MaximumRetries = configuration.MaximumRetries
+	if !data.Nginx429RetryWaitTime.IsNull() {
+		rateLimitTransport.RetryAfter = time.Duration(configuration.Nginx429RetryWaitTime) * time.Second
+	}
+
+	retryClient.HTTPClient.Transport = rateLimitTransport
 	configuration.HTTPClient = retryClient.HTTPClient
 
 	client := openApiClient.NewAPIClient(configuration)
@@ -159,7 +185,51 @@ func (p *CiscoMerakiProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	// Pass the encryption key to resources and data sources
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	// Retry policy for API calls made by resources and data sources
+	retryPolicy := utils.DefaultRetryPolicy(configuration.MaximumRetries)
+	if !data.RetryMaxElapsedTime.IsNull() {
+		retryPolicy.MaxElapsedTime = time.Duration(data.RetryMaxElapsedTime.ValueInt64()) * time.Second
+	}
+
+	// Count network and device requests against the budget of their organization
+	organizations := utils.NewOrganizationLookup(client, retryPolicy)
+	rateLimitTransport.Organizations = organizations
+
+	// Encryption keys for sensitive values stored in state
+	var previousEncryptionKeys []string
+	if !data.PreviousEncryptionKeys.IsNull() && !data.PreviousEncryptionKeys.IsUnknown() {
+		resp.Diagnostics.Append(data.PreviousEncryptionKeys.ElementsAs(ctx, &previousEncryptionKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.EncryptionKey.ValueString() == "" && len(previousEncryptionKeys) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("encryption_key"),
+			"Missing Encryption Key",
+			"previous_encryption_keys can only be used to rotate to a new encryption_key. Set encryption_key to the key that sensitive values should be encrypted with.",
+		)
+		return
+	}
+
+	providerData := &utils.ProviderData{
+		Client: client,
+		Encryption: utils.EncryptionKeys{
+			Key:          data.EncryptionKey.ValueString(),
+			PreviousKeys: previousEncryptionKeys,
+		},
+		Retry:       retryPolicy,
+		SwitchPorts: utils.NewSwitchPortRegistry(),
+	}
+
+	// Submit compatible writes as action batches
+	if data.UseActionBatches.ValueBool() {
+		providerData.ActionBatches = utils.NewActionBatcher(client, retryPolicy, organizations)
+	}
+
+	// Pass the client, encryption keys, retry policy, action batcher and switch port registry to resources, data sources and ephemeral resources
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
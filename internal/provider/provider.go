@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -11,7 +12,11 @@ import (
 )
 
 // Ensure CiscoMerakiProvider satisfies various provider interfaces.
-var _ provider.Provider = &CiscoMerakiProvider{}
+var (
+	_ provider.Provider                       = &CiscoMerakiProvider{}
+	_ provider.ProviderWithEphemeralResources = &CiscoMerakiProvider{}
+	_ provider.ProviderWithFunctions          = &CiscoMerakiProvider{}
+)
 
 // CiscoMerakiProvider defines the provider implementation.
 type CiscoMerakiProvider struct {
@@ -20,17 +25,21 @@ type CiscoMerakiProvider struct {
 
 // CiscoMerakiProviderModel describes the provider data model.
 type CiscoMerakiProviderModel struct {
-	LoggingEnabled        types.Bool   `tfsdk:"logging_enabled"`
-	ApiKey                types.String `tfsdk:"api_key"`
-	BaseUrl               types.String `tfsdk:"base_url"`
-	BasePath              types.String `tfsdk:"base_path"`
-	CertificatePath       types.String `tfsdk:"certificate_path"`
-	Proxy                 types.String `tfsdk:"proxy"`
-	SingleRequestTimeout  types.Int64  `tfsdk:"single_request_timeout"`
-	MaximumRetries        types.Int64  `tfsdk:"maximum_retries"`
-	Nginx429RetryWaitTime types.Int64  `tfsdk:"nginx_429_retry_wait_time"`
-	WaitOnRateLimit       types.Bool   `tfsdk:"wait_on_rate_limit"`
-	EncryptionKey         types.String `tfsdk:"encryption_key"`
+	LoggingEnabled         types.Bool   `tfsdk:"logging_enabled"`
+	ApiKey                 types.String `tfsdk:"api_key"`
+	BaseUrl                types.String `tfsdk:"base_url"`
+	BasePath               types.String `tfsdk:"base_path"`
+	CertificatePath        types.String `tfsdk:"certificate_path"`
+	Proxy                  types.String `tfsdk:"proxy"`
+	SingleRequestTimeout   types.Int64  `tfsdk:"single_request_timeout"`
+	MaximumRetries         types.Int64  `tfsdk:"maximum_retries"`
+	Nginx429RetryWaitTime  types.Int64  `tfsdk:"nginx_429_retry_wait_time"`
+	WaitOnRateLimit        types.Bool   `tfsdk:"wait_on_rate_limit"`
+	RequestsPerSecond      types.Int64  `tfsdk:"requests_per_second"`
+	RetryMaxElapsedTime    types.Int64  `tfsdk:"retry_max_elapsed_time"`
+	EncryptionKey          types.String `tfsdk:"encryption_key"`
+	PreviousEncryptionKeys types.List   `tfsdk:"previous_encryption_keys"`
+	UseActionBatches       types.Bool   `tfsdk:"use_action_batches"`
 }
 
 func (p *CiscoMerakiProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,7 +64,8 @@ func (p *CiscoMerakiProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Description: "Endpoint for Meraki Dashboard API",
 				MarkdownDescription: "The API version must be specified in the URL:" +
 					"Example: `https://api.meraki.com`" +
-					"For organizations hosted in the China dashboard, use: `https://api.meraki.cn/v1`",
+					"For organizations hosted in the China dashboard, use: `https://api.meraki.cn/v1`" +
+					"Can also be set with the `MERAKI_DASHBOARD_BASE_URL` environment variable.",
 				Optional: true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
@@ -75,7 +85,7 @@ func (p *CiscoMerakiProvider) Schema(ctx context.Context, req provider.SchemaReq
 					)},
 			},
 			"certificate_path": schema.StringAttribute{
-				Description: "Path for TLS/SSL certificate verification if behind local proxy",
+				Description: "Path for TLS/SSL certificate verification if behind local proxy. Can also be set with the MERAKI_DASHBOARD_CERTIFICATE_PATH environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -99,10 +109,46 @@ func (p *CiscoMerakiProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Description: "Retry if 429 rate limit error encountered",
 				Optional:    true,
 			},
+			"requests_per_second": schema.Int64Attribute{
+				Description: "Maximum number of API requests per second sent to each organization. Defaults to 10, the Dashboard API budget for each organization.",
+				MarkdownDescription: "Maximum number of API requests per second sent to each organization. " +
+					"Defaults to `10`, the Dashboard API budget for each organization. " +
+					"Lower this when other tools share the organization's budget.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_elapsed_time": schema.Int64Attribute{
+				Description: "Maximum number of seconds to spend retrying a failed API call. Defaults to 300.",
+				MarkdownDescription: "Maximum number of seconds to spend retrying a failed API call. Defaults to `300`. " +
+					"Calls are retried on 429 and 5xx responses, up to `maximum_retries` times, after the delay given in the " +
+					"`Retry-After` header or with exponential backoff when there is none.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"encryption_key": schema.StringAttribute{
 				Optional:            true,
-				Description:         "Encryption key for encrypting sensitive values.",
-				MarkdownDescription: "Encryption key for encrypting sensitive values.",
+				Sensitive:           true,
+				Description:         "Encryption key for encrypting sensitive values in state with AES-256-GCM.",
+				MarkdownDescription: "Encryption key for encrypting sensitive values in state with AES-256-GCM.",
+			},
+			"previous_encryption_keys": schema.ListAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Encryption keys that were previously used to encrypt sensitive values.",
+				MarkdownDescription: "Encryption keys that were previously used to encrypt sensitive values. " +
+					"State values encrypted with one of these keys are decrypted and re-encrypted with `encryption_key` on the next refresh.",
+			},
+			"use_action_batches": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Queue compatible create, update and delete calls and submit them as organization action batches. Defaults to false.",
+				MarkdownDescription: "Queue compatible create, update and delete calls and submit them as organization action batches of up to 100 actions. Defaults to `false`. " +
+					"Supported by `meraki_devices_switch_port`, `meraki_networks_appliance_vlan` and `meraki_networks_appliance_static_routes`. " +
+					"Each batch waits up to 2 seconds for other resources to queue their calls, which reduces the number of requests made by large applies.",
 			},
 		},
 	}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -22,12 +23,33 @@ func SweeperHTTPClient() (*openApiClient.APIClient, error) {
 			InsecureSkipVerify: false,
 		},
 	}
+
+	// Allow sweepers to target an alternate Dashboard such as the offline emulator
+	if baseUrl := os.Getenv("MERAKI_DASHBOARD_BASE_URL"); baseUrl != "" {
+		configuration.Servers = openApiClient.ServerConfigurations{
+			{
+				URL: baseUrl + "/api/v1",
+			},
+		}
+	}
+	if certFile := os.Getenv("MERAKI_DASHBOARD_CERTIFICATE_PATH"); certFile != "" {
+		cert, err := os.ReadFile(certFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading certificate: %s", err)
+		}
+		certPool := x509.NewCertPool()
+		certPool.AppendCertsFromPEM(cert)
+		transport.TLSClientConfig.RootCAs = certPool
+	}
+
 	authenticatedTransport := &BearerAuthTransport{
 		Transport: transport,
 	}
 	authenticatedTransport.Token = os.Getenv("MERAKI_DASHBOARD_API_KEY")
 	retryClient := retryablehttp.NewClient()
-	retryClient.HTTPClient.Transport = authenticatedTransport
+	rateLimitTransport := NewRateLimitTransport(authenticatedTransport, defaultRequestsPerSecond)
+	rateLimitTransport.MaximumRetries = configuration.MaximumRetries
+	retryClient.HTTPClient.Transport = rateLimitTransport
 	configuration.HTTPClient = retryClient.HTTPClient
 	client := openApiClient.NewAPIClient(configuration)
 	return client, nil
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRequestsPerSecond is the Dashboard API budget for each organization.
+	defaultRequestsPerSecond = 10
+
+	// defaultRetryAfter is used when a 429 response does not carry a usable Retry-After header.
+	defaultRetryAfter = time.Second
+)
+
+// Patterns that extract the organization ID, network ID and device serial from Dashboard API request paths.
+var (
+	organizationPathPattern = regexp.MustCompile(`/organizations/([^/?]+)`)
+	networkPathPattern      = regexp.MustCompile(`/networks/([^/?]+)`)
+	devicePathPattern       = regexp.MustCompile(`/devices/([^/?]+)`)
+)
+
+// OrganizationResolver finds the organization of the networks and devices referenced by request paths.
+type OrganizationResolver interface {
+	NetworkOrganization(ctx context.Context, networkId string) (string, error)
+	DeviceOrganization(ctx context.Context, serial string) (string, error)
+}
+
+// RateLimitTransport paces requests to the Dashboard API with a token bucket per organization, so that
+// concurrent resources share each organization's request budget instead of retrying independently.
+// Requests for a network or device count against the budget of its organization when Organizations is set.
+// Requests that cannot be resolved to an organization share a single bucket.
+// When WaitOnRateLimit is set, 429 responses pause the organization's bucket for the duration of the
+// Retry-After header and the request is retried up to MaximumRetries times.
+type RateLimitTransport struct {
+	Transport         http.RoundTripper
+	RequestsPerSecond float64
+	WaitOnRateLimit   bool
+	MaximumRetries    int
+	RetryAfter        time.Duration
+	Organizations     OrganizationResolver
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewRateLimitTransport returns a RateLimitTransport that sends requests through transport.
+func NewRateLimitTransport(transport http.RoundTripper, requestsPerSecond float64) *RateLimitTransport {
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = defaultRequestsPerSecond
+	}
+	return &RateLimitTransport{
+		Transport:         transport,
+		RequestsPerSecond: requestsPerSecond,
+		WaitOnRateLimit:   true,
+		RetryAfter:        defaultRetryAfter,
+		buckets:           map[string]*tokenBucket{},
+	}
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	organizationId := t.organization(req)
+	bucket := t.bucket(organizationId)
+
+	for attempt := 0; ; attempt++ {
+		if err := bucket.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := t.Transport.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+			return resp, err
+		}
+
+		retryAfter := utils.ParseRetryAfter(resp.Header.Get("Retry-After"), t.RetryAfter)
+		bucket.pause(retryAfter)
+
+		if !t.WaitOnRateLimit || attempt >= t.MaximumRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		tflog.Warn(ctx, fmt.Sprintf("Rate limited by the Dashboard API, retrying %d/%d after %s", attempt+1, t.MaximumRetries, retryAfter), map[string]interface{}{
+			"organization_id": organizationId,
+			"url":             req.URL.String(),
+		})
+
+		// Drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// bucket returns the token bucket for an organization, creating it on first use.
+func (t *RateLimitTransport) bucket(organizationId string) *tokenBucket {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.buckets == nil {
+		t.buckets = map[string]*tokenBucket{}
+	}
+
+	bucket, ok := t.buckets[organizationId]
+	if !ok {
+		bucket = newTokenBucket(t.RequestsPerSecond)
+		t.buckets[organizationId] = bucket
+	}
+	return bucket
+}
+
+// organization returns the ID of the organization whose budget the request counts against, or an empty string.
+func (t *RateLimitTransport) organization(req *http.Request) string {
+	ctx := req.Context()
+
+	if organizationId := organizationFromPath(req.URL.Path); organizationId != "" {
+		return organizationId
+	}
+
+	// The lookups themselves are sent through this transport
+	if t.Organizations == nil || utils.IsOrganizationLookup(ctx) {
+		return ""
+	}
+
+	var organizationId string
+	var err error
+	if match := networkPathPattern.FindStringSubmatch(req.URL.Path); match != nil {
+		organizationId, err = t.Organizations.NetworkOrganization(ctx, match[1])
+	} else if match := devicePathPattern.FindStringSubmatch(req.URL.Path); match != nil {
+		organizationId, err = t.Organizations.DeviceOrganization(ctx, match[1])
+	}
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Rate limiting request without an organization: %s", err), map[string]interface{}{
+			"url": req.URL.String(),
+		})
+		return ""
+	}
+	return organizationId
+}
+
+// organizationFromPath returns the organization ID referenced by a request path, or an empty string.
+func organizationFromPath(path string) string {
+	match := organizationPathPattern.FindStringSubmatch(path)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// tokenBucket allows rate requests per second with bursts of up to rate requests.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+	paused time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		tokens: rate,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before sending its request.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+	b.tokens--
+
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	if pause := b.paused.Sub(now); pause > wait {
+		wait = pause
+	}
+	return wait
+}
+
+// wait blocks until a request may be sent or the context is cancelled. Requests that were already waiting
+// when the bucket is paused wait for the pause to end as well.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	wait := b.reserve(time.Now())
+	for wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		wait = b.pausedFor(time.Now())
+	}
+	return nil
+}
+
+// pausedFor returns how long the bucket remains paused after now.
+func (b *tokenBucket) pausedFor(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.paused.Sub(now)
+}
+
+// pause holds back every request in the bucket for d and discards accumulated tokens, so that requests
+// resume at the configured rate once the Dashboard API accepts them again.
+func (b *tokenBucket) pause(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if until.After(b.paused) {
+		b.paused = until
+	}
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	t.Run("Paces requests to the same organization", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, 10)}
+
+		// The first 10 requests use the burst, the next 5 are paced at 10 per second
+		start := time.Now()
+		var wg sync.WaitGroup
+		for i := 0; i < 15; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Get(server.URL + "/api/v1/organizations/123/networks")
+				if assert.NoError(t, err) {
+					resp.Body.Close()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond, "Expected requests beyond the burst to be paced")
+	})
+
+	t.Run("Organizations have separate budgets", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, 2)}
+
+		start := time.Now()
+		for _, organizationId := range []string{"1", "2", "3", "4"} {
+			for i := 0; i < 2; i++ {
+				resp, err := client.Get(server.URL + "/api/v1/organizations/" + organizationId + "/admins")
+				require.NoError(t, err)
+				resp.Body.Close()
+			}
+		}
+
+		assert.Less(t, time.Since(start), 250*time.Millisecond, "Expected each organization to have its own burst")
+	})
+
+	t.Run("Network and device requests share the budget of their organization", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		transport := NewRateLimitTransport(http.DefaultTransport, 2)
+		transport.Organizations = testOrganizationResolver{}
+		client := &http.Client{Transport: transport}
+
+		// Organization 1's burst is used by its network and device, organization 2 is not held back by them
+		start := time.Now()
+		for _, path := range []string{"/networks/N_1/appliance/vlans", "/devices/Q2XX-0001/switch/ports", "/networks/N_2/appliance/vlans", "/organizations/2/admins"} {
+			resp, err := client.Get(server.URL + "/api/v1" + path)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+		assert.Less(t, time.Since(start), 250*time.Millisecond, "Expected organizations to have their own burst")
+
+		resp, err := client.Get(server.URL + "/api/v1/organizations/1/admins")
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond, "Expected the organization's budget to include its network and device requests")
+	})
+
+	t.Run("Retries after the Retry-After header", func(t *testing.T) {
+		var count int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt64(&count, 1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		transport := NewRateLimitTransport(http.DefaultTransport, 10)
+		transport.MaximumRetries = 2
+		client := &http.Client{Transport: transport}
+
+		start := time.Now()
+		resp, err := client.Post(server.URL+"/api/v1/organizations/123/admins", "application/json", strings.NewReader(`{"name":"admin"}`))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int64(2), atomic.LoadInt64(&count))
+		assert.GreaterOrEqual(t, time.Since(start), time.Second, "Expected the retry to wait for Retry-After")
+	})
+
+	t.Run("Returns 429 when waiting on rate limits is disabled", func(t *testing.T) {
+		var count int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(&count, 1)
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		transport := NewRateLimitTransport(http.DefaultTransport, 10)
+		transport.MaximumRetries = 2
+		transport.WaitOnRateLimit = false
+		client := &http.Client{Transport: transport}
+
+		resp, err := client.Get(server.URL + "/api/v1/organizations/123")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&count))
+	})
+
+	t.Run("Waiting respects context cancellation", func(t *testing.T) {
+		bucket := newTokenBucket(1)
+		bucket.pause(time.Minute)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, bucket.wait(ctx), context.DeadlineExceeded)
+	})
+}
+
+// testOrganizationResolver places networks and devices ending in 1 in organization 1, those ending in 2 in
+// organization 2, and fails to resolve anything else.
+type testOrganizationResolver struct{}
+
+func (testOrganizationResolver) NetworkOrganization(ctx context.Context, networkId string) (string, error) {
+	return testOrganization(networkId)
+}
+
+func (testOrganizationResolver) DeviceOrganization(ctx context.Context, serial string) (string, error) {
+	return testOrganization(serial)
+}
+
+func testOrganization(id string) (string, error) {
+	switch {
+	case strings.HasSuffix(id, "1"):
+		return "1", nil
+	case strings.HasSuffix(id, "2"):
+		return "2", nil
+	}
+	return "", fmt.Errorf("%s not found", id)
+}
+
+func TestRateLimitTransportOrganization(t *testing.T) {
+	transport := NewRateLimitTransport(http.DefaultTransport, 10)
+	transport.Organizations = testOrganizationResolver{}
+
+	organization := func(ctx context.Context, path string) string {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.meraki.com/api/v1"+path, nil)
+		require.NoError(t, err)
+		return transport.organization(req)
+	}
+	ctx := context.Background()
+
+	assert.Equal(t, "2", organization(ctx, "/organizations/2/networks"))
+	assert.Equal(t, "1", organization(ctx, "/networks/N_1/wireless/ssids/0"))
+	assert.Equal(t, "2", organization(ctx, "/networks/N_2"))
+	assert.Equal(t, "1", organization(ctx, "/devices/Q2XX-0001/switch/ports/1"))
+	assert.Equal(t, "", organization(ctx, "/networks/N_3/appliance/vlans"))
+	assert.Equal(t, "", organization(ctx, "/administered/identities/me"))
+
+	// Test case: The lookups made to resolve an organization are not resolved themselves
+	assert.Equal(t, "", organization(utils.WithOrganizationLookup(ctx), "/networks/N_1"))
+}
+
+func TestOrganizationFromPath(t *testing.T) {
+	assert.Equal(t, "123", organizationFromPath("/api/v1/organizations/123"))
+	assert.Equal(t, "123", organizationFromPath("/api/v1/organizations/123/admins/456"))
+	assert.Equal(t, "", organizationFromPath("/api/v1/organizations"))
+	assert.Equal(t, "", organizationFromPath("/api/v1/networks/N_123/wireless/ssids/0"))
+}
@@ -2,25 +2,46 @@ package provider
 
 import (
 	"context"
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/functions"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/administered"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices"
+	devicesBlinkLeds "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/blink/leds"
 	devicesCellular "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/cellular"
 	devicesDevice "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/device"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/dhcp/subnets"
+	devicesLiveToolsCableTest "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/live/tools/cable"
+	devicesLiveToolsPing "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/live/tools/ping"
 	devicesManagementInterface "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/management/interface"
+	devicesReboot "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/reboot"
 	devicesSwitchPort "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/switch/port"
 	"github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/switch/ports"
 	devicesSwitchPortsCycle "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/switch/ports/cycle"
+	devicesSwitchRoutingInterfaces "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/switch/routing/interfaces"
+	devicesSwitchRoutingInterfacesDhcp "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/switch/routing/interfaces/dhcp"
+	devicesSwitchRoutingStaticRoutes "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/devices/switch/routing/static/routes"
+	networksApplianceContentFiltering "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/content/filtering"
+	networksApplianceContentFilteringCategories "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/content/filtering/categories"
+	networksApplianceFirewallCellularRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/cellular/firewall/rules"
+	networksApplianceFirewallInboundRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/inbound/firewall/rules"
 	networksApplianceFirewallL3Rules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/l3/firewall/rules"
 	networksApplianceFirewallL7Rules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/l7/firewall/rules"
+	networksApplianceFirewallOneToManyNatRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/one/to/many/nat/rules"
+	networksApplianceFirewallOneToOneNatRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/one/to/one/nat/rules"
+	networksApplianceFirewallPortForwardingRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/port/forwarding/rules"
 	networksApplianceFirewallSettings "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/firewall/settings"
 	networksAppliancePorts "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/ports"
+	networksApplianceSecurityIntrusion "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/security/intrusion"
+	networksApplianceSecurityMalware "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/security/malware"
 	networksApplianceSettings "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/settings"
 	networksApplianceStaticRoutes "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/static/routes"
+	networksApplianceTrafficShapingCustomPerformanceClasses "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/traffic/shaping/custom/performance/classes"
+	networksApplianceTrafficShapingRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/traffic/shaping/rules"
 	networksApplianceTrafficShapingUplinkBandWidth "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/traffic/shaping/uplink/bandwidth"
+	networksApplianceTrafficShapingUplinkSelection "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/traffic/shaping/uplink/selection"
 	networksApplianceVlansSettings "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/vlans/settings"
 	networksApplianceVlansVlan "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/vlans/vlan"
 	networksApplianceVpn "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/vpn"
+	networksApplianceVpnBgp "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/appliance/vpn/bgp"
 	networksCellularGatewaySubnetPool "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/cellular/gateway/subnet/pool"
 	networksCellularGatewayUplink "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/cellular/gateway/uplink"
 	networksDevicesClaim "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/devices/claim"
@@ -30,19 +51,31 @@ import (
 	networksSettings "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/settings"
 	networksSnmp "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/snmp"
 	networksStormControl "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/storm/control"
+	networksSwitchAccessPolicies "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/access/policies"
 	networksSwitchDscpToCosMappings "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/dscp/to/cos/mappings"
+	networksSwitchLinkAggregations "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/link/aggregations"
 	networksSwitchMtu "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/mtu"
+	networksSwitchPortSchedules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/port/schedules"
 	networksSwitchQosRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/qos/rules"
+	networksSwitchRoutingMulticast "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/routing/multicast"
+	networksSwitchRoutingMulticastRendezvousPoints "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/routing/multicast/rendezvous/points"
+	networksSwitchRoutingOspf "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/routing/ospf"
 	networksSwitchSettings "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/settings"
+	networksSwitchStacks "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/switch/stacks"
 	networksSyslogServers "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/syslog/servers"
 	networksTrafficAnalysis "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/traffic/analysis"
 	networksWirelessSsids "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/wireless/ssid"
 	networksWirelessSsidsFirewallL3FirewallRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/wireless/ssids/firewall/l3/firewall/rules"
 	networksWirelessSsidsFirewallL7FirewallRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/wireless/ssids/firewall/l7/firewall/rules"
+	networksWirelessSsidsIdentityPsk "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/wireless/ssids/identity/psk"
+	networksWirelessSsidsPsk "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/wireless/ssids/psk"
 	networksWirelessSsidsSplashSettings "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/networks/wireless/ssids/splash/settings"
 	organizationsAdaptivePolicyAcls "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/adaptive/policy/acls"
 	organizationsAdmins "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/admins"
+	organizationsApplianceSecurityIntrusion "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/appliance/security/intrusion"
 	organizationsApplianceVpnFirewallRules "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/appliance/vpn/firewall/rules"
+	organizationsApplianceVpnThirdPartyPeers "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/appliance/vpn/third/party/peers"
+	organizationsApplianceVpnTopology "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/appliance/vpn/topology"
 	organizationsCellularGatewayUplinkStatuses "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/cellular/gateway/uplink/statuses"
 	organizationsClaim "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/claim"
 	organizationsInventoryDevices "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/inventory/devices"
@@ -56,15 +89,28 @@ import (
 	organizationsSamlRoles "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/saml/roles"
 	organizationsSnmp "github.com/core-infra-svcs/terraform-provider-meraki/internal/meraki/organizations/snmp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 func (p *CiscoMerakiProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		devicesBlinkLeds.NewResource,
 		devicesCellular.NewResource,
 		devicesDevice.NewResource,
+		devicesLiveToolsCableTest.NewResource,
+		devicesLiveToolsPing.NewResource,
+		devicesReboot.NewResource,
 		devicesSwitchPort.NewResource,
+		ports.NewResource,
 		devicesSwitchPortsCycle.NewResource,
+		devicesSwitchRoutingInterfaces.NewResource,
+		devicesSwitchRoutingInterfaces.NewStackResource,
+		devicesSwitchRoutingInterfacesDhcp.NewResource,
+		devicesSwitchRoutingInterfacesDhcp.NewStackResource,
+		devicesSwitchRoutingStaticRoutes.NewResource,
+		devicesSwitchRoutingStaticRoutes.NewStackResource,
 		devicesManagementInterface.NewResource,
 		networksCellularGatewaySubnetPool.NewResource,
 		networksCellularGatewayUplink.NewResource,
@@ -78,26 +124,47 @@ func (p *CiscoMerakiProvider) Resources(ctx context.Context) []func() resource.R
 		networksTrafficAnalysis.NewResource,
 		networksGroupPolicy.NewResource,
 		networksAppliancePorts.NewResource,
+		networksApplianceContentFiltering.NewResource,
+		networksApplianceSecurityIntrusion.NewResource,
+		networksApplianceSecurityMalware.NewResource,
 		networksApplianceSettings.NewResource,
 		networksApplianceStaticRoutes.NewResource,
 		networksApplianceTrafficShapingUplinkBandWidth.NewResource,
+		networksApplianceTrafficShapingUplinkSelection.NewResource,
+		networksApplianceTrafficShapingRules.NewResource,
+		networksApplianceTrafficShapingCustomPerformanceClasses.NewResource,
 		networksApplianceVpn.NewResource,
+		networksApplianceVpnBgp.NewResource,
 		networksApplianceFirewallL3Rules.NewResource,
+		networksApplianceFirewallInboundRules.NewResource,
+		networksApplianceFirewallCellularRules.NewResource,
 		networksApplianceFirewallL7Rules.NewResource,
+		networksApplianceFirewallPortForwardingRules.NewResource,
+		networksApplianceFirewallOneToOneNatRules.NewResource,
+		networksApplianceFirewallOneToManyNatRules.NewResource,
 		networksApplianceFirewallSettings.NewResource,
 		networksApplianceVlansVlan.NewResource,
 		networksApplianceVlansSettings.NewResource,
+		networksSwitchAccessPolicies.NewResource,
 		networksSwitchDscpToCosMappings.NewResource,
+		networksSwitchLinkAggregations.NewResource,
 		networksSwitchMtu.NewResource,
+		networksSwitchPortSchedules.NewResource,
 		networksSwitchQosRules.NewResource,
+		networksSwitchRoutingMulticast.NewResource,
+		networksSwitchRoutingMulticastRendezvousPoints.NewResource,
+		networksSwitchRoutingOspf.NewResource,
 		networksSwitchSettings.NewResource,
+		networksSwitchStacks.NewResource,
 		networksWirelessSsidsFirewallL3FirewallRules.NewResource,
 		networksWirelessSsidsFirewallL7FirewallRules.NewResource,
 		networksWirelessSsidsSplashSettings.NewResource,
 		networksWirelessSsids.NewResource,
 		organizationsAdaptivePolicyAcls.NewResource,
 		organizationsAdmins.NewResource,
+		organizationsApplianceSecurityIntrusion.NewResource,
 		organizationsApplianceVpnFirewallRules.NewResource,
+		organizationsApplianceVpnThirdPartyPeers.NewResource,
 		organizationsClaim.NewResource,
 		organizationsLicencesMove.NewResource,
 		organizationsSamlIdps.NewResource,
@@ -122,12 +189,18 @@ func (p *CiscoMerakiProvider) DataSources(ctx context.Context) []func() datasour
 		networksApplianceVlansVlan.NewNDatasource,
 		networksApplianceVlansSettings.NewDatasource,
 		networksApplianceVpn.NewDatasource,
+		networksApplianceContentFilteringCategories.NewDataSource,
 		networksApplianceFirewallL3Rules.NewDataSource,
+		networksApplianceFirewallPortForwardingRules.NewDataSource,
+		networksApplianceFirewallOneToOneNatRules.NewDataSource,
+		networksApplianceFirewallOneToManyNatRules.NewDataSource,
 		networksSwitchMtu.NewDataSource,
 		networksSwitchQosRules.NewDataSource,
+		networksSwitchStacks.NewDataSource,
 		networksWirelessSsids.NewDataSource,
 		organizationsAdaptivePolicyAcls.NewDataSource,
 		organizationsAdmins.NewDataSource,
+		organizationsApplianceVpnTopology.NewDataSource,
 		organizationsLicences.NewDataSource,
 		organizationsCellularGatewayUplinkStatuses.NewDataSource,
 		organizationsOrganization.NewDataSource,
@@ -137,3 +210,19 @@ func (p *CiscoMerakiProvider) DataSources(ctx context.Context) []func() datasour
 		organizationsNetworks.NewDataSource,
 	}
 }
+
+func (p *CiscoMerakiProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		networksWirelessSsidsIdentityPsk.NewEphemeralResource,
+		networksWirelessSsidsPsk.NewEphemeralResource,
+	}
+}
+
+func (p *CiscoMerakiProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewCidrHostForApplianceFunction,
+		functions.NewFirewallPortRangeFunction,
+		functions.NewNormalizeMacFunction,
+		functions.NewParseSerialFunction,
+	}
+}
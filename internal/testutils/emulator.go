@@ -0,0 +1,47 @@
+package testutils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/testutils/emulator"
+)
+
+// EmulatorEnvVar enables the offline Dashboard API emulator for acceptance tests when set.
+const EmulatorEnvVar = "TF_ACC_MERAKI_EMULATOR"
+
+// Emulator is the running Dashboard API emulator, or nil when acceptance tests target a live Dashboard.
+var Emulator *emulator.Server
+
+// init starts the emulator before any test case is built, because test configurations read
+// their organization ID and device serials from the environment while the test case is declared.
+func init() {
+	if os.Getenv(EmulatorEnvVar) == "" {
+		return
+	}
+
+	server, err := emulator.New()
+	if err != nil {
+		panic(fmt.Sprintf("starting Dashboard API emulator: %s", err))
+	}
+	Emulator = server
+
+	env := map[string]string{
+		"MERAKI_DASHBOARD_API_KEY":          emulator.APIKey,
+		"MERAKI_DASHBOARD_BASE_URL":         server.URL(),
+		"MERAKI_DASHBOARD_CERTIFICATE_PATH": server.CertificatePath(),
+		"TF_ACC_MERAKI_ORGANIZATION_ID":     server.Fixture.OrganizationId,
+		"TF_ACC_MERAKI_MX_SERIAL":           server.Fixture.MxSerial,
+		"TF_ACC_MERAKI_MS_SERIAL":           server.Fixture.MsSerial,
+		"TF_ACC_MERAKI_MS_STACK_SERIAL":     server.Fixture.MsStackSerial,
+		"TF_ACC_MERAKI_MR_SERIAL":           server.Fixture.MrSerial,
+		"TF_ACC_MERAKI_MG_SERIAL":           server.Fixture.MgSerial,
+		"TF_ACC_MERAKI_MX_LICENCE":          server.Fixture.MxLicence,
+		"TF_ACC_MERAKI_ORDER_NUMBER":        server.Fixture.OrderNumber,
+	}
+	for key, value := range env {
+		if err := os.Setenv(key, value); err != nil {
+			panic(fmt.Sprintf("setting %s for Dashboard API emulator: %s", key, err))
+		}
+	}
+}
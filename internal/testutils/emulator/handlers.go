@@ -0,0 +1,458 @@
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const ssidCount = 15
+
+func (s *Server) createOrganization(p string, _ []string, body document) (int, interface{}) {
+	c, _ := collectionAt(p)
+	status, doc := s.createMember(c, p, body)
+	if status != http.StatusCreated {
+		return status, doc
+	}
+
+	id := doc["id"].(string)
+	defaults := document{
+		"url":        "https://n1.meraki.com/o/" + id + "/manage/organization/overview",
+		"api":        document{"enabled": true},
+		"licensing":  document{"model": "co-term"},
+		"cloud":      document{"region": document{"name": "North America"}},
+		"management": document{"details": []interface{}{}},
+	}
+	for k, v := range defaults {
+		if _, ok := doc[k]; !ok {
+			doc[k] = v
+		}
+	}
+	return status, doc
+}
+
+func (s *Server) cloneOrganization(_ string, _ []string, body document) (int, interface{}) {
+	return s.createOrganization("/organizations", nil, document{"name": body["name"]})
+}
+
+func (s *Server) deleteOrganization(p string, params []string, _ document) (int, interface{}) {
+	if _, ok := s.store.get(p); !ok {
+		return notFound(p)
+	}
+
+	networks := "/organizations/" + params[0] + "/networks"
+	for _, network := range s.store.members(networks) {
+		s.deleteNetwork("/networks/"+network["id"].(string), []string{network["id"].(string)}, nil)
+	}
+	for _, device := range s.store.members("/organizations/" + params[0] + "/inventory/devices") {
+		s.store.delete("/devices/" + device["serial"].(string))
+	}
+
+	s.store.delete(p)
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) createNetwork(p string, params []string, body document) (int, interface{}) {
+	c, _ := collectionAt(p)
+	status, doc := s.createMember(c, p, body)
+	if status != http.StatusCreated {
+		return status, doc
+	}
+
+	id := doc["id"].(string)
+	doc["organizationId"] = params[0]
+	doc["url"] = "https://n1.meraki.com/" + id + "/manage/usage/list"
+	doc["isBoundToConfigTemplate"] = false
+	if _, ok := doc["tags"]; !ok {
+		doc["tags"] = []interface{}{}
+	}
+
+	// Every network starts with the fixed set of SSID slots and appliance LAN ports.
+	ssids := "/networks/" + id + "/wireless/ssids"
+	for i := 0; i < ssidCount; i++ {
+		member := fmt.Sprintf("%s/%d", ssids, i)
+		s.store.put(member, document{
+			"number":   i,
+			"name":     fmt.Sprintf("Unconfigured SSID %d", i+1),
+			"enabled":  false,
+			"authMode": "open",
+		})
+		s.store.appendMember(ssids, member)
+	}
+
+	ports := "/networks/" + id + "/appliance/ports"
+	for i := 2; i <= 5; i++ {
+		member := fmt.Sprintf("%s/%d", ports, i)
+		s.store.put(member, document{
+			"number":              i,
+			"enabled":             true,
+			"type":                "trunk",
+			"dropUntaggedTraffic": false,
+			"vlan":                1,
+			"allowedVlans":        "all",
+		})
+		s.store.appendMember(ports, member)
+	}
+
+	return status, doc
+}
+
+func (s *Server) deleteNetwork(p string, params []string, _ document) (int, interface{}) {
+	if _, ok := s.store.get(p); !ok {
+		return notFound(p)
+	}
+
+	// Deleting a network returns its devices to the organization inventory.
+	for _, device := range s.store.filter("/devices", "networkId", params[0]) {
+		device["networkId"] = nil
+	}
+
+	s.store.delete(p)
+	return http.StatusNoContent, nil
+}
+
+// addDevice places a device in the inventory of organizationId.
+func (s *Server) addDevice(organizationId, serial, model string) document {
+	devicePath := "/devices/" + serial
+	doc := document{
+		"serial":      serial,
+		"model":       model,
+		"name":        "",
+		"mac":         macFromSerial(serial),
+		"networkId":   nil,
+		"productType": productType(model),
+		"tags":        []interface{}{},
+		"address":     "",
+		"notes":       "",
+		"lat":         37.4180951010362,
+		"lng":         -122.098531723022,
+	}
+	s.store.put(devicePath, doc)
+	s.store.appendMember("/organizations/"+organizationId+"/inventory/devices", devicePath)
+
+	if productType(model) == "switch" {
+		ports := devicePath + "/switch/ports"
+		for i := 1; i <= 8; i++ {
+			member := fmt.Sprintf("%s/%d", ports, i)
+			s.store.put(member, document{
+				"portId":       fmt.Sprintf("%d", i),
+				"name":         nil,
+				"tags":         []interface{}{},
+				"enabled":      true,
+				"poeEnabled":   true,
+				"type":         "trunk",
+				"vlan":         1,
+				"allowedVlans": "all",
+			})
+			s.store.appendMember(ports, member)
+		}
+	}
+
+	return doc
+}
+
+func (s *Server) claimIntoOrganization(_ string, params []string, body document) (int, interface{}) {
+	for _, serial := range stringList(body["serials"]) {
+		if _, ok := s.store.get("/devices/" + serial); !ok {
+			s.addDevice(params[0], serial, modelFromSerial(serial))
+		}
+	}
+	return http.StatusOK, body
+}
+
+func (s *Server) releaseFromOrganization(_ string, _ []string, body document) (int, interface{}) {
+	for _, serial := range stringList(body["serials"]) {
+		s.store.delete("/devices/" + serial)
+	}
+	return http.StatusOK, document{"serials": body["serials"]}
+}
+
+func (s *Server) getOrganizationDevices(_ string, params []string, _ document) (int, interface{}) {
+	result := []document{}
+	for _, device := range s.store.members("/organizations/" + params[0] + "/inventory/devices") {
+		if device["networkId"] != nil {
+			result = append(result, device)
+		}
+	}
+	return http.StatusOK, result
+}
+
+func (s *Server) getNetworkDevices(_ string, params []string, _ document) (int, interface{}) {
+	return http.StatusOK, s.store.filter("/devices", "networkId", params[0])
+}
+
+func (s *Server) claimNetworkDevices(_ string, params []string, body document) (int, interface{}) {
+	network, _ := s.store.get("/networks/" + params[0])
+	inventory := "/organizations/" + network["organizationId"].(string) + "/inventory/devices"
+
+	serials := stringList(body["serials"])
+	for _, serial := range serials {
+		device, ok := s.store.get("/devices/" + serial)
+		if !ok || !contains(s.store.lists[inventory], "/devices/"+serial) {
+			return http.StatusBadRequest, errorsBody(fmt.Sprintf("Device with serial %s not found in the organization inventory", serial))
+		}
+		if device["networkId"] != nil && device["networkId"] != params[0] {
+			return http.StatusBadRequest, errorsBody(fmt.Sprintf("Device with serial %s is already claimed in another network", serial))
+		}
+	}
+
+	for _, serial := range serials {
+		device, _ := s.store.get("/devices/" + serial)
+		device["networkId"] = params[0]
+	}
+	return http.StatusOK, body
+}
+
+func (s *Server) removeNetworkDevices(_ string, params []string, body document) (int, interface{}) {
+	serial := stringValue(body["serial"])
+	device, ok := s.store.get("/devices/" + serial)
+	if !ok || device["networkId"] != params[0] {
+		return http.StatusNotFound, errorsBody(fmt.Sprintf("Device with serial %s not found in network %s", serial, params[0]))
+	}
+
+	device["networkId"] = nil
+	return http.StatusNoContent, nil
+}
+
+// defaultFirewallRules returns the rules the Dashboard always appends after the user defined rules.
+func defaultFirewallRules(p string, doc document) []interface{} {
+	rule := func(comment, policy, destCidr string) document {
+		return document{
+			"comment":       comment,
+			"policy":        policy,
+			"protocol":      "Any",
+			"srcPort":       "Any",
+			"srcCidr":       "Any",
+			"destPort":      "Any",
+			"destCidr":      destCidr,
+			"syslogEnabled": false,
+		}
+	}
+
+	if strings.Contains(p, "/wireless/ssids/") {
+		lanPolicy := "deny"
+		if allow, ok := doc["allowLanAccess"].(bool); ok && allow {
+			lanPolicy = "allow"
+		}
+		return []interface{}{
+			rule("Wireless clients accessing LAN", lanPolicy, "Local LAN"),
+			rule("Default rule", "allow", "Any"),
+		}
+	}
+	return []interface{}{rule("Default rule", "allow", "Any")}
+}
+
+func (s *Server) getFirewallRules(p string, _ []string, _ document) (int, interface{}) {
+	doc, ok := s.store.get(p)
+	if !ok {
+		doc = document{"rules": []interface{}{}}
+	}
+
+	result := copyDocument(doc)
+	rules, _ := doc["rules"].([]interface{})
+	result["rules"] = append(append([]interface{}{}, rules...), defaultFirewallRules(p, doc)...)
+	return http.StatusOK, result
+}
+
+func (s *Server) updateFirewallRules(p string, params []string, body document) (int, interface{}) {
+	// The Dashboard ignores attempts to send the default rules back as user rules.
+	var rules []interface{}
+	if requested, ok := body["rules"].([]interface{}); ok {
+		for _, r := range requested {
+			if rule, ok := r.(document); ok && isDefaultRule(rule) {
+				continue
+			}
+			rules = append(rules, r)
+		}
+	}
+
+	patch := copyDocument(body)
+	if _, ok := body["rules"]; ok {
+		patch["rules"] = append([]interface{}{}, rules...)
+	}
+	s.store.merge(p, patch)
+	return s.getFirewallRules(p, params, nil)
+}
+
+func isDefaultRule(rule document) bool {
+	switch rule["comment"] {
+	case "Default rule", "Wireless clients accessing LAN":
+		return true
+	}
+	return false
+}
+
+// productType derives the Dashboard product type from a device model.
+func productType(model string) string {
+	switch {
+	case strings.HasPrefix(model, "MX"), strings.HasPrefix(model, "Z"):
+		return "appliance"
+	case strings.HasPrefix(model, "MS"):
+		return "switch"
+	case strings.HasPrefix(model, "MR"), strings.HasPrefix(model, "CW"):
+		return "wireless"
+	case strings.HasPrefix(model, "MG"):
+		return "cellularGateway"
+	case strings.HasPrefix(model, "MV"):
+		return "camera"
+	case strings.HasPrefix(model, "MT"):
+		return "sensor"
+	}
+	return "appliance"
+}
+
+// modelFromSerial guesses a model for serials claimed without an inventory record.
+func modelFromSerial(serial string) string {
+	for _, prefix := range []string{"MX", "MS", "MR", "MG", "MV", "MT"} {
+		if strings.Contains(strings.ToUpper(serial), prefix) {
+			return prefix + "-EMULATED"
+		}
+	}
+	return "MX-EMULATED"
+}
+
+func macFromSerial(serial string) string {
+	var sum [5]byte
+	for i, c := range []byte(serial) {
+		sum[i%len(sum)] += c
+	}
+	return fmt.Sprintf("00:18:%02x:%02x:%02x:%02x", sum[0], sum[1]^sum[4], sum[2], sum[3])
+}
+
+func stringList(v interface{}) []string {
+	values, _ := v.([]interface{})
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, stringValue(value))
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createSwitchStack(p string, _ []string, body document) (int, interface{}) {
+	for _, serial := range stringList(body["serials"]) {
+		if _, ok := s.store.get("/devices/" + serial); !ok {
+			return http.StatusBadRequest, errorsBody(fmt.Sprintf("Device with serial %s not found", serial))
+		}
+	}
+
+	c, _ := collectionAt(p)
+	return s.createMember(c, p, body)
+}
+
+func (s *Server) addSwitchStackMember(p string, _ []string, body document) (int, interface{}) {
+	stackPath, _ := splitLast(p)
+	stack, ok := s.store.get(stackPath)
+	if !ok {
+		return notFound(stackPath)
+	}
+
+	serial := stringValue(body["serial"])
+	if _, ok := s.store.get("/devices/" + serial); !ok {
+		return http.StatusBadRequest, errorsBody(fmt.Sprintf("Device with serial %s not found", serial))
+	}
+
+	serials := stringList(stack["serials"])
+	if !contains(serials, serial) {
+		serials = append(serials, serial)
+	}
+	stack["serials"] = interfaceList(serials)
+	return http.StatusOK, stack
+}
+
+func (s *Server) removeSwitchStackMember(p string, _ []string, body document) (int, interface{}) {
+	stackPath, _ := splitLast(p)
+	stack, ok := s.store.get(stackPath)
+	if !ok {
+		return notFound(stackPath)
+	}
+
+	serial := stringValue(body["serial"])
+	serials := stringList(stack["serials"])
+	if !contains(serials, serial) {
+		return http.StatusBadRequest, errorsBody(fmt.Sprintf("Switch %s is not a member of switch stack %s", serial, stack["id"]))
+	}
+
+	kept := serials[:0]
+	for _, member := range serials {
+		if member != serial {
+			kept = append(kept, member)
+		}
+	}
+	stack["serials"] = interfaceList(kept)
+	return http.StatusOK, stack
+}
+
+func (s *Server) rebootDevice(_ string, _ []string, _ document) (int, interface{}) {
+	return http.StatusAccepted, document{"success": true}
+}
+
+func (s *Server) blinkDeviceLeds(_ string, _ []string, body document) (int, interface{}) {
+	result := document{"duration": 20, "period": 160, "duty": 50}
+	for k, v := range body {
+		result[k] = v
+	}
+	return http.StatusAccepted, result
+}
+
+// createCableTest starts a cable test that completes immediately, with every requested port up and its pairs ok.
+func (s *Server) createCableTest(p string, params []string, body document) (int, interface{}) {
+	id := s.store.nextId("")
+	request := document{"serial": params[0], "ports": body["ports"]}
+
+	var results []interface{}
+	for _, port := range stringList(body["ports"]) {
+		var pairs []interface{}
+		for i := 0; i < 4; i++ {
+			pairs = append(pairs, document{"index": i, "status": "ok", "lengthMeters": 3})
+		}
+		results = append(results, document{"port": port, "status": "up", "speedMbps": 1000, "pairs": pairs})
+	}
+
+	s.putLiveToolsJob(p, id, document{"cableTestId": id, "status": "complete", "request": request, "results": results})
+	return http.StatusCreated, document{"cableTestId": id, "url": p + "/" + id, "request": request}
+}
+
+// createPing starts a ping that completes immediately without losing any of the requested packets.
+func (s *Server) createPing(p string, params []string, body document) (int, interface{}) {
+	id := s.store.nextId("")
+	count := 5
+	if n, ok := body["count"].(float64); ok {
+		count = int(n)
+	}
+	request := document{"serial": params[0], "target": body["target"], "count": count}
+
+	results := document{
+		"sent":      count,
+		"received":  count,
+		"loss":      document{"percentage": 0},
+		"latencies": document{"minimum": 1.2, "average": 1.5, "maximum": 2.1},
+		"replies":   []interface{}{},
+	}
+
+	s.putLiveToolsJob(p, id, document{"pingId": id, "status": "complete", "request": request, "results": results})
+	return http.StatusCreated, document{"pingId": id, "url": p + "/" + id, "request": request}
+}
+
+// putLiveToolsJob stores a live tools job so that it can be read back from the job collection at p.
+func (s *Server) putLiveToolsJob(p, id string, job document) {
+	member := p + "/" + id
+	s.store.put(member, job)
+	s.store.appendMember(p, member)
+}
+
+func interfaceList(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,405 @@
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// collection describes a Dashboard endpoint that lists objects and addresses each one by an identifier.
+type collection struct {
+	pattern  string
+	idField  string
+	idPrefix string
+
+	// itemRoot is set when members live outside the collection path, e.g. networks created
+	// under /organizations/{organizationId}/networks are addressed as /networks/{networkId}.
+	itemRoot string
+
+	// creatable collections accept POST to add a member.
+	creatable bool
+}
+
+var collections = []collection{
+	{pattern: "/organizations", idField: "id", creatable: true},
+	{pattern: "/organizations/*/networks", idField: "id", idPrefix: "L_", itemRoot: "/networks", creatable: true},
+	{pattern: "/organizations/*/inventory/devices", idField: "serial", itemRoot: "/devices"},
+	{pattern: "/organizations/*/admins", idField: "id", creatable: true},
+	{pattern: "/organizations/*/adaptivePolicy/acls", idField: "aclId", creatable: true},
+	{pattern: "/organizations/*/policyObjects", idField: "id", creatable: true},
+	{pattern: "/organizations/*/saml/idps", idField: "idpId", creatable: true},
+	{pattern: "/organizations/*/samlRoles", idField: "id", creatable: true},
+	{pattern: "/organizations/*/licenses", idField: "id"},
+	{pattern: "/organizations/*/cellularGateway/uplink/statuses", idField: "serial"},
+	{pattern: "/networks/*/appliance/ports", idField: "number"},
+	{pattern: "/networks/*/appliance/staticRoutes", idField: "id", creatable: true},
+	{pattern: "/networks/*/appliance/vlans", idField: "id", creatable: true},
+	{pattern: "/networks/*/groupPolicies", idField: "groupPolicyId", creatable: true},
+	{pattern: "/networks/*/appliance/trafficShaping/customPerformanceClasses", idField: "customPerformanceClassId", creatable: true},
+	{pattern: "/networks/*/switch/qosRules", idField: "id", creatable: true},
+	{pattern: "/networks/*/switch/accessPolicies", idField: "accessPolicyNumber", creatable: true},
+	{pattern: "/networks/*/switch/linkAggregations", idField: "id", creatable: true},
+	{pattern: "/networks/*/switch/portSchedules", idField: "id", creatable: true},
+	{pattern: "/networks/*/switch/routing/multicast/rendezvousPoints", idField: "rendezvousPointId", creatable: true},
+	{pattern: "/networks/*/switch/stacks", idField: "id", creatable: true},
+	{pattern: "/networks/*/switch/stacks/*/routing/interfaces", idField: "interfaceId", creatable: true},
+	{pattern: "/networks/*/switch/stacks/*/routing/staticRoutes", idField: "staticRouteId", creatable: true},
+	{pattern: "/networks/*/wireless/ssids", idField: "number"},
+	{pattern: "/devices/*/switch/ports", idField: "portId"},
+	{pattern: "/devices/*/switch/routing/interfaces", idField: "interfaceId", creatable: true},
+	{pattern: "/devices/*/switch/routing/staticRoutes", idField: "staticRouteId", creatable: true},
+	{pattern: "/devices/*/appliance/dhcp/subnets", idField: "subnet"},
+	{pattern: "/devices/*/liveTools/cableTest", idField: "cableTestId"},
+	{pattern: "/devices/*/liveTools/ping", idField: "pingId"},
+}
+
+// singletonPaths are fixed endpoints that share a parent with a collection and must not be treated as members.
+var singletonPaths = []string{
+	"/networks/*/appliance/vlans/settings",
+	"/networks/*/switch/qosRules/order",
+	"/devices/*/switch/ports/cycle",
+}
+
+// singletonDefaults are returned for settings endpoints that have not been written yet.
+var singletonDefaults = map[string]func() document{
+	"/networks/*/appliance/firewall/l7FirewallRules":        func() document { return document{"rules": []interface{}{}} },
+	"/networks/*/wireless/ssids/*/firewall/l7FirewallRules": func() document { return document{"rules": []interface{}{}} },
+	"/networks/*/appliance/vlans/settings":                  func() document { return document{"vlansEnabled": false} },
+	"/networks/*/appliance/vpn/siteToSiteVpn":               func() document { return document{"mode": "none", "hubs": []interface{}{}, "subnets": []interface{}{}} },
+	"/networks/*/switch/mtu":                                func() document { return document{"defaultMtuSize": 9578, "overrides": []interface{}{}} },
+	"/networks/*/switch/dscpToCosMappings":                  func() document { return document{"mappings": []interface{}{}} },
+	"/networks/*/syslogServers":                             func() document { return document{"servers": []interface{}{}} },
+	"/networks/*/appliance/firewall/settings": func() document {
+		return document{"spoofingProtection": document{"ipSourceGuard": document{"mode": "alert"}}}
+	},
+	"/organizations/*/saml":                                func() document { return document{"enabled": false} },
+	"/organizations/*/snmp":                                func() document { return document{"v2cEnabled": false, "v3Enabled": false, "peerIps": []interface{}{}} },
+	"/networks/*/appliance/trafficShaping/uplinkBandwidth": func() document { return document{"bandwidthLimits": document{}} },
+	"/networks/*/cellularGateway/subnetPool":               func() document { return document{"mask": 24, "cidr": "192.168.0.0/16", "subnets": []interface{}{}} },
+	"/administered/identities/me":                          func() document { return document{"name": "Dashboard Emulator", "email": "emulator@example.com"} },
+	"/networks/*/wireless/ssids/*/splash/settings":         func() document { return document{"splashPage": "None"} },
+	"/networks/*/appliance/firewall/portForwardingRules":   func() document { return document{"rules": []interface{}{}} },
+	"/networks/*/appliance/firewall/oneToOneNatRules":      func() document { return document{"rules": []interface{}{}} },
+	"/networks/*/appliance/firewall/oneToManyNatRules":     func() document { return document{"rules": []interface{}{}} },
+	"/networks/*/appliance/security/intrusion": func() document {
+		return document{"mode": "disabled", "idsRulesets": "balanced", "protectedNetworks": document{"useDefault": true}}
+	},
+	"/networks/*/appliance/security/malware": func() document {
+		return document{"mode": "disabled", "allowedUrls": []interface{}{}, "allowedFiles": []interface{}{}}
+	},
+	"/organizations/*/appliance/security/intrusion": func() document { return document{"allowedRules": []interface{}{}} },
+	"/networks/*/appliance/contentFiltering": func() document {
+		return document{
+			"allowedUrlPatterns":   []interface{}{},
+			"blockedUrlPatterns":   []interface{}{},
+			"blockedUrlCategories": []interface{}{},
+			"urlCategoryListSize":  "topSites",
+		}
+	},
+	"/networks/*/appliance/contentFiltering/categories": func() document {
+		return document{"categories": []interface{}{
+			document{"id": "meraki:contentFiltering/category/C1", "name": "Adult"},
+			document{"id": "meraki:contentFiltering/category/C7", "name": "Gambling"},
+			document{"id": "meraki:contentFiltering/category/C23", "name": "Peer to Peer"},
+		}}
+	},
+	"/networks/*/appliance/trafficShaping/rules": func() document {
+		return document{"defaultRulesEnabled": true, "rules": []interface{}{}}
+	},
+	"/networks/*/appliance/trafficShaping/uplinkSelection": func() document {
+		return document{
+			"activeActiveAutoVpnEnabled":  false,
+			"defaultUplink":               "wan1",
+			"loadBalancingEnabled":        false,
+			"failoverAndFailback":         document{"immediate": document{"enabled": false}},
+			"wanTrafficUplinkPreferences": []interface{}{},
+			"vpnTrafficUplinkPreferences": []interface{}{},
+		}
+	},
+	"/networks/*/appliance/vpn/bgp": func() document {
+		return document{"enabled": false, "asNumber": 64512, "ibgpHoldTimer": 240, "neighbors": []interface{}{}}
+	},
+	"/organizations/*/appliance/vpn/thirdPartyVPNPeers": func() document { return document{"peers": []interface{}{}} },
+	"/networks/*/switch/routing/ospf": func() document {
+		return document{"enabled": false, "helloTimerInSeconds": 10, "deadTimerInSeconds": 40, "areas": []interface{}{}, "md5AuthenticationEnabled": false}
+	},
+	"/networks/*/switch/routing/multicast": func() document {
+		return document{
+			"defaultSettings": document{"igmpSnoopingEnabled": true, "floodUnknownMulticastTrafficEnabled": true},
+			"overrides":       []interface{}{},
+		}
+	},
+	"/devices/*/switch/routing/interfaces/*/dhcp":           func() document { return document{"dhcpMode": "dhcpDisabled"} },
+	"/networks/*/switch/stacks/*/routing/interfaces/*/dhcp": func() document { return document{"dhcpMode": "dhcpDisabled"} },
+}
+
+// handler serves a request whose path matched pattern; params holds the wildcard segments.
+type handler struct {
+	method  string
+	pattern string
+	fn      func(s *Server, p string, params []string, body document) (int, interface{})
+}
+
+var handlers = []handler{
+	{http.MethodPost, "/organizations", (*Server).createOrganization},
+	{http.MethodPost, "/organizations/*/clone", (*Server).cloneOrganization},
+	{http.MethodDelete, "/organizations/*", (*Server).deleteOrganization},
+	{http.MethodPost, "/organizations/*/networks", (*Server).createNetwork},
+	{http.MethodDelete, "/networks/*", (*Server).deleteNetwork},
+	{http.MethodPost, "/organizations/*/claim", (*Server).claimIntoOrganization},
+	{http.MethodPost, "/organizations/*/inventory/claim", (*Server).claimIntoOrganization},
+	{http.MethodPost, "/organizations/*/inventory/release", (*Server).releaseFromOrganization},
+	{http.MethodGet, "/organizations/*/devices", (*Server).getOrganizationDevices},
+	{http.MethodGet, "/networks/*/devices", (*Server).getNetworkDevices},
+	{http.MethodPost, "/networks/*/devices/claim", (*Server).claimNetworkDevices},
+	{http.MethodPost, "/networks/*/devices/remove", (*Server).removeNetworkDevices},
+	{http.MethodGet, "/networks/*/appliance/firewall/l3FirewallRules", (*Server).getFirewallRules},
+	{http.MethodPut, "/networks/*/appliance/firewall/l3FirewallRules", (*Server).updateFirewallRules},
+	{http.MethodGet, "/networks/*/wireless/ssids/*/firewall/l3FirewallRules", (*Server).getFirewallRules},
+	{http.MethodPut, "/networks/*/wireless/ssids/*/firewall/l3FirewallRules", (*Server).updateFirewallRules},
+	{http.MethodGet, "/organizations/*/appliance/vpn/vpnFirewallRules", (*Server).getFirewallRules},
+	{http.MethodPut, "/organizations/*/appliance/vpn/vpnFirewallRules", (*Server).updateFirewallRules},
+	{http.MethodGet, "/networks/*/appliance/firewall/inboundFirewallRules", (*Server).getFirewallRules},
+	{http.MethodPut, "/networks/*/appliance/firewall/inboundFirewallRules", (*Server).updateFirewallRules},
+	{http.MethodGet, "/networks/*/appliance/firewall/cellularFirewallRules", (*Server).getFirewallRules},
+	{http.MethodPut, "/networks/*/appliance/firewall/cellularFirewallRules", (*Server).updateFirewallRules},
+	{http.MethodPost, "/networks/*/switch/stacks", (*Server).createSwitchStack},
+	{http.MethodPost, "/networks/*/switch/stacks/*/add", (*Server).addSwitchStackMember},
+	{http.MethodPost, "/networks/*/switch/stacks/*/remove", (*Server).removeSwitchStackMember},
+	{http.MethodPost, "/devices/*/reboot", (*Server).rebootDevice},
+	{http.MethodPost, "/devices/*/blinkLeds", (*Server).blinkDeviceLeds},
+	{http.MethodPost, "/devices/*/liveTools/cableTest", (*Server).createCableTest},
+	{http.MethodPost, "/devices/*/liveTools/ping", (*Server).createPing},
+}
+
+// route dispatches a request to a specific handler, a collection or the generic settings store.
+func (s *Server) route(method, p string, body document) (int, interface{}) {
+	if parent, ok := s.missingParent(p); ok {
+		return notFound(parent)
+	}
+
+	for _, h := range handlers {
+		if h.method != method {
+			continue
+		}
+		if params, ok := match(h.pattern, p); ok {
+			return h.fn(s, p, params, body)
+		}
+	}
+
+	if c, ok := collectionAt(p); ok {
+		switch method {
+		case http.MethodGet:
+			return http.StatusOK, s.store.members(p)
+		case http.MethodPost:
+			if c.creatable {
+				return s.createMember(c, p, body)
+			}
+		}
+		return methodNotAllowed(method, p)
+	}
+
+	if _, ok := collectionItem(p); ok {
+		return s.item(method, p, body)
+	}
+
+	return s.singleton(method, p, body)
+}
+
+// missingParent reports the organization, network or device that p is nested under when it does not exist.
+func (s *Server) missingParent(p string) (string, bool) {
+	segments := strings.Split(strings.TrimPrefix(p, "/"), "/")
+	if len(segments) < 3 {
+		return "", false
+	}
+	switch segments[0] {
+	case "organizations", "networks", "devices":
+		parent := "/" + segments[0] + "/" + segments[1]
+		if _, ok := s.store.get(parent); !ok {
+			return parent, true
+		}
+	}
+	return "", false
+}
+
+// createMember adds body to collection c at p, assigning an identifier if the request did not supply one.
+func (s *Server) createMember(c collection, p string, body document) (int, document) {
+	doc := copyDocument(body)
+
+	id := stringValue(doc[c.idField])
+	if id == "" {
+		id = s.store.nextId(c.idPrefix)
+		doc[c.idField] = id
+	}
+
+	itemPath := p + "/" + id
+	if c.itemRoot != "" {
+		itemPath = c.itemRoot + "/" + id
+	}
+	if _, exists := s.store.get(itemPath); exists {
+		return http.StatusBadRequest, errorsBody(fmt.Sprintf("%s %s already exists", c.idField, id))
+	}
+
+	s.store.put(itemPath, doc)
+	s.store.appendMember(p, itemPath)
+	return http.StatusCreated, doc
+}
+
+// item serves reads, updates and deletes of a single collection member.
+func (s *Server) item(method, p string, body document) (int, interface{}) {
+	doc, ok := s.store.get(p)
+	if !ok {
+		return notFound(p)
+	}
+
+	switch method {
+	case http.MethodGet:
+		return http.StatusOK, doc
+	case http.MethodPut:
+		return http.StatusOK, s.store.merge(p, body)
+	case http.MethodDelete:
+		s.store.delete(p)
+		return http.StatusNoContent, nil
+	}
+	return methodNotAllowed(method, p)
+}
+
+// singleton serves settings style endpoints, which always exist and are updated in place.
+func (s *Server) singleton(method, p string, body document) (int, interface{}) {
+	switch method {
+	case http.MethodGet:
+		if doc, ok := s.store.get(p); ok {
+			return http.StatusOK, doc
+		}
+		return http.StatusOK, defaultDocument(p)
+	case http.MethodPut:
+		if _, ok := s.store.get(p); !ok {
+			s.store.put(p, defaultDocument(p))
+		}
+		return http.StatusOK, s.store.merge(p, body)
+	case http.MethodPost:
+		// Action endpoints such as port cycling or licence moves echo the request back.
+		return http.StatusOK, body
+	case http.MethodDelete:
+		s.store.delete(p)
+		return http.StatusNoContent, nil
+	}
+	return methodNotAllowed(method, p)
+}
+
+func defaultDocument(p string) document {
+	for pattern, fn := range singletonDefaults {
+		if _, ok := match(pattern, p); ok {
+			return fn()
+		}
+	}
+	return document{}
+}
+
+// collectionAt returns the collection served at exactly p.
+func collectionAt(p string) (collection, bool) {
+	for _, c := range collections {
+		if _, ok := match(c.pattern, p); ok {
+			return c, true
+		}
+	}
+	return collection{}, false
+}
+
+// collectionItem returns the collection p is a member path of.
+func collectionItem(p string) (collection, bool) {
+	for _, pattern := range singletonPaths {
+		if _, ok := match(pattern, p); ok {
+			return collection{}, false
+		}
+	}
+
+	parent, _ := splitLast(p)
+	for _, c := range collections {
+		if c.itemRoot != "" && parent == c.itemRoot {
+			return c, true
+		}
+		if _, ok := match(c.pattern, parent); ok {
+			return c, true
+		}
+	}
+	return collection{}, false
+}
+
+// match reports whether p matches pattern, where '*' matches exactly one path segment.
+func match(pattern, p string) ([]string, bool) {
+	want := strings.Split(pattern, "/")
+	got := strings.Split(p, "/")
+	if len(want) != len(got) {
+		return nil, false
+	}
+
+	var params []string
+	for i := range want {
+		if want[i] == "*" {
+			params = append(params, got[i])
+			continue
+		}
+		if want[i] != got[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// canonicalPath strips the API version prefix and any duplicate slashes from a request path.
+func canonicalPath(p string) string {
+	p = path.Clean("/" + p)
+	p = strings.TrimPrefix(p, "/api/v1")
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
+func splitLast(p string) (string, string) {
+	i := strings.LastIndex(p, "/")
+	if i < 0 {
+		return "", p
+	}
+	return p[:i], p[i+1:]
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
+
+// stringValue renders a decoded JSON identifier as the string used in request paths.
+func stringValue(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func copyDocument(doc document) document {
+	result := make(document, len(doc))
+	for k, v := range doc {
+		result[k] = v
+	}
+	return result
+}
+
+func errorsBody(messages ...string) document {
+	return document{"errors": messages}
+}
+
+func notFound(p string) (int, interface{}) {
+	return http.StatusNotFound, errorsBody(fmt.Sprintf("Resource not found: %s", p))
+}
+
+func methodNotAllowed(method, p string) (int, interface{}) {
+	return http.StatusMethodNotAllowed, errorsBody(fmt.Sprintf("%s is not supported for %s", method, p))
+}
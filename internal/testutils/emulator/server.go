@@ -0,0 +1,269 @@
+// Package emulator provides an in-process fake of the Meraki Dashboard API for hermetic acceptance tests.
+//
+// The emulator is served over TLS by net/http/httptest and keeps organizations, networks, devices, VLANs,
+// SSIDs, firewall rules and every other settings endpoint in memory. Point the provider's base_url and
+// certificate_path at a running Server to exercise resources without a live Dashboard organization.
+package emulator
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// APIKey is the only Dashboard API key accepted by the emulator.
+const APIKey = "0123456789abcdef0123456789abcdef01234567"
+
+// DefaultMaxPerPage is the default page size limit of a Server. It is kept small so that paginated list endpoints
+// return several pages even for the few objects an acceptance test creates.
+const DefaultMaxPerPage = 2
+
+// Fixture describes the organization and inventory the emulator is seeded with.
+type Fixture struct {
+	OrganizationId string
+	MxSerial       string
+	MsSerial       string
+	MsStackSerial  string
+	MrSerial       string
+	MgSerial       string
+	MxLicence      string
+	OrderNumber    string
+}
+
+// Server is a running Dashboard API emulator.
+type Server struct {
+	Fixture Fixture
+
+	// MaxPerPage limits the perPage parameter of paginated list requests. Zero applies no limit.
+	MaxPerPage int
+
+	server          *httptest.Server
+	store           *store
+	certificatePath string
+}
+
+// New starts an emulator seeded with a single organization holding one MX, MR and MG device and two MS devices.
+func New() (*Server, error) {
+	s := &Server{store: newStore(), MaxPerPage: DefaultMaxPerPage}
+	s.server = httptest.NewTLSServer(s)
+
+	certificatePath, err := writeCertificate(s.server.Certificate().Raw)
+	if err != nil {
+		s.server.Close()
+		return nil, err
+	}
+	s.certificatePath = certificatePath
+
+	s.seed()
+	return s, nil
+}
+
+// URL returns the base URL of the emulator, suitable for the provider's base_url.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// CertificatePath returns a PEM file containing the emulator's self-signed certificate.
+func (s *Server) CertificatePath() string {
+	return s.certificatePath
+}
+
+// ProviderConfig returns a provider block that targets the emulator.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "meraki" {
+  api_key          = %q
+  base_url         = %q
+  base_path        = "/api/v1"
+  certificate_path = %q
+}
+`, APIKey, s.URL(), s.CertificatePath())
+}
+
+// NewAPIClient returns a Dashboard API client that talks to the emulator.
+func (s *Server) NewAPIClient() *openApiClient.APIClient {
+	configuration := openApiClient.NewConfiguration()
+	configuration.Servers = openApiClient.ServerConfigurations{
+		{URL: s.URL() + "/api/v1"},
+	}
+	configuration.HTTPClient = &http.Client{
+		Transport: &bearerTransport{
+			transport: s.server.Client().Transport,
+			token:     APIKey,
+		},
+	}
+	return openApiClient.NewAPIClient(configuration)
+}
+
+// Close shuts the emulator down and removes its certificate file.
+func (s *Server) Close() {
+	s.server.Close()
+	_ = os.Remove(s.certificatePath)
+}
+
+func (s *Server) seed() {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	_, org := s.createOrganization("/organizations", nil, document{"name": "Dashboard Emulator"})
+	organizationId := org.(document)["id"].(string)
+
+	s.Fixture = Fixture{
+		OrganizationId: organizationId,
+		MxSerial:       "Q2MX-EMUL-0001",
+		MsSerial:       "Q2MS-EMUL-0001",
+		MsStackSerial:  "Q2MS-EMUL-0002",
+		MrSerial:       "Q2MR-EMUL-0001",
+		MgSerial:       "Q2MG-EMUL-0001",
+		MxLicence:      "Z2EMULATORLICENCE",
+		OrderNumber:    "4CEMULATOR",
+	}
+
+	s.addDevice(organizationId, s.Fixture.MxSerial, "MX67")
+	s.addDevice(organizationId, s.Fixture.MsSerial, "MS120-8")
+	s.addDevice(organizationId, s.Fixture.MsStackSerial, "MS120-8")
+	s.addDevice(organizationId, s.Fixture.MrSerial, "MR36")
+	s.addDevice(organizationId, s.Fixture.MgSerial, "MG21")
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+APIKey {
+		writeJSON(w, http.StatusUnauthorized, errorsBody("Invalid API key"))
+		return
+	}
+
+	var body document
+	if r.Body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			writeJSON(w, http.StatusBadRequest, errorsBody(fmt.Sprintf("Invalid JSON body: %s", err)))
+			return
+		}
+	}
+	if body == nil {
+		body = document{}
+	}
+
+	s.store.mu.Lock()
+	status, payload := s.route(r.Method, canonicalPath(r.URL.Path), body)
+	s.store.mu.Unlock()
+
+	if items, ok := payload.([]document); ok && status == http.StatusOK {
+		status, payload = s.paginate(w, r, items)
+	}
+
+	writeJSON(w, status, payload)
+}
+
+// paginate returns the page of items requested by the perPage and startingAfter parameters of r, and links the
+// first and next pages in the Link header like the Dashboard does. Requests without either parameter get every item.
+// The startingAfter tokens are the positions of the last item of the previous page.
+func (s *Server) paginate(w http.ResponseWriter, r *http.Request, items []document) (int, interface{}) {
+	query := r.URL.Query()
+	if !query.Has("perPage") && !query.Has("startingAfter") {
+		return http.StatusOK, items
+	}
+
+	perPage := len(items)
+	if query.Has("perPage") {
+		n, err := strconv.Atoi(query.Get("perPage"))
+		if err != nil || n < 1 {
+			return http.StatusBadRequest, errorsBody(fmt.Sprintf("Invalid perPage: %q", query.Get("perPage")))
+		}
+		perPage = n
+	}
+	if s.MaxPerPage > 0 && perPage > s.MaxPerPage {
+		perPage = s.MaxPerPage
+	}
+
+	start := 0
+	if query.Has("startingAfter") {
+		n, err := strconv.Atoi(query.Get("startingAfter"))
+		if err != nil || n < 0 {
+			return http.StatusBadRequest, errorsBody(fmt.Sprintf("Invalid startingAfter: %q", query.Get("startingAfter")))
+		}
+		start = n
+	}
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	link := func(startingAfter int) string {
+		u := url.URL{Scheme: "https", Host: r.Host, Path: r.URL.Path}
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("perPage", strconv.Itoa(perPage))
+		q.Del("startingAfter")
+		if startingAfter > 0 {
+			q.Set("startingAfter", strconv.Itoa(startingAfter))
+		}
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+
+	links := []string{fmt.Sprintf("<%s>; rel=first", link(0))}
+	if end < len(items) {
+		links = append(links, fmt.Sprintf("<%s>; rel=next", link(end)))
+	}
+	w.Header().Set("Link", strings.Join(links, ", "))
+
+	return http.StatusOK, items[start:end]
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(errorsBody(err.Error()))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+func writeCertificate(der []byte) (string, error) {
+	file, err := os.CreateTemp("", "meraki-emulator-*.pem")
+	if err != nil {
+		return "", fmt.Errorf("creating emulator certificate file: %w", err)
+	}
+	defer file.Close()
+
+	if err := pem.Encode(file, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return "", fmt.Errorf("writing emulator certificate file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// bearerTransport adds the Dashboard API key to every request.
+type bearerTransport struct {
+	transport http.RoundTripper
+	token     string
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.transport.RoundTrip(req)
+}
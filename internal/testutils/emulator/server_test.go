@@ -0,0 +1,288 @@
+package emulator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/utils"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*Server, *openApiClient.APIClient) {
+	t.Helper()
+
+	server, err := New()
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	return server, server.NewAPIClient()
+}
+
+func createTestNetwork(t *testing.T, server *Server, client *openApiClient.APIClient) string {
+	t.Helper()
+
+	request := *openApiClient.NewCreateOrganizationNetworkRequest("test_acc_emulator", []string{"appliance", "switch", "wireless"})
+	network, httpResp, err := client.OrganizationsApi.CreateOrganizationNetwork(context.Background(), server.Fixture.OrganizationId).CreateOrganizationNetworkRequest(request).Execute()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, httpResp.StatusCode)
+	return network.GetId()
+}
+
+func TestServerRejectsUnknownApiKey(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL()+"/api/v1/organizations", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer invalid")
+
+	resp, err := server.server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestServerOrganizationLifecycle(t *testing.T) {
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	org, httpResp, err := client.OrganizationsApi.CreateOrganization(ctx).CreateOrganizationRequest(*openApiClient.NewCreateOrganizationRequest("test_acc_org")).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, httpResp.StatusCode)
+	assert.Equal(t, "test_acc_org", org.GetName())
+	assert.True(t, org.Api.GetEnabled())
+
+	orgs, _, err := client.OrganizationsApi.GetOrganizations(ctx).Execute()
+	require.NoError(t, err)
+	assert.Len(t, orgs, 2)
+
+	httpResp, err = client.OrganizationsApi.DeleteOrganization(ctx, org.GetId()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, httpResp.StatusCode)
+
+	_, httpResp, err = client.OrganizationsApi.GetOrganization(ctx, org.GetId()).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+}
+
+func TestServerNetworkLifecycle(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	networkId := createTestNetwork(t, server, client)
+
+	network, _, err := client.NetworksApi.GetNetwork(ctx, networkId).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, server.Fixture.OrganizationId, network.GetOrganizationId())
+
+	networks, _, err := client.OrganizationsApi.GetOrganizationNetworks(ctx, server.Fixture.OrganizationId).Execute()
+	require.NoError(t, err)
+	assert.Len(t, networks, 1)
+
+	_, err = client.NetworksApi.DeleteNetwork(ctx, networkId).Execute()
+	require.NoError(t, err)
+
+	_, httpResp, err := client.NetworksApi.GetNetwork(ctx, networkId).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+
+	// Nested settings of a deleted network are gone as well.
+	_, httpResp, err = client.SwitchApi.GetNetworkSwitchMtu(ctx, networkId).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+}
+
+func TestServerVlanLifecycle(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+	networkId := createTestNetwork(t, server, client)
+
+	request := *openApiClient.NewCreateNetworkApplianceVlanRequest("10", "test_acc_vlan")
+	request.SetSubnet("192.168.10.0/24")
+	_, httpResp, err := client.ApplianceApi.CreateNetworkApplianceVlan(ctx, networkId).CreateNetworkApplianceVlanRequest(request).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, httpResp.StatusCode)
+
+	_, httpResp, err = client.ApplianceApi.CreateNetworkApplianceVlan(ctx, networkId).CreateNetworkApplianceVlanRequest(request).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, httpResp.StatusCode)
+
+	vlan, _, err := client.ApplianceApi.GetNetworkApplianceVlan(ctx, networkId, "10").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.10.0/24", vlan.GetSubnet())
+
+	settings, _, err := client.ApplianceApi.GetNetworkApplianceVlansSettings(ctx, networkId).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, false, settings["vlansEnabled"])
+
+	_, err = client.ApplianceApi.DeleteNetworkApplianceVlan(ctx, networkId, "10").Execute()
+	require.NoError(t, err)
+
+	vlans, _, err := client.ApplianceApi.GetNetworkApplianceVlans(ctx, networkId).Execute()
+	require.NoError(t, err)
+	assert.Empty(t, vlans)
+}
+
+func TestServerWirelessSsids(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+	networkId := createTestNetwork(t, server, client)
+
+	ssids, _, err := client.WirelessApi.GetNetworkWirelessSsids(ctx, networkId).Execute()
+	require.NoError(t, err)
+	assert.Len(t, ssids, ssidCount)
+
+	request := *openApiClient.NewUpdateNetworkWirelessSsidRequest()
+	request.SetName("test_acc_ssid")
+	request.SetEnabled(true)
+	_, _, err = client.WirelessApi.UpdateNetworkWirelessSsid(ctx, networkId, "3").UpdateNetworkWirelessSsidRequest(request).Execute()
+	require.NoError(t, err)
+
+	ssid, _, err := client.WirelessApi.GetNetworkWirelessSsid(ctx, networkId, "3").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "test_acc_ssid", ssid.GetName())
+	assert.True(t, ssid.GetEnabled())
+}
+
+func TestServerFirewallDefaultRule(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+	networkId := createTestNetwork(t, server, client)
+
+	rule := *openApiClient.NewUpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner("deny", "tcp", "Any", "10.0.0.0/8")
+	rule.SetComment("test_acc_rule")
+	request := *openApiClient.NewUpdateNetworkApplianceFirewallL3FirewallRulesRequest()
+	request.SetRules([]openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner{rule})
+
+	_, _, err := client.ApplianceApi.UpdateNetworkApplianceFirewallL3FirewallRules(ctx, networkId).UpdateNetworkApplianceFirewallL3FirewallRulesRequest(request).Execute()
+	require.NoError(t, err)
+
+	rules, _, err := client.ApplianceApi.GetNetworkApplianceFirewallL3FirewallRules(ctx, networkId).Execute()
+	require.NoError(t, err)
+
+	list := rules["rules"].([]interface{})
+	require.Len(t, list, 2)
+	assert.Equal(t, "test_acc_rule", list[0].(map[string]interface{})["comment"])
+	assert.Equal(t, "Default rule", list[1].(map[string]interface{})["comment"])
+}
+
+func TestServerClaimNetworkDevices(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+	networkId := createTestNetwork(t, server, client)
+
+	claim := *openApiClient.NewClaimNetworkDevicesRequest([]string{server.Fixture.MxSerial, server.Fixture.MsSerial})
+	_, err := client.NetworksApi.ClaimNetworkDevices(ctx, networkId).ClaimNetworkDevicesRequest(claim).Execute()
+	require.NoError(t, err)
+
+	devices, _, err := client.NetworksApi.GetNetworkDevices(ctx, networkId).Execute()
+	require.NoError(t, err)
+	assert.Len(t, devices, 2)
+
+	ports, _, err := client.SwitchApi.GetDeviceSwitchPorts(ctx, server.Fixture.MsSerial).Execute()
+	require.NoError(t, err)
+	assert.NotEmpty(t, ports)
+
+	unknown := *openApiClient.NewClaimNetworkDevicesRequest([]string{"Q2XX-0000-0000"})
+	httpResp, err := client.NetworksApi.ClaimNetworkDevices(ctx, networkId).ClaimNetworkDevicesRequest(unknown).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, httpResp.StatusCode)
+}
+
+func TestServerPagination(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	// Test case: Requests without paging parameters read every item
+	devices, httpResp, err := client.OrganizationsApi.GetOrganizationInventoryDevices(ctx, server.Fixture.OrganizationId).Execute()
+	require.NoError(t, err)
+	assert.Len(t, devices, 5)
+	assert.Empty(t, httpResp.Header.Get("Link"))
+
+	// Test case: Paged requests follow the rel=next links up to the last page
+	pages := 0
+	devices, _, err = utils.PaginateAll(ctx, utils.PaginationOptions{}, func(ctx context.Context, startingAfter string) ([]openApiClient.GetOrganizationInventoryDevices200ResponseInner, *http.Response, error) {
+		pages++
+		request := client.OrganizationsApi.GetOrganizationInventoryDevices(ctx, server.Fixture.OrganizationId).PerPage(1000)
+		if startingAfter != "" {
+			request = request.StartingAfter(startingAfter)
+		}
+		return request.Execute()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, pages)
+	require.Len(t, devices, 5)
+	assert.Equal(t, server.Fixture.MxSerial, devices[0].GetSerial())
+	assert.Equal(t, server.Fixture.MgSerial, devices[4].GetSerial())
+
+	// Test case: Without a page size limit a single page holds every item
+	server.MaxPerPage = 0
+	_, httpResp, err = client.OrganizationsApi.GetOrganizationInventoryDevices(ctx, server.Fixture.OrganizationId).PerPage(1000).Execute()
+	require.NoError(t, err)
+	_, ok, err := utils.NextPageToken(httpResp)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestServerSwitchStackLifecycle(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+	networkId := createTestNetwork(t, server, client)
+
+	request := *openApiClient.NewCreateNetworkSwitchStackRequest("test_acc_stack", []string{server.Fixture.MsSerial})
+	created, httpResp, err := client.SwitchApi.CreateNetworkSwitchStack(ctx, networkId).CreateNetworkSwitchStackRequest(request).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, httpResp.StatusCode)
+	stackId := created["id"].(string)
+
+	add := *openApiClient.NewAddNetworkSwitchStackRequest(server.Fixture.MsStackSerial)
+	stack, _, err := client.SwitchApi.AddNetworkSwitchStack(ctx, networkId, stackId).AddNetworkSwitchStackRequest(add).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []string{server.Fixture.MsSerial, server.Fixture.MsStackSerial}, stack.GetSerials())
+
+	routingInterface := *openApiClient.NewCreateNetworkSwitchStackRoutingInterfaceRequest("test_acc_interface", 10)
+	created, _, err = client.SwitchApi.CreateNetworkSwitchStackRoutingInterface(ctx, networkId, stackId).CreateNetworkSwitchStackRoutingInterfaceRequest(routingInterface).Execute()
+	require.NoError(t, err)
+	interfaceId := created["interfaceId"].(string)
+
+	dhcp, _, err := client.SwitchApi.GetNetworkSwitchStackRoutingInterfaceDhcp(ctx, networkId, stackId, interfaceId).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "dhcpDisabled", dhcp.GetDhcpMode())
+
+	remove := *openApiClient.NewRemoveNetworkSwitchStackRequest(server.Fixture.MsSerial)
+	_, _, err = client.SwitchApi.RemoveNetworkSwitchStack(ctx, networkId, stackId).RemoveNetworkSwitchStackRequest(remove).Execute()
+	require.NoError(t, err)
+
+	stack, _, err = client.SwitchApi.GetNetworkSwitchStack(ctx, networkId, stackId).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []string{server.Fixture.MsStackSerial}, stack.GetSerials())
+
+	_, err = client.SwitchApi.DeleteNetworkSwitchStack(ctx, networkId, stackId).Execute()
+	require.NoError(t, err)
+
+	// Routing interfaces of a deleted stack are gone as well.
+	_, httpResp, err = client.SwitchApi.GetNetworkSwitchStackRoutingInterface(ctx, networkId, stackId, interfaceId).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+}
+
+func TestServerDeviceOperations(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	reboot, httpResp, err := client.DevicesApi.RebootDevice(ctx, server.Fixture.MxSerial).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, httpResp.StatusCode)
+	assert.True(t, reboot.GetSuccess())
+
+	cableTest, _, err := client.DevicesApi.CreateDeviceLiveToolsCableTest(ctx, server.Fixture.MsSerial).CreateDeviceLiveToolsCableTestRequest(*openApiClient.NewCreateDeviceLiveToolsCableTestRequest([]string{"1", "2"})).Execute()
+	require.NoError(t, err)
+
+	result, _, err := client.DevicesApi.GetDeviceLiveToolsCableTest(ctx, server.Fixture.MsSerial, cableTest.GetCableTestId()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, utils.LiveToolsStatusComplete, result.GetStatus())
+	assert.Len(t, result.GetResults(), 2)
+}
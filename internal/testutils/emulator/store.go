@@ -0,0 +1,120 @@
+package emulator
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// document is a single JSON object held by the emulator.
+type document = map[string]interface{}
+
+// store holds every object the emulator knows about, keyed by its canonical API path.
+// Collections keep an ordered list of member paths so list endpoints return objects in creation order.
+type store struct {
+	mu    sync.Mutex
+	docs  map[string]document
+	lists map[string][]string
+	seq   int64
+}
+
+func newStore() *store {
+	return &store{
+		docs:  make(map[string]document),
+		lists: make(map[string][]string),
+		seq:   100000,
+	}
+}
+
+// nextId returns a unique identifier with the given prefix.
+func (s *store) nextId(prefix string) string {
+	s.seq++
+	return prefix + itoa(s.seq)
+}
+
+func (s *store) get(path string) (document, bool) {
+	doc, ok := s.docs[path]
+	return doc, ok
+}
+
+// put stores doc at path, replacing any existing object.
+func (s *store) put(path string, doc document) {
+	s.docs[path] = doc
+}
+
+// merge applies a Dashboard style partial update: top level keys in patch replace those in the stored object.
+func (s *store) merge(path string, patch document) document {
+	doc, ok := s.docs[path]
+	if !ok {
+		doc = document{}
+	}
+	for k, v := range patch {
+		doc[k] = v
+	}
+	s.docs[path] = doc
+	return doc
+}
+
+// appendMember registers member as the last element of the collection at list.
+func (s *store) appendMember(list, member string) {
+	for _, m := range s.lists[list] {
+		if m == member {
+			return
+		}
+	}
+	s.lists[list] = append(s.lists[list], member)
+}
+
+// members returns the objects that belong to the collection at list.
+func (s *store) members(list string) []document {
+	result := make([]document, 0, len(s.lists[list]))
+	for _, m := range s.lists[list] {
+		if doc, ok := s.docs[m]; ok {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
+
+// delete removes path, everything nested under it and any collection membership that refers to it.
+func (s *store) delete(path string) {
+	prefix := path + "/"
+	for k := range s.docs {
+		if k == path || strings.HasPrefix(k, prefix) {
+			delete(s.docs, k)
+		}
+	}
+	for list, members := range s.lists {
+		if list == path || strings.HasPrefix(list, prefix) {
+			delete(s.lists, list)
+			continue
+		}
+		kept := members[:0]
+		for _, m := range members {
+			if m != path && !strings.HasPrefix(m, prefix) {
+				kept = append(kept, m)
+			}
+		}
+		s.lists[list] = kept
+	}
+}
+
+// filter returns the objects stored directly under root whose field equals value, ordered by path.
+func (s *store) filter(root, field, value string) []document {
+	var keys []string
+	for k, doc := range s.docs {
+		if parent, _ := splitLast(k); parent != root {
+			continue
+		}
+		if v, ok := doc[field].(string); ok && v == value {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	result := make([]document, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, s.docs[k])
+	}
+	return result
+}
@@ -0,0 +1,321 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MaxActionBatchSize is the number of actions the Dashboard API accepts in one action batch.
+	MaxActionBatchSize = 100
+
+	// MaxSynchronousActionBatchSize is the number of actions the Dashboard API accepts in a synchronous action batch.
+	// Larger batches are submitted asynchronously and polled until they complete.
+	MaxSynchronousActionBatchSize = 20
+
+	defaultActionBatchFlushInterval = 2 * time.Second
+	defaultActionBatchPollInterval  = 2 * time.Second
+	defaultActionBatchPollTimeout   = 10 * time.Minute
+)
+
+// Action batch operations.
+const (
+	BatchOperationCreate  = "create"
+	BatchOperationUpdate  = "update"
+	BatchOperationDestroy = "destroy"
+)
+
+// BatchAction is a single Create, Update or Delete call queued into an action batch.
+type BatchAction struct {
+	// Resource is the API path of the call without the /api/v1 prefix, e.g. /devices/{serial}/switch/ports/{portId}.
+	Resource  string
+	Operation string
+	Body      map[string]interface{}
+}
+
+// NewBatchAction returns a BatchAction with the JSON representation of payload as its body. A nil payload
+// leaves the body empty, which is what destroy operations expect.
+func NewBatchAction(resource, operation string, payload interface{}) (BatchAction, error) {
+	action := BatchAction{Resource: resource, Operation: operation}
+	if payload == nil {
+		return action, nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return action, fmt.Errorf("encoding %s %s action: %w", operation, resource, err)
+	}
+	if err = json.Unmarshal(body, &action.Body); err != nil {
+		return action, fmt.Errorf("encoding %s %s action: %w", operation, resource, err)
+	}
+	return action, nil
+}
+
+// BatchCreatedResource identifies a resource created by a create action.
+type BatchCreatedResource struct {
+	ID  string
+	URI string
+}
+
+// ActionBatchError is returned when the Dashboard reports an action batch as failed. Action batches are atomic,
+// so none of the batch's actions were applied.
+type ActionBatchError struct {
+	BatchID string
+	Errors  []string
+}
+
+func (e *ActionBatchError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("action batch %s failed", e.BatchID)
+	}
+	return fmt.Sprintf("action batch %s failed: %s", e.BatchID, strings.Join(e.Errors, "; "))
+}
+
+// ActionBatcher queues Create, Update and Delete calls from concurrently applied resources and submits them to
+// the Dashboard API as action batches, so that a large apply spends one request per batch instead of one per
+// resource.
+//
+// Actions are queued per organization and submitted once MaxActionBatchSize actions are queued or FlushInterval
+// has passed since the first one was queued. When the Dashboard reports a batch with actions from several resources
+// as failed, each resource's actions are submitted again in a batch of their own, so that every failure is reported
+// against the resource that caused it. Any other error leaves the outcome of the batch unknown, so it is reported to
+// every resource without resubmitting anything.
+type ActionBatcher struct {
+	FlushInterval time.Duration
+	PollInterval  time.Duration
+	PollTimeout   time.Duration
+
+	client        *openApiClient.APIClient
+	retry         RetryPolicy
+	organizations *OrganizationLookup
+
+	mu     sync.Mutex
+	queues map[string]*actionQueue
+}
+
+// actionQueue holds the actions waiting to be submitted for an organization.
+type actionQueue struct {
+	entries []*queuedActions
+	size    int
+	timer   *time.Timer
+}
+
+// queuedActions are the actions submitted by one resource. They are always sent in the same batch.
+type queuedActions struct {
+	ctx     context.Context
+	actions []BatchAction
+	result  chan batchOutcome
+}
+
+type batchOutcome struct {
+	created []BatchCreatedResource
+	err     error
+}
+
+// NewActionBatcher returns an ActionBatcher that submits action batches with client and finds the organization of
+// networks and devices with organizations.
+func NewActionBatcher(client *openApiClient.APIClient, retry RetryPolicy, organizations *OrganizationLookup) *ActionBatcher {
+	return &ActionBatcher{
+		FlushInterval: defaultActionBatchFlushInterval,
+		PollInterval:  defaultActionBatchPollInterval,
+		PollTimeout:   defaultActionBatchPollTimeout,
+		client:        client,
+		retry:         retry,
+		organizations: organizations,
+		queues:        map[string]*actionQueue{},
+	}
+}
+
+// Submit queues actions for the organization and waits until the batch they were sent in has completed. The
+// actions are submitted together and in order. Resources created by create actions are returned in the order of
+// those actions.
+func (b *ActionBatcher) Submit(ctx context.Context, organizationId string, actions ...BatchAction) ([]BatchCreatedResource, error) {
+	if len(actions) == 0 {
+		return nil, nil
+	}
+	if len(actions) > MaxActionBatchSize {
+		return nil, fmt.Errorf("%d actions exceed the action batch limit of %d", len(actions), MaxActionBatchSize)
+	}
+
+	entry := &queuedActions{
+		ctx:     context.WithoutCancel(ctx),
+		actions: actions,
+		result:  make(chan batchOutcome, 1),
+	}
+	b.enqueue(organizationId, entry)
+
+	select {
+	case outcome := <-entry.result:
+		return outcome.created, outcome.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for action batch: %w", ctx.Err())
+	}
+}
+
+// SubmitForNetwork submits actions to the organization of the network.
+func (b *ActionBatcher) SubmitForNetwork(ctx context.Context, networkId string, actions ...BatchAction) ([]BatchCreatedResource, error) {
+	organizationId, err := b.organizations.NetworkOrganization(ctx, networkId)
+	if err != nil {
+		return nil, err
+	}
+	return b.Submit(ctx, organizationId, actions...)
+}
+
+// SubmitForDevice submits actions to the organization of the network the device is in.
+func (b *ActionBatcher) SubmitForDevice(ctx context.Context, serial string, actions ...BatchAction) ([]BatchCreatedResource, error) {
+	organizationId, err := b.organizations.DeviceOrganization(ctx, serial)
+	if err != nil {
+		return nil, err
+	}
+	return b.Submit(ctx, organizationId, actions...)
+}
+
+// enqueue adds entry to the organization's queue. A full queue is submitted right away, otherwise a timer
+// submits the queue after FlushInterval.
+func (b *ActionBatcher) enqueue(organizationId string, entry *queuedActions) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	queue := b.queues[organizationId]
+	if queue != nil && queue.size+len(entry.actions) > MaxActionBatchSize {
+		b.detach(organizationId)
+		queue = nil
+	}
+	if queue == nil {
+		queue = &actionQueue{}
+		b.queues[organizationId] = queue
+		queue.timer = time.AfterFunc(b.FlushInterval, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if b.queues[organizationId] == queue {
+				b.detach(organizationId)
+			}
+		})
+	}
+
+	queue.entries = append(queue.entries, entry)
+	queue.size += len(entry.actions)
+
+	if queue.size == MaxActionBatchSize {
+		b.detach(organizationId)
+	}
+}
+
+// detach removes the organization's queue and submits it in the background. b.mu must be held.
+func (b *ActionBatcher) detach(organizationId string) {
+	queue := b.queues[organizationId]
+	delete(b.queues, organizationId)
+	queue.timer.Stop()
+
+	go b.flush(organizationId, queue.entries)
+}
+
+// flush submits the queued actions as one batch and reports the outcome to every entry.
+func (b *ActionBatcher) flush(organizationId string, entries []*queuedActions) {
+	ctx := entries[0].ctx
+
+	var actions []BatchAction
+	for _, entry := range entries {
+		actions = append(actions, entry.actions...)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Submitting action batch of %d actions from %d resources", len(actions), len(entries)), map[string]interface{}{
+		"organization_id": organizationId,
+	})
+
+	created, err := b.run(ctx, organizationId, actions)
+	if err == nil {
+		for _, entry := range entries {
+			var entryCreated []BatchCreatedResource
+			for _, action := range entry.actions {
+				if action.Operation == BatchOperationCreate && len(created) > 0 {
+					entryCreated = append(entryCreated, created[0])
+					created = created[1:]
+				}
+			}
+			entry.result <- batchOutcome{created: entryCreated}
+		}
+		return
+	}
+
+	// A batch that timed out or could not be polled may still be running or may already have been applied, so
+	// resubmitting its actions could apply them twice
+	var batchErr *ActionBatchError
+	if len(entries) == 1 || !errors.As(err, &batchErr) {
+		for _, entry := range entries {
+			entry.result <- batchOutcome{err: err}
+		}
+		return
+	}
+
+	// Nothing in a failed batch was applied. Submit each resource's actions on their own to find which ones failed.
+	tflog.Warn(ctx, fmt.Sprintf("Action batch failed, resubmitting the actions of %d resources separately: %s", len(entries), err), map[string]interface{}{
+		"organization_id": organizationId,
+	})
+	for _, entry := range entries {
+		created, err := b.run(entry.ctx, organizationId, entry.actions)
+		entry.result <- batchOutcome{created: created, err: err}
+	}
+}
+
+// run creates a confirmed action batch and waits for it to complete.
+func (b *ActionBatcher) run(ctx context.Context, organizationId string, actions []BatchAction) ([]BatchCreatedResource, error) {
+	request := *openApiClient.NewCreateOrganizationActionBatchRequest(nil)
+	request.SetConfirmed(true)
+	request.SetSynchronous(len(actions) <= MaxSynchronousActionBatchSize)
+	for _, action := range actions {
+		inner := *openApiClient.NewCreateOrganizationActionBatchRequestActionsInner(action.Resource, action.Operation)
+		if action.Body != nil {
+			inner.SetBody(action.Body)
+		}
+		request.Actions = append(request.Actions, inner)
+	}
+
+	batch, _, err := CustomHttpRequestRetry(ctx, b.retry, func() (*openApiClient.CreateOrganizationActionBatch201Response, *http.Response, error) {
+		return b.client.OrganizationsApi.CreateOrganizationActionBatch(ctx, organizationId).CreateOrganizationActionBatchRequest(request).Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(b.PollTimeout)
+	for {
+		status := batch.GetStatus()
+		if status.GetFailed() {
+			return nil, &ActionBatchError{BatchID: batch.GetId(), Errors: status.GetErrors()}
+		}
+		if status.GetCompleted() {
+			var created []BatchCreatedResource
+			for _, resource := range status.GetCreatedResources() {
+				created = append(created, BatchCreatedResource{ID: resource.GetId(), URI: resource.GetUri()})
+			}
+			return created, nil
+		}
+
+		if time.Now().Add(b.PollInterval).After(deadline) {
+			return nil, fmt.Errorf("action batch %s did not complete within %s", batch.GetId(), b.PollTimeout)
+		}
+		timer := time.NewTimer(b.PollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("waiting for action batch %s: %w", batch.GetId(), ctx.Err())
+		case <-timer.C:
+		}
+
+		batch, _, err = CustomHttpRequestRetry(ctx, b.retry, func() (*openApiClient.CreateOrganizationActionBatch201Response, *http.Response, error) {
+			return b.client.OrganizationsApi.GetOrganizationActionBatch(ctx, organizationId, batch.GetId()).Execute()
+		})
+		if err != nil {
+			return nil, fmt.Errorf("polling action batch %s: %w", batch.GetId(), err)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// actionBatchTestServer emulates the action batch endpoints. Batches that contain an action whose resource
+// includes "invalid" fail, asynchronous batches complete on the first status poll unless an action's resource
+// includes "slow", and create actions return sequential IDs.
+type actionBatchTestServer struct {
+	mu      sync.Mutex
+	batches [][]openApiClient.CreateOrganizationActionBatchRequestActionsInner
+	polls   int
+	created int
+	pending map[string]openApiClient.CreateOrganizationActionBatch201Response
+}
+
+func newActionBatchTestServer(t *testing.T) (*actionBatchTestServer, *openApiClient.APIClient) {
+	t.Helper()
+
+	s := &actionBatchTestServer{pending: map[string]openApiClient.CreateOrganizationActionBatch201Response{}}
+	server := httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(server.Close)
+
+	configuration := openApiClient.NewConfiguration()
+	configuration.Servers = openApiClient.ServerConfigurations{{URL: server.URL}}
+	return s, openApiClient.NewAPIClient(configuration)
+}
+
+func (s *actionBatchTestServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/networks/"):
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": strings.TrimPrefix(r.URL.Path, "/networks/"), "organizationId": "1"})
+
+	case r.Method == http.MethodPost && r.URL.Path == "/organizations/1/actionBatches":
+		var request openApiClient.CreateOrganizationActionBatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.batches = append(s.batches, request.Actions)
+		id := fmt.Sprintf("batch-%d", len(s.batches))
+
+		status := openApiClient.GetOrganizationActionBatches200ResponseInnerStatus{}
+		var errs []string
+		slow := false
+		for _, action := range request.Actions {
+			if strings.Contains(action.Resource, "invalid") {
+				errs = append(errs, fmt.Sprintf("%s is invalid", action.Resource))
+			}
+			slow = slow || strings.Contains(action.Resource, "slow")
+		}
+		if len(errs) > 0 {
+			status.SetFailed(true)
+			status.SetErrors(errs)
+		} else if !slow {
+			status.SetCompleted(true)
+			status.CreatedResources = []openApiClient.GetOrganizationActionBatches200ResponseInnerStatusCreatedResourcesInner{}
+			for _, action := range request.Actions {
+				if action.Operation == BatchOperationCreate {
+					s.created++
+					resource := openApiClient.GetOrganizationActionBatches200ResponseInnerStatusCreatedResourcesInner{}
+					resource.SetId(fmt.Sprintf("%d", s.created))
+					resource.SetUri(fmt.Sprintf("%s/%d", action.Resource, s.created))
+					status.CreatedResources = append(status.CreatedResources, resource)
+				}
+			}
+		}
+
+		batch := openApiClient.CreateOrganizationActionBatch201Response{}
+		batch.SetId(id)
+		if request.GetSynchronous() {
+			batch.SetStatus(status)
+		} else {
+			s.pending[id] = openApiClient.CreateOrganizationActionBatch201Response{Id: &id, Status: &status}
+			batch.SetStatus(openApiClient.GetOrganizationActionBatches200ResponseInnerStatus{})
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(batch)
+
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/organizations/1/actionBatches/"):
+		s.polls++
+		batch, ok := s.pending[strings.TrimPrefix(r.URL.Path, "/organizations/1/actionBatches/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(batch)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestActionBatcher(client *openApiClient.APIClient) *ActionBatcher {
+	batcher := NewActionBatcher(client, RetryPolicy{}, NewOrganizationLookup(client, RetryPolicy{}))
+	batcher.FlushInterval = 50 * time.Millisecond
+	batcher.PollInterval = 10 * time.Millisecond
+	return batcher
+}
+
+// submitConcurrently submits each group of actions from its own goroutine, the way Terraform applies resources.
+func submitConcurrently(batcher *ActionBatcher, groups ...[]BatchAction) ([][]BatchCreatedResource, []error) {
+	created := make([][]BatchCreatedResource, len(groups))
+	errs := make([]error, len(groups))
+
+	var wg sync.WaitGroup
+	for i, actions := range groups {
+		wg.Add(1)
+		go func(i int, actions []BatchAction) {
+			defer wg.Done()
+			created[i], errs[i] = batcher.Submit(context.Background(), "1", actions...)
+		}(i, actions)
+	}
+	wg.Wait()
+	return created, errs
+}
+
+func TestActionBatcher(t *testing.T) {
+	// Test case: Actions from concurrent resources are sent in one batch and created IDs go back to their resource
+	t.Run("combines resources", func(t *testing.T) {
+		server, client := newActionBatchTestServer(t)
+		batcher := newTestActionBatcher(client)
+
+		created, errs := submitConcurrently(batcher,
+			[]BatchAction{{Resource: "/devices/Q2XX-XXXX-XXXX/switch/ports/1", Operation: BatchOperationUpdate}},
+			[]BatchAction{
+				{Resource: "/networks/N_1/appliance/vlans", Operation: BatchOperationCreate, Body: map[string]interface{}{"id": "10"}},
+				{Resource: "/networks/N_1/appliance/vlans/10", Operation: BatchOperationUpdate},
+			},
+		)
+		require.NoError(t, errs[0])
+		require.NoError(t, errs[1])
+
+		require.Len(t, server.batches, 1)
+		assert.Len(t, server.batches[0], 3)
+		assert.Empty(t, created[0])
+		require.Len(t, created[1], 1)
+		assert.Equal(t, "1", created[1][0].ID)
+	})
+
+	// Test case: A failed batch is split up so that only the resource with the invalid action reports an error
+	t.Run("maps failures to resources", func(t *testing.T) {
+		server, client := newActionBatchTestServer(t)
+		batcher := newTestActionBatcher(client)
+
+		_, errs := submitConcurrently(batcher,
+			[]BatchAction{{Resource: "/devices/Q2XX-XXXX-XXXX/switch/ports/1", Operation: BatchOperationUpdate}},
+			[]BatchAction{{Resource: "/devices/Q2XX-XXXX-XXXX/switch/ports/invalid", Operation: BatchOperationUpdate}},
+		)
+		assert.NoError(t, errs[0])
+		require.Error(t, errs[1])
+		assert.Contains(t, errs[1].Error(), "/devices/Q2XX-XXXX-XXXX/switch/ports/invalid is invalid")
+
+		var batchErr *ActionBatchError
+		assert.ErrorAs(t, errs[1], &batchErr)
+		assert.Len(t, server.batches, 3)
+	})
+
+	// Test case: A batch that does not complete is reported to every resource and never resubmitted
+	t.Run("timeout is not resubmitted", func(t *testing.T) {
+		server, client := newActionBatchTestServer(t)
+		batcher := newTestActionBatcher(client)
+		batcher.PollTimeout = 50 * time.Millisecond
+
+		var actions []BatchAction
+		for i := 0; i < MaxSynchronousActionBatchSize; i++ {
+			actions = append(actions, BatchAction{Resource: fmt.Sprintf("/networks/N_1/appliance/staticRoutes/%d", i), Operation: BatchOperationUpdate})
+		}
+
+		_, errs := submitConcurrently(batcher,
+			actions,
+			[]BatchAction{{Resource: "/networks/N_1/appliance/staticRoutes/slow", Operation: BatchOperationCreate}},
+		)
+		for _, err := range errs {
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "batch-1")
+		}
+		assert.Len(t, server.batches, 1)
+	})
+
+	// Test case: Batches larger than the synchronous limit are submitted asynchronously and polled
+	t.Run("asynchronous", func(t *testing.T) {
+		server, client := newActionBatchTestServer(t)
+		batcher := newTestActionBatcher(client)
+
+		var actions []BatchAction
+		for i := 0; i <= MaxSynchronousActionBatchSize; i++ {
+			actions = append(actions, BatchAction{Resource: fmt.Sprintf("/devices/Q2XX-XXXX-XXXX/switch/ports/%d", i), Operation: BatchOperationUpdate})
+		}
+
+		_, err := batcher.Submit(context.Background(), "1", actions...)
+		require.NoError(t, err)
+		assert.Equal(t, 1, server.polls)
+	})
+
+	// Test case: Too many actions from one resource are rejected
+	t.Run("too many actions", func(t *testing.T) {
+		_, client := newActionBatchTestServer(t)
+		batcher := newTestActionBatcher(client)
+
+		_, err := batcher.Submit(context.Background(), "1", make([]BatchAction, MaxActionBatchSize+1)...)
+		assert.Error(t, err)
+	})
+
+}
+
+func TestNewBatchAction(t *testing.T) {
+	payload := *openApiClient.NewUpdateDeviceSwitchPortRequest()
+	payload.SetName("uplink")
+	payload.SetEnabled(true)
+
+	action, err := NewBatchAction("/devices/Q2XX-XXXX-XXXX/switch/ports/1", BatchOperationUpdate, payload)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "uplink", "enabled": true}, action.Body)
+
+	action, err = NewBatchAction("/networks/N_1/appliance/vlans/10", BatchOperationDestroy, nil)
+	require.NoError(t, err)
+	assert.Nil(t, action.Body)
+}
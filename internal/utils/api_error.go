@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// MerakiAPIError describes a failed Dashboard API call. The Dashboard reports failures as a JSON payload of the form
+// {"errors": ["..."]}, which is parsed into Errors so that diagnostics can show the messages on their own.
+type MerakiAPIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	RequestID  string
+	Errors     []string
+
+	// Body holds the response body when it does not carry an errors array.
+	Body string
+
+	// Err is the error returned by the API client, if any.
+	Err error
+}
+
+// apiErrorRule maps a known Dashboard failure to an actionable summary.
+type apiErrorRule struct {
+	statusCode int
+	methods    []string
+	pattern    *regexp.Regexp
+	summary    string
+	hint       string
+}
+
+// apiErrorRules are checked in order and the first match wins. A zero statusCode matches any status, nil methods
+// match any method and a nil pattern matches any message.
+var apiErrorRules = []apiErrorRule{
+	{
+		statusCode: http.StatusBadRequest,
+		pattern:    regexp.MustCompile(`(?i)(product ?type|not bound to|only supports? .*networks?|does not have an? (appliance|switch|wireless|camera|cellular gateway|sensor))`),
+		summary:    "Network not bound to product type",
+		hint:       "The network does not include the product type this resource configures. Add the product type to the network's product_types or claim a device of that type into the network.",
+	},
+	{
+		statusCode: http.StatusBadRequest,
+		pattern:    regexp.MustCompile(`(?i)(serial .*(not found|invalid|already claimed)|device .*not (found|in (this|the) (network|organization)))`),
+		summary:    "Device not available",
+		hint:       "Check that the serial is correct and that the device is claimed into the organization's inventory.",
+	},
+	{
+		statusCode: http.StatusUnauthorized,
+		summary:    "Invalid Meraki API key",
+		hint:       "Check the api_key provider setting or the MERAKI_DASHBOARD_API_KEY environment variable.",
+	},
+	{
+		statusCode: http.StatusForbidden,
+		methods:    []string{http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch},
+		summary:    "API key lacks org write access",
+		hint:       "The API key's administrator needs full (read-write) access to the organization or network.",
+	},
+	{
+		statusCode: http.StatusForbidden,
+		summary:    "API key lacks org read access",
+		hint:       "The API key's administrator does not have access to this organization or network.",
+	},
+	{
+		statusCode: http.StatusNotFound,
+		summary:    "Resource not found",
+		hint:       "The resource, or the organization or network that contains it, does not exist or is not visible to the API key.",
+	},
+	{
+		statusCode: http.StatusTooManyRequests,
+		summary:    "Dashboard API rate limit exceeded",
+		hint:       "Lower requests_per_second or Terraform's -parallelism, or raise maximum_retries and retry_max_elapsed_time.",
+	},
+}
+
+// NewMerakiAPIError builds a MerakiAPIError from an API client response and error. The response body is read
+// and then restored, so that it can still be read by the caller.
+func NewMerakiAPIError(httpResp *http.Response, err error) *MerakiAPIError {
+	apiErr := &MerakiAPIError{Err: err}
+
+	if httpResp == nil {
+		return apiErr
+	}
+
+	apiErr.StatusCode = httpResp.StatusCode
+	apiErr.RequestID = httpResp.Header.Get("X-Request-Id")
+	if httpResp.Request != nil {
+		apiErr.Method = httpResp.Request.Method
+		if httpResp.Request.URL != nil {
+			apiErr.Endpoint = httpResp.Request.URL.Path
+		}
+	}
+
+	if httpResp.Body != nil {
+		body, readErr := io.ReadAll(httpResp.Body)
+		_ = httpResp.Body.Close()
+		httpResp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr == nil {
+			apiErr.parseBody(body)
+		}
+	}
+
+	return apiErr
+}
+
+// AsMerakiAPIError returns err as a MerakiAPIError when it is one, or builds one from the response otherwise.
+func AsMerakiAPIError(httpResp *http.Response, err error) *MerakiAPIError {
+	var apiErr *MerakiAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return NewMerakiAPIError(httpResp, err)
+}
+
+// parseBody extracts the errors array from a Dashboard error payload.
+func (e *MerakiAPIError) parseBody(body []byte) {
+	var payload struct {
+		Errors []string `json:"errors"`
+	}
+	if json.Unmarshal(body, &payload) == nil && len(payload.Errors) > 0 {
+		e.Errors = payload.Errors
+		return
+	}
+	e.Body = strings.TrimSpace(string(body))
+}
+
+// Error implements the error interface.
+func (e *MerakiAPIError) Error() string {
+	if e.StatusCode == 0 {
+		if e.Err != nil {
+			return fmt.Sprintf("no response from the Meraki Dashboard API: %s", e.Err)
+		}
+		return "no response from the Meraki Dashboard API"
+	}
+
+	message := fmt.Sprintf("%s %s returned %d", e.Method, e.Endpoint, e.StatusCode)
+	if details := e.messages(); details != "" {
+		message += ": " + details
+	}
+	return message
+}
+
+// Unwrap returns the error reported by the API client.
+func (e *MerakiAPIError) Unwrap() error {
+	return e.Err
+}
+
+// messages joins the parsed errors, falling back to the raw body.
+func (e *MerakiAPIError) messages() string {
+	if len(e.Errors) > 0 {
+		return strings.Join(e.Errors, "; ")
+	}
+	return e.Body
+}
+
+// rule returns the first apiErrorRule matching the error, or nil.
+func (e *MerakiAPIError) rule() *apiErrorRule {
+	message := e.messages()
+	for i := range apiErrorRules {
+		rule := &apiErrorRules[i]
+		if rule.statusCode != 0 && rule.statusCode != e.StatusCode {
+			continue
+		}
+		if rule.methods != nil && !containsString(rule.methods, e.Method) {
+			continue
+		}
+		if rule.pattern != nil && !rule.pattern.MatchString(message) {
+			continue
+		}
+		return rule
+	}
+	return nil
+}
+
+// Summary returns a short, actionable description of the failure.
+func (e *MerakiAPIError) Summary() string {
+	if rule := e.rule(); rule != nil {
+		return rule.summary
+	}
+	switch {
+	case e.StatusCode == 0:
+		return "Unable to reach the Meraki Dashboard API"
+	case e.StatusCode >= 500:
+		return "Meraki Dashboard API server error"
+	default:
+		return fmt.Sprintf("Meraki Dashboard API error (HTTP %d)", e.StatusCode)
+	}
+}
+
+// Detail returns the Dashboard's error messages followed by the request details needed to report the failure.
+func (e *MerakiAPIError) Detail() string {
+	var detail strings.Builder
+
+	if e.StatusCode == 0 {
+		detail.WriteString(e.Error())
+		detail.WriteString("\n\nCheck network connectivity, base_url and proxy settings.")
+		return detail.String()
+	}
+
+	switch {
+	case len(e.Errors) == 1:
+		detail.WriteString(e.Errors[0])
+		detail.WriteString("\n")
+	case len(e.Errors) > 1:
+		for _, message := range e.Errors {
+			detail.WriteString("- " + message + "\n")
+		}
+	case e.Body != "":
+		detail.WriteString(e.Body)
+		detail.WriteString("\n")
+	case e.Err != nil:
+		detail.WriteString(e.Err.Error())
+		detail.WriteString("\n")
+	}
+
+	if rule := e.rule(); rule != nil && rule.hint != "" {
+		detail.WriteString("\n" + rule.hint + "\n")
+	}
+
+	detail.WriteString(fmt.Sprintf("\nStatus: %d\nEndpoint: %s %s", e.StatusCode, e.Method, e.Endpoint))
+	if e.RequestID != "" {
+		detail.WriteString("\nRequest ID: " + e.RequestID)
+	}
+	return detail.String()
+}
+
+// NewAPIErrorDiagnostic returns an error diagnostic for a failed Dashboard API call. operation describes what the
+// provider was doing, for example "Error creating network", and prefixes the actionable summary.
+func NewAPIErrorDiagnostic(operation string, httpResp *http.Response, err error) diag.Diagnostic {
+	apiErr := AsMerakiAPIError(httpResp, err)
+	return diag.NewErrorDiagnostic(fmt.Sprintf("%s: %s", operation, apiErr.Summary()), apiErr.Detail())
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// newAPIErrorResponse builds a response in the shape returned by the generated API client.
+func newAPIErrorResponse(method string, statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"X-Request-Id": []string{"req-123"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request: &http.Request{
+			Method: method,
+			URL:    &url.URL{Scheme: "https", Host: "api.meraki.com", Path: "/api/v1/organizations/123/networks"},
+		},
+	}
+}
+
+func TestMerakiAPIError(t *testing.T) {
+	// Test case: The errors array, status, endpoint and request ID are recorded
+	t.Run("parse errors array", func(t *testing.T) {
+		httpResp := newAPIErrorResponse(http.MethodPost, http.StatusBadRequest, `{"errors":["Name has already been taken","Time zone is invalid"]}`)
+
+		apiErr := NewMerakiAPIError(httpResp, errors.New("400 Bad Request"))
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.Equal(t, http.MethodPost, apiErr.Method)
+		assert.Equal(t, "/api/v1/organizations/123/networks", apiErr.Endpoint)
+		assert.Equal(t, "req-123", apiErr.RequestID)
+		assert.Equal(t, []string{"Name has already been taken", "Time zone is invalid"}, apiErr.Errors)
+		assert.Equal(t, "POST /api/v1/organizations/123/networks returned 400: Name has already been taken; Time zone is invalid", apiErr.Error())
+		assert.Equal(t, "Meraki Dashboard API error (HTTP 400)", apiErr.Summary())
+
+		detail := apiErr.Detail()
+		assert.Contains(t, detail, "- Name has already been taken\n- Time zone is invalid")
+		assert.Contains(t, detail, "Request ID: req-123")
+
+		// The body remains readable for the caller
+		body, err := io.ReadAll(httpResp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "Name has already been taken")
+	})
+
+	// Test case: Bodies without an errors array are kept verbatim
+	t.Run("raw body", func(t *testing.T) {
+		apiErr := NewMerakiAPIError(newAPIErrorResponse(http.MethodGet, http.StatusBadGateway, "<html>Bad Gateway</html>"), nil)
+		assert.Nil(t, apiErr.Errors)
+		assert.Equal(t, "<html>Bad Gateway</html>", apiErr.Body)
+		assert.Equal(t, "Meraki Dashboard API server error", apiErr.Summary())
+	})
+
+	// Test case: Transport errors without a response
+	t.Run("no response", func(t *testing.T) {
+		cause := errors.New("dial tcp: connection refused")
+		apiErr := NewMerakiAPIError(nil, cause)
+		assert.ErrorIs(t, apiErr, cause)
+		assert.Equal(t, "Unable to reach the Meraki Dashboard API", apiErr.Summary())
+		assert.Contains(t, apiErr.Detail(), "connection refused")
+	})
+
+	// Test case: Errors returned by CustomHttpRequestRetry are reused rather than parsed again
+	t.Run("as meraki api error", func(t *testing.T) {
+		apiErr := NewMerakiAPIError(newAPIErrorResponse(http.MethodGet, http.StatusNotFound, `{"errors":["Not found"]}`), nil)
+		wrapped := errors.Join(errors.New("after 0 retries"), apiErr)
+		assert.Same(t, apiErr, AsMerakiAPIError(nil, wrapped))
+	})
+}
+
+func TestMerakiAPIErrorSummary(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		status  int
+		body    string
+		summary string
+	}{
+		{"invalid api key", http.MethodGet, http.StatusUnauthorized, `{"errors":["Invalid API key"]}`, "Invalid Meraki API key"},
+		{"read only api key", http.MethodPut, http.StatusForbidden, `{"errors":["Forbidden"]}`, "API key lacks org write access"},
+		{"no org access", http.MethodGet, http.StatusForbidden, `{"errors":["Forbidden"]}`, "API key lacks org read access"},
+		{"not found", http.MethodGet, http.StatusNotFound, `{"errors":["Not found"]}`, "Resource not found"},
+		{"rate limited", http.MethodGet, http.StatusTooManyRequests, `{"errors":["API rate limit exceeded for organization"]}`, "Dashboard API rate limit exceeded"},
+		{"product type", http.MethodPut, http.StatusBadRequest, `{"errors":["This endpoint only supports wireless networks"]}`, "Network not bound to product type"},
+		{"missing appliance", http.MethodPut, http.StatusBadRequest, `{"errors":["Network does not have an appliance"]}`, "Network not bound to product type"},
+		{"unknown serial", http.MethodPost, http.StatusBadRequest, `{"errors":["Device with serial Q2XX-XXXX-XXXX not found"]}`, "Device not available"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := NewMerakiAPIError(newAPIErrorResponse(tt.method, tt.status, tt.body), nil)
+			assert.Equal(t, tt.summary, apiErr.Summary())
+		})
+	}
+}
+
+func TestNewAPIErrorDiagnostic(t *testing.T) {
+	httpResp := newAPIErrorResponse(http.MethodDelete, http.StatusForbidden, `{"errors":["You do not have write access to this organization"]}`)
+
+	d := NewAPIErrorDiagnostic("Error deleting network", httpResp, errors.New("403 Forbidden"))
+	assert.Equal(t, diag.SeverityError, d.Severity())
+	assert.Equal(t, "Error deleting network: API key lacks org write access", d.Summary())
+	assert.Contains(t, d.Detail(), "You do not have write access to this organization")
+	assert.Contains(t, d.Detail(), "Endpoint: DELETE /api/v1/organizations/123/networks")
+}
+
+func TestHandleAPIError(t *testing.T) {
+	var diags diag.Diagnostics
+
+	ok := &http.Response{StatusCode: http.StatusOK}
+	assert.NoError(t, HandleAPIError(context.Background(), ok, nil, &diags))
+	assert.False(t, diags.HasError())
+
+	err := HandleAPIError(context.Background(), newAPIErrorResponse(http.MethodGet, http.StatusNotFound, `{"errors":["Not found"]}`), nil, &diags)
+	var apiErr *MerakiAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, []string{"Not found"}, apiErr.Errors)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "API Error: Resource not found", diags[0].Summary())
+}
@@ -9,31 +9,133 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/argon2"
 	"io"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 )
 
-// Encrypt encrypts the given plaintext with the provided key.
+const (
+	// ciphertextPrefix marks values written by Encrypt. Values without it are either plaintext or legacy AES-CFB
+	// ciphertext written by earlier versions of the provider.
+	ciphertextPrefix = "enc:"
+
+	// ciphertextVersion identifies AES-256-GCM with an Argon2id derived key. It is authenticated as additional data
+	// so that a value cannot be replayed under a different version.
+	ciphertextVersion = "v2"
+
+	saltSize = 16
+
+	// Argon2id parameters, following the OWASP minimum recommendation.
+	kdfTime    = 2
+	kdfMemory  = 19 * 1024
+	kdfThreads = 1
+	kdfKeySize = 32
+)
+
+// ErrCiphertextAuthentication is returned when an encrypted value fails authentication, either because it was
+// modified or because it was encrypted with a key that is no longer configured.
+var ErrCiphertextAuthentication = errors.New("encrypted value failed authentication: it was modified, or it was encrypted with a key that is not set in encryption_key or previous_encryption_keys")
+
+// derivedKeys caches Argon2id output by passphrase and salt, since every refresh decrypts each sensitive value.
+var derivedKeys sync.Map
+
+// Encrypt encrypts the given plaintext with the provided key using AES-256-GCM. The returned value carries a
+// version prefix followed by the base64 encoded salt, nonce and sealed plaintext.
 func Encrypt(key, text string) (string, error) {
-	block, err := aes.NewCipher([]byte(createHash(key)))
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(key, salt)
 	if err != nil {
 		return "", err
 	}
-	plaintext := []byte(text)
-	ciphertext := make([]byte, aes.BlockSize+len(plaintext))
-	iv := ciphertext[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return "", err
 	}
-	stream := cipher.NewCFBEncrypter(block, iv)
-	stream.XORKeyStream(ciphertext[aes.BlockSize:], plaintext)
-	return base64.URLEncoding.EncodeToString(ciphertext), nil
+
+	header := ciphertextPrefix + ciphertextVersion + ":"
+	data := append(append(salt, nonce...), gcm.Seal(nil, nonce, []byte(text), []byte(header))...)
+	return header + base64.RawURLEncoding.EncodeToString(data), nil
 }
 
-// Decrypt decrypts the given ciphertext with the provided key.
+// Decrypt decrypts a value produced by Encrypt, or legacy AES-CFB ciphertext, with the provided key.
+// ErrCiphertextAuthentication is returned when a versioned value fails authentication.
 func Decrypt(key, cryptoText string) (string, error) {
-	block, err := aes.NewCipher([]byte(createHash(key)))
+	if !IsEncrypted(cryptoText) {
+		return decryptLegacy(key, cryptoText)
+	}
+
+	version, encoded, ok := strings.Cut(strings.TrimPrefix(cryptoText, ciphertextPrefix), ":")
+	if !ok || version != ciphertextVersion {
+		return "", fmt.Errorf("unsupported encrypted value version %q", version)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	if len(data) < saltSize {
+		return "", errors.New("malformed encrypted value: ciphertext too short")
+	}
+
+	gcm, err := newGCM(key, data[:saltSize])
+	if err != nil {
+		return "", err
+	}
+
+	data = data[saltSize:]
+	if len(data) < gcm.NonceSize()+gcm.Overhead() {
+		return "", errors.New("malformed encrypted value: ciphertext too short")
+	}
+
+	header := ciphertextPrefix + version + ":"
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], []byte(header))
+	if err != nil {
+		return "", ErrCiphertextAuthentication
+	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted reports whether value carries the versioned ciphertext prefix written by Encrypt.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, ciphertextPrefix)
+}
+
+func newGCM(key string, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(deriveKey(key, salt))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// deriveKey derives an AES-256 key from the given key and salt with Argon2id.
+func deriveKey(key string, salt []byte) []byte {
+	cacheKey := key + "\x00" + string(salt)
+	if derived, ok := derivedKeys.Load(cacheKey); ok {
+		return derived.([]byte)
+	}
+
+	derived := argon2.IDKey([]byte(key), salt, kdfTime, kdfMemory, kdfThreads, kdfKeySize)
+	derivedKeys.Store(cacheKey, derived)
+	return derived
+}
+
+// decryptLegacy decrypts unversioned AES-CFB ciphertext written by earlier versions of the provider.
+// CFB mode is unauthenticated, so decrypting with the wrong key yields random bytes rather than an error.
+func decryptLegacy(key, cryptoText string) (string, error) {
+	block, err := aes.NewCipher(createHash(key))
 	if err != nil {
 		return "", err
 	}
@@ -51,54 +153,147 @@ func Decrypt(key, cryptoText string) (string, error) {
 	return string(ciphertext), nil
 }
 
-// createHash creates a hash of the given key.
-func createHash(key string) string {
-	hash := sha256.New()
-	hash.Write([]byte(key))
-	return base64.URLEncoding.EncodeToString(hash.Sum(nil))
+// createHash derives the 32 byte AES-256 key used by legacy AES-CFB ciphertext.
+func createHash(key string) []byte {
+	hash := sha256.Sum256([]byte(key))
+	return hash[:]
+}
+
+// EncryptionKeys holds the provider's encryption_key along with the previous_encryption_keys that
+// state values may still be encrypted with.
+type EncryptionKeys struct {
+	Key          string
+	PreviousKeys []string
+}
+
+// Enabled reports whether sensitive values should be encrypted in state.
+func (k EncryptionKeys) Enabled() bool {
+	return k.Key != ""
 }
 
-type SensitivePlanModifier struct {
-	encryptionKey string
+// Encrypt encrypts plaintext with the current key. The value is returned unchanged when no key is configured.
+func (k EncryptionKeys) Encrypt(plaintext string) (string, error) {
+	if !k.Enabled() {
+		return plaintext, nil
+	}
+	return Encrypt(k.Key, plaintext)
 }
 
+// Decrypt recovers the plaintext of a state value. The current key is tried first, followed by each
+// previous key in order. current reports whether value is already encrypted with the current key in the
+// current format, so legacy AES-CFB values are reported as not current and upgraded by Seal.
+// Unversioned values that cannot be decrypted with any key are treated as plaintext, which is how they are
+// stored before the first refresh or when no key is configured. Versioned values that fail authentication
+// with every key return ErrCiphertextAuthentication.
+func (k EncryptionKeys) Decrypt(value string) (plaintext string, current bool, err error) {
+	if value == "" {
+		return value, !k.Enabled(), nil
+	}
+
+	keys := make([]string, 0, len(k.PreviousKeys)+1)
+	for _, key := range append([]string{k.Key}, k.PreviousKeys...) {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	if IsEncrypted(value) {
+		if len(keys) == 0 {
+			return "", false, errors.New("value is encrypted but no encryption_key is configured")
+		}
+
+		err = ErrCiphertextAuthentication
+		for _, key := range keys {
+			decrypted, decryptErr := Decrypt(key, value)
+			if decryptErr == nil {
+				return decrypted, key == k.Key, nil
+			}
+			if !errors.Is(decryptErr, ErrCiphertextAuthentication) {
+				err = decryptErr
+			}
+		}
+		return "", false, err
+	}
+
+	for _, key := range keys {
+		decrypted, decryptErr := decryptLegacy(key, value)
+		if decryptErr == nil && isPrintable(decrypted) {
+			return decrypted, false, nil
+		}
+	}
+
+	return value, !k.Enabled(), nil
+}
+
+// Seal returns value encrypted with the current key. Values already encrypted with the current key are
+// returned unchanged so that refreshes do not produce a new ciphertext each time, while values written
+// in plaintext or with a previous key are re-encrypted.
+func (k EncryptionKeys) Seal(value string) (string, error) {
+	plaintext, current, err := k.Decrypt(value)
+	if err != nil || current {
+		return value, err
+	}
+	return k.Encrypt(plaintext)
+}
+
+// Equivalent reports whether the state value decrypts to the configured plaintext.
+func (k EncryptionKeys) Equivalent(stateValue, configValue string) bool {
+	plaintext, _, err := k.Decrypt(stateValue)
+	return err == nil && plaintext == configValue
+}
+
+// NewEncryptionDiagnostic returns an attribute error for a sensitive value that could not be encrypted or decrypted.
+func NewEncryptionDiagnostic(p path.Path, err error) diag.Diagnostic {
+	if errors.Is(err, ErrCiphertextAuthentication) {
+		return diag.NewAttributeErrorDiagnostic(
+			p,
+			"Encrypted Value Failed Authentication",
+			"The encrypted value stored in state could not be authenticated, so it will not be sent to the Meraki Dashboard. "+
+				"It was either modified outside of Terraform or encrypted with a key that is no longer configured. "+
+				"If encryption_key was changed, add the former key to previous_encryption_keys. "+
+				"Otherwise remove the resource from state and import it again.",
+		)
+	}
+	return diag.NewAttributeErrorDiagnostic(p, "Error Processing Encrypted Value", err.Error())
+}
+
+// isPrintable reports whether a legacy decrypted value looks like text, which is the only way to tell whether
+// unauthenticated AES-CFB ciphertext was decrypted with the right key.
+func isPrintable(value string) bool {
+	if !utf8.ValidString(value) {
+		return false
+	}
+	for _, r := range value {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// SensitivePlanModifier preserves the prior state value of a sensitive attribute when it is not configured.
+// Encryption is applied by the owning resource, since schemas are built before the provider is configured
+// and cannot see the encryption keys.
+type SensitivePlanModifier struct{}
+
 func (m SensitivePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-	// If password is not set in the plan, preserve the value from the state
 	// Preserve the value from the state if the config value is null or unknown
 	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
 		resp.PlanValue = req.StateValue
 		return
 	}
 
-	var err error
-	var planValue string
-
-	if m.encryptionKey != "" {
-		// Encrypt the new password and set it in the plan
-		planValue, err = Encrypt(m.encryptionKey, req.ConfigValue.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Encrypting Value",
-				fmt.Sprintf("Could not Encrypt the password: %s", err),
-			)
-			return
-		}
-	} else {
-		// If encryption key is not set, use the plain value directly
-		planValue = req.ConfigValue.ValueString()
-	}
-
-	resp.PlanValue = types.StringValue(planValue)
+	resp.PlanValue = types.StringValue(req.ConfigValue.ValueString())
 }
 
 func (m SensitivePlanModifier) Description(ctx context.Context) string {
-	return "Custom plan modifier to hash or Encrypt sensitive strings."
+	return "Preserves the prior state of sensitive strings that are not configured."
 }
 
 func (m SensitivePlanModifier) MarkdownDescription(ctx context.Context) string {
-	return "Custom plan modifier to hash or Encrypt sensitive strings."
+	return "Preserves the prior state of sensitive strings that are not configured."
 }
 
-func NewSensitivePlanModifier(encryptionKey string) planmodifier.String {
-	return SensitivePlanModifier{encryptionKey: encryptionKey}
+func NewSensitivePlanModifier() planmodifier.String {
+	return SensitivePlanModifier{}
 }
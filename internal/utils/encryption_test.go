@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptionKeys(t *testing.T) {
+	const secret = "supersecret"
+
+	// Test case: No key configured
+	t.Run("disabled", func(t *testing.T) {
+		keys := EncryptionKeys{}
+
+		sealed, err := keys.Seal(secret)
+		require.NoError(t, err)
+		assert.Equal(t, secret, sealed, "Expected values to be stored in plaintext without a key")
+
+		plaintext, current, err := keys.Decrypt(sealed)
+		require.NoError(t, err)
+		assert.Equal(t, secret, plaintext)
+		assert.True(t, current)
+	})
+
+	// Test case: Plaintext state is encrypted on the next refresh
+	t.Run("seal plaintext", func(t *testing.T) {
+		keys := EncryptionKeys{Key: "current"}
+
+		_, current, err := keys.Decrypt(secret)
+		require.NoError(t, err)
+		assert.False(t, current, "Expected plaintext to require sealing")
+
+		sealed, err := keys.Seal(secret)
+		require.NoError(t, err)
+		assert.NotEqual(t, secret, sealed)
+		assert.True(t, keys.Equivalent(sealed, secret))
+
+		// Sealing again must not produce a new ciphertext
+		resealed, err := keys.Seal(sealed)
+		require.NoError(t, err)
+		assert.Equal(t, sealed, resealed)
+	})
+
+	// Test case: Values encrypted with a previous key are rotated to the current key
+	t.Run("rotate previous key", func(t *testing.T) {
+		previous, err := Encrypt("old", secret)
+		require.NoError(t, err)
+
+		keys := EncryptionKeys{Key: "current", PreviousKeys: []string{"older", "old"}}
+
+		plaintext, current, err := keys.Decrypt(previous)
+		require.NoError(t, err)
+		assert.Equal(t, secret, plaintext)
+		assert.False(t, current)
+		assert.True(t, keys.Equivalent(previous, secret))
+
+		rotated, err := keys.Seal(previous)
+		require.NoError(t, err)
+		assert.NotEqual(t, previous, rotated)
+
+		decrypted, err := Decrypt("current", rotated)
+		require.NoError(t, err)
+		assert.Equal(t, secret, decrypted)
+	})
+
+	// Test case: A changed configuration is not equivalent to the encrypted state
+	t.Run("changed value", func(t *testing.T) {
+		keys := EncryptionKeys{Key: "current"}
+
+		sealed, err := keys.Seal(secret)
+		require.NoError(t, err)
+		assert.False(t, keys.Equivalent(sealed, "othersecret"))
+	})
+
+	// Test case: Legacy AES-CFB values are readable and upgraded to the versioned format
+	t.Run("upgrade legacy ciphertext", func(t *testing.T) {
+		legacy := encryptLegacy(t, "current", secret)
+		keys := EncryptionKeys{Key: "current"}
+
+		plaintext, current, err := keys.Decrypt(legacy)
+		require.NoError(t, err)
+		assert.Equal(t, secret, plaintext)
+		assert.False(t, current, "Expected legacy ciphertext to require sealing")
+
+		upgraded, err := keys.Seal(legacy)
+		require.NoError(t, err)
+		assert.True(t, IsEncrypted(upgraded))
+		assert.True(t, keys.Equivalent(upgraded, secret))
+	})
+
+	// Test case: Modified ciphertext is rejected rather than decrypted into garbage
+	t.Run("tampered ciphertext", func(t *testing.T) {
+		keys := EncryptionKeys{Key: "current"}
+
+		sealed, err := keys.Seal(secret)
+		require.NoError(t, err)
+
+		data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(sealed, "enc:v2:"))
+		require.NoError(t, err)
+		data[len(data)-1] ^= 0x01
+		tampered := "enc:v2:" + base64.RawURLEncoding.EncodeToString(data)
+
+		_, _, err = keys.Decrypt(tampered)
+		assert.ErrorIs(t, err, ErrCiphertextAuthentication)
+
+		_, err = keys.Seal(tampered)
+		assert.ErrorIs(t, err, ErrCiphertextAuthentication)
+		assert.False(t, keys.Equivalent(tampered, secret))
+	})
+
+	// Test case: A value encrypted with a key that is no longer configured fails authentication
+	t.Run("unknown key", func(t *testing.T) {
+		sealed, err := Encrypt("forgotten", secret)
+		require.NoError(t, err)
+
+		_, _, err = EncryptionKeys{Key: "current", PreviousKeys: []string{"old"}}.Decrypt(sealed)
+		assert.ErrorIs(t, err, ErrCiphertextAuthentication)
+	})
+
+	// Test case: Unknown ciphertext versions are rejected
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := Decrypt("current", "enc:v9:AAAA")
+		assert.ErrorContains(t, err, "unsupported encrypted value version")
+	})
+}
+
+// encryptLegacy produces AES-CFB ciphertext in the format written by earlier versions of the provider.
+func encryptLegacy(t *testing.T, key, text string) string {
+	t.Helper()
+
+	block, err := aes.NewCipher(createHash(key))
+	require.NoError(t, err)
+
+	ciphertext := make([]byte, aes.BlockSize+len(text))
+	_, err = io.ReadFull(rand.Reader, ciphertext[:aes.BlockSize])
+	require.NoError(t, err)
+
+	cipher.NewCFBEncrypter(block, ciphertext[:aes.BlockSize]).XORKeyStream(ciphertext[aes.BlockSize:], []byte(text))
+	return base64.URLEncoding.EncodeToString(ciphertext)
+}
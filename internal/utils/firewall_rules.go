@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// DefaultFirewallRuleComment is the comment of the default rule that the Dashboard API appends to the rules of the
+// appliance L3, inbound and cellular firewalls.
+const DefaultFirewallRuleComment = "Default rule"
+
+// FirewallRuleModel is a rule of the appliance L3, inbound or cellular firewall.
+type FirewallRuleModel struct {
+	Comment       jsontypes.String `tfsdk:"comment" json:"comment"`
+	DestCidr      jsontypes.String `tfsdk:"dest_cidr" json:"destCidr"`
+	DestPort      jsontypes.String `tfsdk:"dest_port" json:"destPort"`
+	Policy        jsontypes.String `tfsdk:"policy" json:"policy"`
+	Protocol      jsontypes.String `tfsdk:"protocol" json:"protocol"`
+	SrcPort       jsontypes.String `tfsdk:"src_port" json:"srcPort"`
+	SrcCidr       jsontypes.String `tfsdk:"src_cidr" json:"srcCidr"`
+	SysLogEnabled jsontypes.Bool   `tfsdk:"syslog_enabled" json:"syslogEnabled"`
+}
+
+// FirewallRulesResponse is the rules of an appliance firewall as returned by the Dashboard API. The cellular firewall
+// has no syslogDefaultRule setting, so SyslogDefaultRule is null for it.
+type FirewallRulesResponse struct {
+	Rules             []FirewallRuleModel `json:"rules"`
+	SyslogDefaultRule jsontypes.Bool      `json:"syslogDefaultRule"`
+}
+
+// SyslogDefaultRuleSchema is the syslog_default_rule attribute of the appliance L3 and inbound firewall rules.
+var SyslogDefaultRuleSchema = schema.BoolAttribute{
+	MarkdownDescription: "Log the special default rule (boolean value - enable only if you've configured a syslog server) (optional)",
+	Optional:            true,
+	Computed:            true,
+	CustomType:          jsontypes.BoolType,
+	PlanModifiers: []planmodifier.Bool{
+		boolplanmodifier.UseStateForUnknown(),
+	},
+}
+
+// FirewallRulesSchema is the rules attribute of the appliance L3, inbound and cellular firewall rules. The default rule
+// may be left out, it is always appended by the Dashboard.
+var FirewallRulesSchema = schema.ListNestedAttribute{
+	MarkdownDescription: "An ordered list of the firewall rules. The default rule is managed by the Dashboard and may be left out.",
+	Required:            true,
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Description of the rule (optional)",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+			},
+			"dest_cidr": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of destination IP address(es) (in IP or CIDR notation), fully-qualified domain names (FQDN) or 'Any'",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+			},
+			"dest_port": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of destination port(s) (integer in the range 1-65535), or 'Any'",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+				Validators: []validator.String{
+					FirewallPortsValidator(),
+				},
+			},
+			"src_cidr": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of source IP address(es) (in IP or CIDR notation), or 'any' (note: FQDN not supported for source addresses)",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+			},
+			"src_port": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of source port(s) (integer in the range 1-65535), or 'Any'",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          jsontypes.StringType,
+				Validators: []validator.String{
+					FirewallPortsValidator(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "'allow' or 'deny' traffic specified by this rule",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The type of protocol (must be 'tcp', 'udp', 'icmp', 'icmp6', 'Any', or 'any')",
+				Required:            true,
+				CustomType:          jsontypes.StringType,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"tcp", "udp", "icmp", "icmp6", "Any", "any"}...),
+				},
+			},
+			"syslog_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Log this rule to syslog (true or false, boolean value) - only applicable if a syslog has been configured (optional)",
+				Optional:            true,
+				CustomType:          jsontypes.BoolType,
+			},
+		},
+	},
+}
+
+// FirewallRulesPayload returns the rules of an appliance firewall update request. The default rule cannot be changed
+// through the Dashboard API, so it is left out.
+func FirewallRulesPayload(rules []FirewallRuleModel) []openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner {
+	payload := []openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner{}
+
+	for _, attribute := range rules {
+		if attribute.Comment.ValueString() == DefaultFirewallRuleComment {
+			continue
+		}
+
+		var rule openApiClient.UpdateNetworkApplianceFirewallCellularFirewallRulesRequestRulesInner
+		rule.SetComment(attribute.Comment.ValueString())
+		rule.SetDestCidr(attribute.DestCidr.ValueString())
+		rule.SetDestPort(attribute.DestPort.ValueString())
+		rule.SetSrcCidr(attribute.SrcCidr.ValueString())
+		rule.SetSrcPort(attribute.SrcPort.ValueString())
+		rule.SetPolicy(attribute.Policy.ValueString())
+		rule.SetProtocol(attribute.Protocol.ValueString())
+		rule.SetSyslogEnabled(attribute.SysLogEnabled.ValueBool())
+		payload = append(payload, rule)
+	}
+
+	return payload
+}
+
+// ReadFirewallRules converts the rules of an appliance firewall returned by the Dashboard API. The API appends the
+// default rule to every rule set; it is stripped unless declared, the rules in the configuration or state, also ends
+// with it.
+func ReadFirewallRules(response interface{}, declared []FirewallRuleModel) (FirewallRulesResponse, error) {
+	var rules FirewallRulesResponse
+	if err := ConvertJSON(response, &rules); err != nil {
+		return rules, err
+	}
+
+	if rules.Rules == nil {
+		rules.Rules = []FirewallRuleModel{}
+	}
+
+	if endsWithDefaultFirewallRule(rules.Rules) && !endsWithDefaultFirewallRule(declared) {
+		rules.Rules = rules.Rules[:len(rules.Rules)-1]
+	}
+
+	return rules, nil
+}
+
+// endsWithDefaultFirewallRule reports whether the last of rules is the default rule.
+func endsWithDefaultFirewallRule(rules []FirewallRuleModel) bool {
+	return len(rules) > 0 && rules[len(rules)-1].Comment.ValueString() == DefaultFirewallRuleComment
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/core-infra-svcs/terraform-provider-meraki/internal/jsontypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirewallRulesPayload(t *testing.T) {
+	payload := FirewallRulesPayload([]FirewallRuleModel{
+		{
+			Comment:       jsontypes.StringValue("Allow HTTPS"),
+			DestCidr:      jsontypes.StringValue("192.168.1.0/24"),
+			DestPort:      jsontypes.StringValue("443"),
+			Policy:        jsontypes.StringValue("allow"),
+			Protocol:      jsontypes.StringValue("tcp"),
+			SrcPort:       jsontypes.StringValue("Any"),
+			SrcCidr:       jsontypes.StringValue("Any"),
+			SysLogEnabled: jsontypes.BoolValue(true),
+		},
+		{Comment: jsontypes.StringValue(DefaultFirewallRuleComment)},
+	})
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{
+		"comment": "Allow HTTPS",
+		"destCidr": "192.168.1.0/24",
+		"destPort": "443",
+		"policy": "allow",
+		"protocol": "tcp",
+		"srcPort": "Any",
+		"srcCidr": "Any",
+		"syslogEnabled": true
+	}]`, string(body))
+
+	assert.Empty(t, FirewallRulesPayload(nil))
+}
+
+func TestReadFirewallRules(t *testing.T) {
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"rules": [
+			{"comment": "Allow HTTPS", "policy": "allow", "protocol": "tcp", "destPort": "443", "destCidr": "192.168.1.0/24", "srcPort": "Any", "srcCidr": "Any", "syslogEnabled": false},
+			{"comment": "Default rule", "policy": "allow", "protocol": "Any", "destPort": "Any", "destCidr": "Any", "srcPort": "Any", "srcCidr": "Any", "syslogEnabled": false}
+		],
+		"syslogDefaultRule": true
+	}`), &response))
+
+	// The default rule is stripped when it is not declared
+	rules, err := ReadFirewallRules(response, nil)
+	require.NoError(t, err)
+	require.Len(t, rules.Rules, 1)
+	assert.Equal(t, "Allow HTTPS", rules.Rules[0].Comment.ValueString())
+	assert.Equal(t, "192.168.1.0/24", rules.Rules[0].DestCidr.ValueString())
+	assert.True(t, rules.SyslogDefaultRule.ValueBool())
+
+	// and kept when the declared rules end with it
+	rules, err = ReadFirewallRules(response, []FirewallRuleModel{
+		{Comment: jsontypes.StringValue("Allow HTTPS")},
+		{Comment: jsontypes.StringValue(DefaultFirewallRuleComment)},
+	})
+	require.NoError(t, err)
+	require.Len(t, rules.Rules, 2)
+	assert.Equal(t, DefaultFirewallRuleComment, rules.Rules[1].Comment.ValueString())
+
+	// A cellular firewall response has no syslogDefaultRule
+	rules, err = ReadFirewallRules(map[string]interface{}{"rules": []interface{}{}}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, rules.Rules)
+	assert.True(t, rules.SyslogDefaultRule.IsNull())
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultLiveToolsPollInterval is how often the status of a live tools job is checked.
+	DefaultLiveToolsPollInterval = 5 * time.Second
+
+	// DefaultLiveToolsTimeout is how long resources wait for a live tools job before giving up, in seconds.
+	DefaultLiveToolsTimeout = 300
+)
+
+// Statuses of a live tools job. Jobs are new, queued or running until they end as complete or failed.
+const (
+	LiveToolsStatusComplete = "complete"
+	LiveToolsStatusFailed   = "failed"
+)
+
+// LiveToolsJob is the status of an asynchronous live tools job, such as a cable test or a ping.
+type LiveToolsJob interface {
+	GetStatus() string
+}
+
+// WaitForLiveToolsJob polls a live tools job with get until it is complete, it fails or timeout has passed. The job
+// and the response of the last request are returned with the error of a failed job, so that callers can report the
+// error message of the job.
+func WaitForLiveToolsJob[T LiveToolsJob](ctx context.Context, interval, timeout time.Duration, get func() (T, *http.Response, error)) (T, *http.Response, error) {
+	var job T
+	var httpResp *http.Response
+	var err error
+
+	deadline := time.Now().Add(timeout)
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return job, httpResp, fmt.Errorf("waiting for live tools job: %w", ctx.Err())
+		case <-timer.C:
+		}
+
+		job, httpResp, err = get()
+		if err != nil {
+			return job, httpResp, err
+		}
+
+		switch job.GetStatus() {
+		case LiveToolsStatusComplete:
+			return job, httpResp, nil
+		case LiveToolsStatusFailed:
+			return job, httpResp, fmt.Errorf("live tools job failed")
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return job, httpResp, fmt.Errorf("live tools job did not complete within %s, last status was %q", timeout, job.GetStatus())
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type liveToolsTestJob struct {
+	status string
+}
+
+func (j liveToolsTestJob) GetStatus() string {
+	return j.status
+}
+
+// liveToolsTestGetter returns the given statuses in order, repeating the last one.
+func liveToolsTestGetter(statuses ...string) (func() (liveToolsTestJob, *http.Response, error), *int) {
+	calls := 0
+	return func() (liveToolsTestJob, *http.Response, error) {
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		return liveToolsTestJob{status: status}, &http.Response{StatusCode: http.StatusOK}, nil
+	}, &calls
+}
+
+func TestWaitForLiveToolsJob(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: The job is polled until it is complete
+	t.Run("complete", func(t *testing.T) {
+		get, calls := liveToolsTestGetter("new", "running", "complete")
+
+		job, httpResp, err := WaitForLiveToolsJob(ctx, time.Millisecond, time.Second, get)
+		require.NoError(t, err)
+		assert.Equal(t, LiveToolsStatusComplete, job.GetStatus())
+		assert.Equal(t, http.StatusOK, httpResp.StatusCode)
+		assert.Equal(t, 3, *calls)
+	})
+
+	// Test case: A failed job returns the job with an error
+	t.Run("failed", func(t *testing.T) {
+		get, _ := liveToolsTestGetter("running", "failed")
+
+		job, _, err := WaitForLiveToolsJob(ctx, time.Millisecond, time.Second, get)
+		assert.Error(t, err)
+		assert.Equal(t, LiveToolsStatusFailed, job.GetStatus())
+	})
+
+	// Test case: Polling stops once the timeout has passed
+	t.Run("timeout", func(t *testing.T) {
+		get, _ := liveToolsTestGetter("running")
+
+		_, _, err := WaitForLiveToolsJob(ctx, 10*time.Millisecond, 30*time.Millisecond, get)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `last status was "running"`)
+	})
+
+	// Test case: Request errors are returned immediately
+	t.Run("request error", func(t *testing.T) {
+		calls := 0
+		get := func() (liveToolsTestJob, *http.Response, error) {
+			calls++
+			return liveToolsTestJob{}, &http.Response{StatusCode: http.StatusNotFound}, fmt.Errorf("not found")
+		}
+
+		_, httpResp, err := WaitForLiveToolsJob(ctx, time.Millisecond, time.Second, get)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+
+	// Test case: A cancelled context stops polling
+	t.Run("cancelled", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		get, calls := liveToolsTestGetter("running")
+
+		_, _, err := WaitForLiveToolsJob(cancelled, time.Second, time.Minute, get)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, *calls)
+	})
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// organizationLookupKey marks the context of requests made by OrganizationLookup.
+type organizationLookupKey struct{}
+
+// WithOrganizationLookup marks ctx as belonging to a request made to look up an organization.
+func WithOrganizationLookup(ctx context.Context) context.Context {
+	return context.WithValue(ctx, organizationLookupKey{}, true)
+}
+
+// IsOrganizationLookup reports whether ctx belongs to a request made to look up an organization, which must not
+// itself be resolved to an organization.
+func IsOrganizationLookup(ctx context.Context) bool {
+	lookup, _ := ctx.Value(organizationLookupKey{}).(bool)
+	return lookup
+}
+
+// organizationLookupFailureTTL is how long OrganizationLookup remembers a failed lookup.
+const organizationLookupFailureTTL = 30 * time.Second
+
+// OrganizationLookup resolves networks and devices to the ID of their organization. Lookups are cached, since a
+// network never moves to another organization. Failed lookups are cached for a short time as well, so that requests
+// for a network or device that cannot be resolved do not each look it up again.
+type OrganizationLookup struct {
+	client *openApiClient.APIClient
+	retry  RetryPolicy
+
+	mu            sync.Mutex
+	organizations map[string]string
+	failures      map[string]organizationLookupFailure
+}
+
+// organizationLookupFailure is a cached failed lookup.
+type organizationLookupFailure struct {
+	err     error
+	expires time.Time
+}
+
+// NewOrganizationLookup returns an OrganizationLookup that looks up networks and devices with client.
+func NewOrganizationLookup(client *openApiClient.APIClient, retry RetryPolicy) *OrganizationLookup {
+	return &OrganizationLookup{
+		client:        client,
+		retry:         retry,
+		organizations: map[string]string{},
+		failures:      map[string]organizationLookupFailure{},
+	}
+}
+
+// NetworkOrganization returns the ID of the organization the network belongs to.
+func (l *OrganizationLookup) NetworkOrganization(ctx context.Context, networkId string) (string, error) {
+	return l.lookup(ctx, "network:"+networkId, func(ctx context.Context) (string, error) {
+		network, _, err := CustomHttpRequestRetry(ctx, l.retry, func() (*openApiClient.GetNetwork200Response, *http.Response, error) {
+			return l.client.NetworksApi.GetNetwork(ctx, networkId).Execute()
+		})
+		if err != nil {
+			return "", fmt.Errorf("looking up the organization of network %s: %w", networkId, err)
+		}
+		if network.GetOrganizationId() == "" {
+			return "", fmt.Errorf("network %s has no organization", networkId)
+		}
+		return network.GetOrganizationId(), nil
+	})
+}
+
+// DeviceOrganization returns the ID of the organization of the network the device is in.
+func (l *OrganizationLookup) DeviceOrganization(ctx context.Context, serial string) (string, error) {
+	return l.lookup(ctx, "device:"+serial, func(ctx context.Context) (string, error) {
+		device, _, err := CustomHttpRequestRetry(ctx, l.retry, func() (map[string]interface{}, *http.Response, error) {
+			return l.client.DevicesApi.GetDevice(ctx, serial).Execute()
+		})
+		if err != nil {
+			return "", fmt.Errorf("looking up the network of device %s: %w", serial, err)
+		}
+
+		networkId, _ := device["networkId"].(string)
+		if networkId == "" {
+			return "", fmt.Errorf("device %s is not in a network", serial)
+		}
+
+		return l.NetworkOrganization(ctx, networkId)
+	})
+}
+
+// lookup returns the cached result for key, or calls fn and caches its result. Failures are cached for
+// organizationLookupFailureTTL, except for cancelled lookups, which say nothing about the network or device.
+func (l *OrganizationLookup) lookup(ctx context.Context, key string, fn func(ctx context.Context) (string, error)) (string, error) {
+	if organizationId, err, ok := l.cached(key); ok {
+		return organizationId, err
+	}
+
+	organizationId, err := fn(WithOrganizationLookup(ctx))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	switch {
+	case err == nil:
+		l.organizations[key] = organizationId
+		delete(l.failures, key)
+	case !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded):
+		l.failures[key] = organizationLookupFailure{err: err, expires: time.Now().Add(organizationLookupFailureTTL)}
+	}
+	return organizationId, err
+}
+
+// cached returns the cached organization ID or failure of key, and whether one was found.
+func (l *OrganizationLookup) cached(key string) (string, error, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if organizationId, ok := l.organizations[key]; ok {
+		return organizationId, nil, true
+	}
+	if failure, ok := l.failures[key]; ok {
+		if time.Now().Before(failure.expires) {
+			return "", failure.err, true
+		}
+		delete(l.failures, key)
+	}
+	return "", nil, false
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOrganizationLookup(t *testing.T) {
+	var lookups int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&lookups, 1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/networks/N_1":
+			_ = json.NewEncoder(w).Encode(map[string]string{"id": "N_1", "organizationId": "1"})
+		case "/devices/Q2XX-XXXX-XXXX":
+			_ = json.NewEncoder(w).Encode(map[string]string{"serial": "Q2XX-XXXX-XXXX", "networkId": "N_1"})
+		case "/devices/Q2YY-YYYY-YYYY":
+			_ = json.NewEncoder(w).Encode(map[string]string{"serial": "Q2YY-YYYY-YYYY"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	configuration := openApiClient.NewConfiguration()
+	configuration.Servers = openApiClient.ServerConfigurations{{URL: server.URL}}
+	lookup := NewOrganizationLookup(openApiClient.NewAPIClient(configuration), RetryPolicy{})
+	ctx := context.Background()
+
+	// Test case: The organization of a network is looked up once
+	for i := 0; i < 2; i++ {
+		organizationId, err := lookup.NetworkOrganization(ctx, "N_1")
+		require.NoError(t, err)
+		assert.Equal(t, "1", organizationId)
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(&lookups))
+
+	// Test case: A device is resolved through its network, which is already cached
+	for i := 0; i < 2; i++ {
+		organizationId, err := lookup.DeviceOrganization(ctx, "Q2XX-XXXX-XXXX")
+		require.NoError(t, err)
+		assert.Equal(t, "1", organizationId)
+	}
+	assert.Equal(t, int64(2), atomic.LoadInt64(&lookups))
+
+	// Test case: Devices outside of a network and unknown networks are errors
+	_, err := lookup.DeviceOrganization(ctx, "Q2YY-YYYY-YYYY")
+	assert.Error(t, err)
+	_, err = lookup.NetworkOrganization(ctx, "N_2")
+	assert.Error(t, err)
+	assert.Equal(t, int64(4), atomic.LoadInt64(&lookups))
+
+	// Test case: Failed lookups are cached until they expire
+	_, cachedErr := lookup.NetworkOrganization(ctx, "N_2")
+	assert.Equal(t, err, cachedErr)
+	assert.Equal(t, int64(4), atomic.LoadInt64(&lookups))
+
+	lookup.failures["network:N_2"] = organizationLookupFailure{err: err, expires: time.Now()}
+	_, err = lookup.NetworkOrganization(ctx, "N_2")
+	assert.Error(t, err)
+	assert.Equal(t, int64(5), atomic.LoadInt64(&lookups))
+}
@@ -0,0 +1,217 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PaginationOptions configures how PaginateAll and PageIterator walk a paginated Dashboard API endpoint.
+type PaginationOptions struct {
+	// MaxItems stops paging once this many items have been read. Zero reads every page.
+	MaxItems int
+
+	// Prefetch requests the next page in the background while the caller processes the current one.
+	Prefetch bool
+}
+
+// PageFetcher requests the page that starts after the given token. An empty token requests the first page.
+// Fetchers typically set StartingAfter on a generated API request and wrap Execute in CustomHttpRequestRetry.
+type PageFetcher[T any] func(ctx context.Context, startingAfter string) ([]T, *http.Response, error)
+
+// page is a single result of a PageFetcher.
+type page[T any] struct {
+	items    []T
+	httpResp *http.Response
+	err      error
+}
+
+// PageIterator follows the rel=next links of a paginated Dashboard API endpoint until the last page is read,
+// MaxItems is reached or a request fails.
+//
+//	it := utils.NewPageIterator(ctx, utils.PaginationOptions{}, fetch)
+//	for it.Next() {
+//		for _, item := range it.Page() { ... }
+//	}
+//	if err := it.Err(); err != nil { ... }
+type PageIterator[T any] struct {
+	ctx     context.Context
+	options PaginationOptions
+	fetch   PageFetcher[T]
+
+	current  page[T]
+	read     int
+	token    string
+	done     bool
+	prefetch chan page[T]
+	cancel   context.CancelFunc
+}
+
+// NewPageIterator returns an iterator over the pages returned by fetch. No request is sent until Next is called.
+func NewPageIterator[T any](ctx context.Context, options PaginationOptions, fetch PageFetcher[T]) *PageIterator[T] {
+	return &PageIterator[T]{
+		ctx:     ctx,
+		options: options,
+		fetch:   fetch,
+	}
+}
+
+// Next advances to the next page and reports whether one was read. It returns false once every page has been
+// read, MaxItems has been reached or a request failed, after which Err reports the failure.
+func (it *PageIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	var next page[T]
+	if it.prefetch != nil {
+		next = <-it.prefetch
+	} else {
+		next = it.get(it.ctx, it.token)
+	}
+
+	it.current = next
+	if next.err != nil {
+		it.stop()
+		return false
+	}
+
+	// Trim the final page to the cap
+	if it.options.MaxItems > 0 && it.read+len(next.items) >= it.options.MaxItems {
+		it.current.items = next.items[:it.options.MaxItems-it.read]
+		it.read = it.options.MaxItems
+		it.stop()
+		return true
+	}
+	it.read += len(next.items)
+
+	token, ok, err := NextPageToken(next.httpResp)
+	if err != nil {
+		it.current.err = err
+		it.stop()
+		return false
+	}
+	if !ok {
+		it.stop()
+		return true
+	}
+	it.token = token
+
+	if it.options.Prefetch {
+		it.startPrefetch(token)
+	}
+
+	return true
+}
+
+// Page returns the items of the current page.
+func (it *PageIterator[T]) Page() []T {
+	return it.current.items
+}
+
+// Response returns the HTTP response of the current page, or of the failed request once Err is set.
+func (it *PageIterator[T]) Response() *http.Response {
+	return it.current.httpResp
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *PageIterator[T]) Err() error {
+	return it.current.err
+}
+
+// Close stops a prefetch that is still in flight. It only needs to be called when iteration ends early.
+func (it *PageIterator[T]) Close() {
+	it.stop()
+}
+
+func (it *PageIterator[T]) get(ctx context.Context, token string) page[T] {
+	items, httpResp, err := it.fetch(ctx, token)
+	if err == nil && httpResp == nil {
+		err = fmt.Errorf("no response received for page starting after %q", token)
+	}
+	return page[T]{items: items, httpResp: httpResp, err: err}
+}
+
+// startPrefetch requests the page after token in the background. Next receives it from the prefetch channel.
+func (it *PageIterator[T]) startPrefetch(token string) {
+	if it.cancel == nil {
+		var ctx context.Context
+		ctx, it.cancel = context.WithCancel(it.ctx)
+		it.ctx = ctx
+	}
+
+	it.prefetch = make(chan page[T], 1)
+	go func(ctx context.Context, result chan<- page[T]) {
+		result <- it.get(ctx, token)
+	}(it.ctx, it.prefetch)
+}
+
+func (it *PageIterator[T]) stop() {
+	it.done = true
+	if it.cancel != nil {
+		it.cancel()
+	}
+}
+
+// PaginateAll reads every page returned by fetch and returns the combined items, which are never nil. On failure
+// the HTTP response of the failed request is returned with the error, so that it can be passed to
+// NewAPIErrorDiagnostic.
+func PaginateAll[T any](ctx context.Context, options PaginationOptions, fetch PageFetcher[T]) ([]T, *http.Response, error) {
+	it := NewPageIterator(ctx, options, fetch)
+	defer it.Close()
+
+	items := make([]T, 0)
+	for it.Next() {
+		items = append(items, it.Page()...)
+	}
+
+	return items, it.Response(), it.Err()
+}
+
+// NextPageToken returns the startingAfter token of the rel=next link in the Link header of a paginated response.
+// It returns false on the last page.
+func NextPageToken(httpResp *http.Response) (string, bool, error) {
+	if httpResp == nil {
+		return "", false, nil
+	}
+
+	for _, header := range httpResp.Header.Values("Link") {
+		for _, link := range strings.Split(header, ",") {
+			target, params, found := strings.Cut(link, ";")
+			if !found || !isNextRel(params) {
+				continue
+			}
+
+			next, err := url.Parse(strings.Trim(strings.TrimSpace(target), "<>"))
+			if err != nil {
+				return "", false, fmt.Errorf("parsing next page link %q: %w", target, err)
+			}
+
+			token := next.Query().Get("startingAfter")
+			if token == "" {
+				return "", false, fmt.Errorf("next page link %q has no startingAfter parameter", next)
+			}
+			return token, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// isNextRel reports whether the parameters of a Link header entry include rel=next.
+func isNextRel(params string) bool {
+	for _, param := range strings.Split(params, ";") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+			continue
+		}
+		for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+			if strings.EqualFold(rel, "next") {
+				return true
+			}
+		}
+	}
+	return false
+}
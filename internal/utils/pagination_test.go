@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPaginationTestServer serves the integers 0 to total-1 in pages of perPage items, linking to the next page with
+// a Link header in the format used by the Dashboard API.
+func newPaginationTestServer(t *testing.T, total, perPage int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		start := 0
+		if token := r.URL.Query().Get("startingAfter"); token != "" {
+			last, err := strconv.Atoi(token)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			start = last + 1
+		}
+
+		end := start + perPage
+		if end >= total {
+			end = total
+		} else {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/items?perPage=%d>; rel=first, <%s/items?perPage=%d&startingAfter=%d>; rel=next`,
+				server.URL, perPage, server.URL, perPage, end-1))
+		}
+
+		items := make([]int, 0, end-start)
+		for i := start; i < end; i++ {
+			items = append(items, i)
+		}
+		_ = json.NewEncoder(w).Encode(items)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+// paginationTestFetcher requests a page from the test server in the shape of a generated API client call.
+func paginationTestFetcher(url string) PageFetcher[int] {
+	return func(ctx context.Context, startingAfter string) ([]int, *http.Response, error) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/items", nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if startingAfter != "" {
+			request.URL.RawQuery = "startingAfter=" + startingAfter
+		}
+
+		httpResp, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return nil, httpResp, err
+		}
+		defer httpResp.Body.Close()
+		if httpResp.StatusCode != http.StatusOK {
+			return nil, httpResp, fmt.Errorf("unexpected status %d", httpResp.StatusCode)
+		}
+
+		var items []int
+		err = json.NewDecoder(httpResp.Body).Decode(&items)
+		return items, httpResp, err
+	}
+}
+
+func TestPaginateAll(t *testing.T) {
+	ctx := context.Background()
+
+	// Test case: Every page is read until the last page, which has no next link
+	t.Run("all pages", func(t *testing.T) {
+		for _, prefetch := range []bool{false, true} {
+			server, calls := newPaginationTestServer(t, 25, 10)
+
+			items, httpResp, err := PaginateAll(ctx, PaginationOptions{Prefetch: prefetch}, paginationTestFetcher(server.URL))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, httpResp.StatusCode)
+			require.Len(t, items, 25)
+			for i, item := range items {
+				assert.Equal(t, i, item)
+			}
+			assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+		}
+	})
+
+	// Test case: Paging stops at the cap and the final page is trimmed
+	t.Run("max items", func(t *testing.T) {
+		server, calls := newPaginationTestServer(t, 100, 10)
+
+		items, _, err := PaginateAll(ctx, PaginationOptions{MaxItems: 15}, paginationTestFetcher(server.URL))
+		require.NoError(t, err)
+		assert.Len(t, items, 15)
+		assert.Equal(t, 14, items[14])
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+	})
+
+	// Test case: An empty collection returns an empty, non-nil slice
+	t.Run("empty", func(t *testing.T) {
+		server, _ := newPaginationTestServer(t, 0, 10)
+
+		items, _, err := PaginateAll(ctx, PaginationOptions{}, paginationTestFetcher(server.URL))
+		require.NoError(t, err)
+		assert.NotNil(t, items)
+		assert.Empty(t, items)
+	})
+
+	// Test case: A failed page stops paging and returns its response with the error
+	t.Run("error", func(t *testing.T) {
+		failing := func(ctx context.Context, startingAfter string) ([]int, *http.Response, error) {
+			if startingAfter == "" {
+				httpResp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+				httpResp.Header.Set("Link", `<https://api.meraki.com/api/v1/items?startingAfter=abc>; rel=next`)
+				return []int{1}, httpResp, nil
+			}
+			return nil, &http.Response{StatusCode: http.StatusBadRequest}, fmt.Errorf("bad request")
+		}
+
+		_, httpResp, err := PaginateAll(ctx, PaginationOptions{}, failing)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, httpResp.StatusCode)
+	})
+}
+
+func TestPageIterator(t *testing.T) {
+	server, _ := newPaginationTestServer(t, 30, 10)
+
+	// Test case: Pages are returned in order and the iterator reports no error once exhausted
+	it := NewPageIterator(context.Background(), PaginationOptions{Prefetch: true}, paginationTestFetcher(server.URL))
+	defer it.Close()
+
+	var pages [][]int
+	for it.Next() {
+		pages = append(pages, it.Page())
+	}
+	require.NoError(t, it.Err())
+	require.Len(t, pages, 3)
+	assert.Equal(t, []int{20, 21, 22, 23, 24, 25, 26, 27, 28, 29}, pages[2])
+	assert.False(t, it.Next())
+}
+
+func TestNextPageToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		link  string
+		token string
+		ok    bool
+		err   bool
+	}{
+		{
+			name:  "next link",
+			link:  `<https://api.meraki.com/api/v1/organizations/1/networks?perPage=3>; rel=first, <https://api.meraki.com/api/v1/organizations/1/networks?perPage=3&startingAfter=L_123>; rel=next`,
+			token: "L_123",
+			ok:    true,
+		},
+		{
+			name:  "quoted rel",
+			link:  `<https://api.meraki.com/api/v1/items?startingAfter=Q2XX-XXXX-XXXX>; rel="next"`,
+			token: "Q2XX-XXXX-XXXX",
+			ok:    true,
+		},
+		{
+			name: "last page",
+			link: `<https://api.meraki.com/api/v1/items?perPage=3>; rel=first, <https://api.meraki.com/api/v1/items?endingBefore=5>; rel=prev`,
+		},
+		{
+			name: "no link header",
+		},
+		{
+			name: "missing token",
+			link: `<https://api.meraki.com/api/v1/items?perPage=3>; rel=next`,
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpResp := &http.Response{Header: http.Header{}}
+			if tt.link != "" {
+				httpResp.Header.Set("Link", tt.link)
+			}
+
+			token, ok, err := NextPageToken(httpResp)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.token, token)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	openApiClient "github.com/meraki/dashboard-api-go/client"
+)
+
+// ProviderData is passed from the provider's Configure method to every resource and data source.
+type ProviderData struct {
+	Client     *openApiClient.APIClient
+	Encryption EncryptionKeys
+	Retry      RetryPolicy
+
+	// ActionBatches is set when the provider's use_action_batches setting is enabled.
+	ActionBatches *ActionBatcher
+
+	// SwitchPorts records planned switch port settings and link aggregations to detect conflicts between them.
+	SwitchPorts *SwitchPortRegistry
+}
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRetryInitialDelay   = time.Second
+	DefaultRetryMaxDelay       = 30 * time.Second
+	DefaultRetryMaxElapsedTime = 5 * time.Minute
+)
+
+// RetryPolicy configures how CustomHttpRequestRetry retries failed Dashboard API calls.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+
+	// InitialDelay is the backoff before the first retry. It doubles on each retry up to MaxDelay.
+	InitialDelay time.Duration
+
+	// MaxDelay caps the exponential backoff. It does not cap a Retry-After header sent by the API.
+	MaxDelay time.Duration
+
+	// MaxElapsedTime stops retrying once the next attempt would start after this much time has passed.
+	// Zero means no limit.
+	MaxElapsedTime time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when the provider does not configure one.
+func DefaultRetryPolicy(maxRetries int) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     maxRetries,
+		InitialDelay:   DefaultRetryInitialDelay,
+		MaxDelay:       DefaultRetryMaxDelay,
+		MaxElapsedTime: DefaultRetryMaxElapsedTime,
+	}
+}
+
+// backoff returns the jittered exponential delay before the given retry, counting from zero.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialDelay
+	if delay <= 0 {
+		delay = DefaultRetryInitialDelay
+	}
+	for i := 0; i < retry && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	// Equal jitter keeps at least half of the delay so that retries still back off
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// IsRetryableResponse reports whether an API call should be retried. Rate limited calls (429) and server errors
+// (5xx) are retried, as are transport errors where no response was received. Every other status is returned to the
+// caller immediately.
+func IsRetryableResponse(httpResp *http.Response, err error) bool {
+	if httpResp == nil {
+		return err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return httpResp.StatusCode == http.StatusTooManyRequests || httpResp.StatusCode >= 500
+}
+
+// ParseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date.
+// fallback is returned when the header is missing or malformed.
+func ParseRetryAfter(value string, fallback time.Duration) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+		return 0
+	}
+	return fallback
+}
+
+// CustomHttpRequestRetry calls apiCall until it succeeds, returns a status that is not retryable, or the policy
+// is exhausted. Retries wait for the Retry-After header when the API sends one and use jittered exponential
+// backoff otherwise. Waiting stops as soon as ctx is cancelled.
+// The response body of a failed call is included in the returned error and left readable for the caller.
+func CustomHttpRequestRetry[T any](ctx context.Context, policy RetryPolicy, apiCall func() (T, *http.Response, error)) (T, *http.Response, error) {
+	start := time.Now()
+
+	for retry := 0; ; retry++ {
+		tflog.Trace(ctx, fmt.Sprintf("Attempt %d/%d", retry+1, policy.MaxRetries+1))
+
+		result, httpResp, err := apiCall()
+		if err == nil && httpResp != nil && httpResp.StatusCode >= 200 && httpResp.StatusCode <= 299 {
+			return result, httpResp, nil
+		}
+
+		err = apiCallError(httpResp, err)
+		if !IsRetryableResponse(httpResp, err) {
+			return result, httpResp, err
+		}
+		if retry >= policy.MaxRetries {
+			return result, httpResp, fmt.Errorf("after %d retries, last error: %w", retry, err)
+		}
+
+		delay := policy.backoff(retry)
+		if httpResp != nil {
+			delay = ParseRetryAfter(httpResp.Header.Get("Retry-After"), delay)
+		}
+		if policy.MaxElapsedTime > 0 && time.Since(start)+delay > policy.MaxElapsedTime {
+			return result, httpResp, fmt.Errorf("giving up after %d retries and %s, last error: %w", retry, time.Since(start).Round(time.Second), err)
+		}
+
+		tflog.Warn(ctx, fmt.Sprintf("Retry %d/%d in %s: %s", retry+1, policy.MaxRetries, delay.Round(time.Millisecond), err))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, httpResp, fmt.Errorf("%w, last error: %w", ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+// apiCallError describes a failed API call as a MerakiAPIError. The response body is restored after parsing,
+// so that callers can still read it for their own diagnostics.
+func apiCallError(httpResp *http.Response, err error) error {
+	if httpResp == nil && err == nil {
+		err = errors.New("no response received")
+	}
+	return NewMerakiAPIError(httpResp, err)
+}
@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newRetryTestServer responds with each status in turn, then with 200 once statuses are exhausted.
+func newRetryTestServer(t *testing.T, headers http.Header, statuses ...int) (*httptest.Server, *int) {
+	t.Helper()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls > len(statuses) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"ok":true}`))
+			return
+		}
+		for key, values := range headers {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(statuses[calls-1])
+		_, _ = w.Write([]byte(`{"errors":["failed"]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+// retryTestCall performs a GET request in the shape of a generated API client call.
+func retryTestCall(url string) func() (string, *http.Response, error) {
+	return func() (string, *http.Response, error) {
+		httpResp, err := http.Get(url)
+		if err != nil {
+			return "", httpResp, err
+		}
+		if httpResp.StatusCode >= 300 {
+			return "", httpResp, nil
+		}
+		body, err := io.ReadAll(httpResp.Body)
+		return string(body), httpResp, err
+	}
+}
+
+func TestCustomHttpRequestRetry(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, InitialDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+
+	// Test case: 429 and 5xx responses are retried until the call succeeds
+	t.Run("retryable statuses", func(t *testing.T) {
+		server, calls := newRetryTestServer(t, nil, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusInternalServerError)
+
+		result, httpResp, err := CustomHttpRequestRetry(context.Background(), policy, retryTestCall(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, httpResp.StatusCode)
+		assert.Equal(t, `{"ok":true}`, result)
+		assert.Equal(t, 4, *calls)
+	})
+
+	// Test case: Other client errors are returned immediately with the response body still readable
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls := newRetryTestServer(t, nil, status)
+
+			_, httpResp, err := CustomHttpRequestRetry(context.Background(), policy, retryTestCall(server.URL))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "failed")
+
+			var apiErr *MerakiAPIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, status, apiErr.StatusCode)
+			assert.Equal(t, 1, *calls)
+
+			body, readErr := io.ReadAll(httpResp.Body)
+			require.NoError(t, readErr)
+			assert.Equal(t, `{"errors":["failed"]}`, string(body))
+		})
+	}
+
+	// Test case: Retry-After of a rate limited call takes precedence over the backoff
+	t.Run("retry after header", func(t *testing.T) {
+		server, calls := newRetryTestServer(t, http.Header{"Retry-After": []string{"1"}}, http.StatusTooManyRequests)
+
+		start := time.Now()
+		_, _, err := CustomHttpRequestRetry(context.Background(), policy, retryTestCall(server.URL))
+		require.NoError(t, err)
+		assert.Equal(t, 2, *calls)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	// Test case: The last error is returned once retries are exhausted
+	t.Run("retries exhausted", func(t *testing.T) {
+		server, calls := newRetryTestServer(t, nil, 503, 503, 503, 503, 503)
+
+		_, httpResp, err := CustomHttpRequestRetry(context.Background(), policy, retryTestCall(server.URL))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "after 3 retries")
+		assert.Equal(t, http.StatusServiceUnavailable, httpResp.StatusCode)
+		assert.Equal(t, 4, *calls)
+	})
+
+	// Test case: Retrying stops before the next attempt would exceed the max elapsed time
+	t.Run("max elapsed time", func(t *testing.T) {
+		server, calls := newRetryTestServer(t, http.Header{"Retry-After": []string{"60"}}, http.StatusServiceUnavailable)
+
+		limited := policy
+		limited.MaxElapsedTime = time.Second
+
+		start := time.Now()
+		_, _, err := CustomHttpRequestRetry(context.Background(), limited, retryTestCall(server.URL))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "giving up")
+		assert.Equal(t, 1, *calls)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	// Test case: Waiting between retries stops when the context is cancelled
+	t.Run("context cancelled", func(t *testing.T) {
+		server, calls := newRetryTestServer(t, http.Header{"Retry-After": []string{"60"}}, http.StatusServiceUnavailable)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, _, err := CustomHttpRequestRetry(ctx, policy, retryTestCall(server.URL))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, *calls)
+	})
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for retry, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		delay := policy.backoff(retry)
+		assert.GreaterOrEqual(t, delay, want/2, "retry %d", retry)
+		assert.LessOrEqual(t, delay, want, "retry %d", retry)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, 3*time.Second, ParseRetryAfter("3", time.Second))
+	assert.Equal(t, time.Second, ParseRetryAfter("", time.Second))
+	assert.Equal(t, time.Second, ParseRetryAfter("soon", time.Second))
+	assert.Equal(t, time.Duration(0), ParseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), time.Second))
+	assert.Greater(t, ParseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), time.Second), 55*time.Second)
+}
+
+func TestIsRetryableResponse(t *testing.T) {
+	assert.True(t, IsRetryableResponse(&http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+	assert.True(t, IsRetryableResponse(&http.Response{StatusCode: http.StatusServiceUnavailable}, nil))
+	assert.False(t, IsRetryableResponse(&http.Response{StatusCode: http.StatusConflict}, nil))
+	assert.True(t, IsRetryableResponse(nil, io.ErrUnexpectedEOF))
+	assert.False(t, IsRetryableResponse(nil, context.Canceled))
+}
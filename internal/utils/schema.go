@@ -6,7 +6,9 @@ import (
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -207,3 +209,29 @@ func (r RequiresReplaceIfSensitive) PlanModifyString(ctx context.Context, req pl
 		resp.RequiresReplace = true
 	}
 }
+
+// TriggersAttribute returns the triggers attribute of resources that run a one-shot operation, such as rebooting a
+// device. The operation runs on create and runs again whenever a value in the map changes.
+func TriggersAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Arbitrary map of values that, when changed, runs the operation again. " +
+			"Use a timestamp or a hash of related configuration to control when the operation is repeated.",
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// ExecutedAtAttribute returns the executed_at attribute of resources that run a one-shot operation. It records
+// when the operation last ran.
+func ExecutedAtAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "When the operation was run, in RFC3339 format",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
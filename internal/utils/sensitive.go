@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SealString returns value encrypted with the current key, as stored in state for the sensitive attribute at p.
+// Values already encrypted with the current key are returned unchanged, while plaintext values, legacy AES-CFB values
+// and values encrypted with one of the previous keys are re-encrypted. Null and unknown values are returned as is.
+func (k EncryptionKeys) SealString(p path.Path, value types.String) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !k.Enabled() || !IsKnown(value) {
+		return value, diags
+	}
+
+	sealed, err := k.Seal(value.ValueString())
+	if err != nil {
+		diags.Append(NewEncryptionDiagnostic(p, err))
+		return value, diags
+	}
+	return types.StringValue(sealed), diags
+}
+
+// PreserveString returns the encrypted prior state value of a sensitive attribute when it decrypts to the planned
+// plaintext, so that encryption alone never produces a diff. Otherwise the planned value is returned.
+func (k EncryptionKeys) PreserveString(planValue, stateValue types.String) types.String {
+	if !IsKnown(planValue) || !IsKnown(stateValue) {
+		return planValue
+	}
+	if k.Equivalent(stateValue.ValueString(), planValue.ValueString()) {
+		return stateValue
+	}
+	return planValue
+}
+
+// SensitiveList describes a list attribute whose elements, of model type T, each hold one sensitive string.
+type SensitiveList[T any] struct {
+	// Path is the path of the list attribute.
+	Path path.Path
+
+	// SecretPath lists the attribute names leading from a list element to its sensitive string.
+	SecretPath []string
+
+	// Secret returns the sensitive string of element, or nil when the element has none.
+	Secret func(element *T) *types.String
+
+	// Key identifies an element across the plan and the prior state. Elements are matched by position when nil.
+	Key func(element T) string
+}
+
+// Seal encrypts the sensitive string of each element of list with SealString.
+func (l SensitiveList[T]) Seal(ctx context.Context, keys EncryptionKeys, list types.List) (types.List, diag.Diagnostics) {
+	if !keys.Enabled() {
+		return list, nil
+	}
+
+	return l.mapElements(ctx, list, func(i int, element *T) diag.Diagnostics {
+		secret := l.Secret(element)
+		if secret == nil {
+			return nil
+		}
+
+		sealed, diags := keys.SealString(l.secretPath(i), *secret)
+		*secret = sealed
+		return diags
+	})
+}
+
+// Preserve applies PreserveString to the sensitive string of each planned element and the element of the prior state
+// matched to it, keeping the encrypted state value of secrets that did not change.
+func (l SensitiveList[T]) Preserve(ctx context.Context, keys EncryptionKeys, plan, state types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !IsKnown(state) {
+		return plan, diags
+	}
+
+	var stateElements []T
+	diags.Append(state.ElementsAs(ctx, &stateElements, true)...)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	stateSecrets := map[string]types.String{}
+	for i := range stateElements {
+		if secret := l.Secret(&stateElements[i]); secret != nil {
+			stateSecrets[l.key(i, stateElements[i])] = *secret
+		}
+	}
+
+	list, mapDiags := l.mapElements(ctx, plan, func(i int, element *T) diag.Diagnostics {
+		secret := l.Secret(element)
+		if secret == nil {
+			return nil
+		}
+		if stateSecret, ok := stateSecrets[l.key(i, *element)]; ok {
+			*secret = keys.PreserveString(*secret, stateSecret)
+		}
+		return nil
+	})
+	diags.Append(mapDiags...)
+	return list, diags
+}
+
+// key returns the key of the element at index i.
+func (l SensitiveList[T]) key(i int, element T) string {
+	if l.Key == nil {
+		return fmt.Sprint(i)
+	}
+	return l.Key(element)
+}
+
+// secretPath returns the path of the sensitive string of the element at index i.
+func (l SensitiveList[T]) secretPath(i int) path.Path {
+	p := l.Path.AtListIndex(i)
+	for _, name := range l.SecretPath {
+		p = p.AtName(name)
+	}
+	return p
+}
+
+// mapElements applies fn to each element of list and returns the updated list.
+func (l SensitiveList[T]) mapElements(ctx context.Context, list types.List, fn func(i int, element *T) diag.Diagnostics) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !IsKnown(list) {
+		return list, diags
+	}
+
+	var elements []T
+	diags.Append(list.ElementsAs(ctx, &elements, true)...)
+	if diags.HasError() {
+		return list, diags
+	}
+
+	for i := range elements {
+		diags.Append(fn(i, &elements[i])...)
+	}
+	if diags.HasError() {
+		return list, diags
+	}
+
+	result, listDiags := types.ListValueFrom(ctx, list.ElementType(ctx), elements)
+	diags.Append(listDiags...)
+	return result, diags
+}
@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSecretModel struct {
+	Name   types.String `tfsdk:"name"`
+	Secret types.String `tfsdk:"secret"`
+}
+
+func testSecretList(t *testing.T, elements ...testSecretModel) types.List {
+	list, diags := types.ListValueFrom(context.Background(), testSecretElementType(), elements)
+	require.False(t, diags.HasError(), diags)
+	return list
+}
+
+func testSecrets(t *testing.T, list types.List) []testSecretModel {
+	var elements []testSecretModel
+	require.False(t, list.ElementsAs(context.Background(), &elements, false).HasError())
+	return elements
+}
+
+func TestSealString(t *testing.T) {
+	keys := EncryptionKeys{Key: "current"}
+
+	sealed, diags := keys.SealString(path.Root("psk"), types.StringValue("secret"))
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, IsEncrypted(sealed.ValueString()))
+
+	// Test case: Sealing again keeps the same ciphertext
+	resealed, diags := keys.SealString(path.Root("psk"), sealed)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, sealed, resealed)
+
+	// Test case: Null values and disabled encryption leave the value as is
+	null, diags := keys.SealString(path.Root("psk"), types.StringNull())
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, null.IsNull())
+
+	plaintext, diags := EncryptionKeys{}.SealString(path.Root("psk"), types.StringValue("secret"))
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "secret", plaintext.ValueString())
+
+	// Test case: Values that fail authentication report the attribute
+	_, diags = EncryptionKeys{Key: "other"}.SealString(path.Root("psk"), sealed)
+	require.True(t, diags.HasError())
+	assert.Equal(t, path.Root("psk"), diags[0].(diag.DiagnosticWithPath).Path())
+}
+
+func TestPreserveString(t *testing.T) {
+	keys := EncryptionKeys{Key: "current"}
+	sealed, err := keys.Encrypt("secret")
+	require.NoError(t, err)
+
+	assert.Equal(t, sealed, keys.PreserveString(types.StringValue("secret"), types.StringValue(sealed)).ValueString())
+	assert.Equal(t, "changed", keys.PreserveString(types.StringValue("changed"), types.StringValue(sealed)).ValueString())
+	assert.True(t, keys.PreserveString(types.StringUnknown(), types.StringValue(sealed)).IsUnknown())
+}
+
+func TestSensitiveList(t *testing.T) {
+	ctx := context.Background()
+	keys := EncryptionKeys{Key: "current"}
+
+	byPosition := SensitiveList[testSecretModel]{
+		Path:       path.Root("servers"),
+		SecretPath: []string{"secret"},
+		Secret:     func(element *testSecretModel) *types.String { return &element.Secret },
+	}
+	byName := byPosition
+	byName.Key = func(element testSecretModel) string { return element.Name.ValueString() }
+
+	state, diags := byPosition.Seal(ctx, keys, testSecretList(t,
+		testSecretModel{Name: types.StringValue("first"), Secret: types.StringValue("one")},
+		testSecretModel{Name: types.StringValue("second"), Secret: types.StringNull()},
+	))
+	require.False(t, diags.HasError(), diags)
+
+	sealed := testSecrets(t, state)
+	assert.True(t, IsEncrypted(sealed[0].Secret.ValueString()))
+	assert.True(t, sealed[1].Secret.IsNull())
+
+	// Test case: Unchanged secrets keep their encrypted state value
+	plan, diags := byPosition.Preserve(ctx, keys, testSecretList(t,
+		testSecretModel{Name: types.StringValue("first"), Secret: types.StringValue("one")},
+	), state)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, sealed[0].Secret, testSecrets(t, plan)[0].Secret)
+
+	// Test case: Elements matched by key follow the element rather than its position
+	plan, diags = byName.Preserve(ctx, keys, testSecretList(t,
+		testSecretModel{Name: types.StringValue("new"), Secret: types.StringValue("one")},
+		testSecretModel{Name: types.StringValue("first"), Secret: types.StringValue("one")},
+	), state)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "one", testSecrets(t, plan)[0].Secret.ValueString())
+	assert.Equal(t, sealed[0].Secret, testSecrets(t, plan)[1].Secret)
+
+	// Test case: Errors report the path of the secret
+	_, diags = byPosition.Seal(ctx, EncryptionKeys{Key: "other"}, state)
+	require.True(t, diags.HasError())
+	assert.Equal(t, path.Root("servers").AtListIndex(0).AtName("secret"), diags[0].(diag.DiagnosticWithPath).Path())
+}
+
+func testSecretElementType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":   types.StringType,
+		"secret": types.StringType,
+	}}
+}
@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SwitchPortKey identifies a switch port by the serial of its switch and its port ID.
+type SwitchPortKey struct {
+	Serial string
+	PortId string
+}
+
+// NewSwitchPortKey returns the key of a switch port. Serials are compared in upper case.
+func NewSwitchPortKey(serial, portId string) SwitchPortKey {
+	return SwitchPortKey{Serial: strings.ToUpper(serial), PortId: portId}
+}
+
+func (k SwitchPortKey) String() string {
+	return fmt.Sprintf("%s port %s", k.Serial, k.PortId)
+}
+
+// SwitchPortConflict describes a setting that two member ports of a link aggregation are planned with different
+// values for.
+type SwitchPortConflict struct {
+	Port       SwitchPortKey
+	Other      SwitchPortKey
+	Setting    string
+	Value      string
+	OtherValue string
+}
+
+func (c SwitchPortConflict) String() string {
+	return fmt.Sprintf("%s sets %s to %q, but %s in the same link aggregation sets it to %q",
+		c.Port, c.Setting, c.Value, c.Other, c.OtherValue)
+}
+
+// SwitchPortRegistry records the switch port settings and link aggregations planned by the provider, so that
+// conflicting settings on the member ports of a link aggregation are reported at plan time. Terraform plans
+// resources concurrently and in no particular order, so both sides record their plan and check against the other:
+// whichever is planned last reports the conflict.
+type SwitchPortRegistry struct {
+	mu           sync.Mutex
+	ports        map[SwitchPortKey]map[string]string
+	aggregations map[string][]SwitchPortKey
+}
+
+// NewSwitchPortRegistry returns an empty SwitchPortRegistry.
+func NewSwitchPortRegistry() *SwitchPortRegistry {
+	return &SwitchPortRegistry{
+		ports:        map[SwitchPortKey]map[string]string{},
+		aggregations: map[string][]SwitchPortKey{},
+	}
+}
+
+// PlanPort records the planned settings of a switch port and returns its conflicts with the other member ports of
+// the link aggregations it belongs to. Settings with an empty value are not compared.
+func (r *SwitchPortRegistry) PlanPort(port SwitchPortKey, settings map[string]string) []SwitchPortConflict {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ports[port] = settings
+
+	var conflicts []SwitchPortConflict
+	for _, members := range r.aggregations {
+		if !containsPort(members, port) {
+			continue
+		}
+		for _, other := range members {
+			if other != port {
+				conflicts = append(conflicts, r.compare(port, other)...)
+			}
+		}
+	}
+	return conflicts
+}
+
+// RemovePort forgets a switch port that is no longer planned, such as one that is being destroyed.
+func (r *SwitchPortRegistry) RemovePort(port SwitchPortKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.ports, port)
+}
+
+// PlanLinkAggregation records the member ports of a link aggregation and returns the conflicts between the
+// settings planned for them. Each member is compared with the first member that has planned settings.
+func (r *SwitchPortRegistry) PlanLinkAggregation(members []SwitchPortKey) []SwitchPortConflict {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.aggregations[aggregationKey(members)] = members
+
+	var reference *SwitchPortKey
+	var conflicts []SwitchPortConflict
+	for i, member := range members {
+		if _, ok := r.ports[member]; !ok {
+			continue
+		}
+		if reference == nil {
+			reference = &members[i]
+			continue
+		}
+		conflicts = append(conflicts, r.compare(member, *reference)...)
+	}
+	return conflicts
+}
+
+// RemoveLinkAggregation forgets a link aggregation that is no longer planned with the given members.
+func (r *SwitchPortRegistry) RemoveLinkAggregation(members []SwitchPortKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.aggregations, aggregationKey(members))
+}
+
+// compare returns the settings that port and other are both planned with, but with different values.
+func (r *SwitchPortRegistry) compare(port, other SwitchPortKey) []SwitchPortConflict {
+	settings, otherSettings := r.ports[port], r.ports[other]
+
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var conflicts []SwitchPortConflict
+	for _, name := range names {
+		value, otherValue := settings[name], otherSettings[name]
+		if value == "" || otherValue == "" || value == otherValue {
+			continue
+		}
+		conflicts = append(conflicts, SwitchPortConflict{
+			Port:       port,
+			Other:      other,
+			Setting:    name,
+			Value:      value,
+			OtherValue: otherValue,
+		})
+	}
+	return conflicts
+}
+
+// aggregationKey identifies a link aggregation by its sorted member ports, since its ID is unknown until it is
+// created.
+func aggregationKey(members []SwitchPortKey) string {
+	keys := make([]string, 0, len(members))
+	for _, member := range members {
+		keys = append(keys, member.Serial+"/"+member.PortId)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+func containsPort(members []SwitchPortKey, port SwitchPortKey) bool {
+	for _, member := range members {
+		if member == port {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSwitchPortRegistry(t *testing.T) {
+	port1 := NewSwitchPortKey("q2xx-xxxx-xxxx", "1")
+	port2 := SwitchPortKey{Serial: "Q2XX-XXXX-XXXX", PortId: "2"}
+	port3 := SwitchPortKey{Serial: "Q2YY-YYYY-YYYY", PortId: "1"}
+
+	// Test case: A port planned after the link aggregation is compared with the other members
+	t.Run("port planned last", func(t *testing.T) {
+		registry := NewSwitchPortRegistry()
+
+		assert.Empty(t, registry.PlanLinkAggregation([]SwitchPortKey{port1, port2}))
+		assert.Empty(t, registry.PlanPort(port1, map[string]string{"type": "trunk", "vlan": "1"}))
+
+		conflicts := registry.PlanPort(port2, map[string]string{"type": "access", "vlan": ""})
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, SwitchPortConflict{Port: port2, Other: port1, Setting: "type", Value: "access", OtherValue: "trunk"}, conflicts[0])
+		assert.Contains(t, conflicts[0].String(), `Q2XX-XXXX-XXXX port 2 sets type to "access"`)
+	})
+
+	// Test case: A link aggregation planned after its ports compares each member with the first one
+	t.Run("link aggregation planned last", func(t *testing.T) {
+		registry := NewSwitchPortRegistry()
+
+		registry.PlanPort(port1, map[string]string{"type": "trunk"})
+		registry.PlanPort(port2, map[string]string{"type": "trunk"})
+		registry.PlanPort(port3, map[string]string{"type": "access"})
+
+		assert.Empty(t, registry.PlanLinkAggregation([]SwitchPortKey{port1, port2}))
+
+		conflicts := registry.PlanLinkAggregation([]SwitchPortKey{port1, port2, port3})
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, port3, conflicts[0].Port)
+		assert.Equal(t, port1, conflicts[0].Other)
+	})
+
+	// Test case: Removed ports and link aggregations are no longer compared
+	t.Run("removed", func(t *testing.T) {
+		registry := NewSwitchPortRegistry()
+
+		registry.PlanPort(port1, map[string]string{"type": "trunk"})
+		registry.PlanLinkAggregation([]SwitchPortKey{port2, port1})
+		registry.RemoveLinkAggregation([]SwitchPortKey{port1, port2})
+		assert.Empty(t, registry.PlanPort(port2, map[string]string{"type": "access"}))
+
+		registry.PlanLinkAggregation([]SwitchPortKey{port1, port2})
+		registry.RemovePort(port1)
+		assert.Empty(t, registry.PlanPort(port2, map[string]string{"type": "access"}))
+	})
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -60,3 +61,14 @@ func ListInt64TypeToInt32Array(data types.List) ([]int32, diag.Diagnostics) {
 	return int32Array, diags
 
 }
+
+// ConvertJSON copies in to out by encoding it as JSON. It converts between API client types that describe the same
+// payload, such as the typed and map responses that the generated client returns for the device and switch stack
+// variants of an endpoint.
+func ConvertJSON(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"net/netip"
+	"strings"
+)
+
+var _ validator.String = firewallPortsValidator{}
+
+// firewallPortsValidator validates the src_port and dest_port of firewall rules.
+type firewallPortsValidator struct{}
+
+// FirewallPortsValidator returns a validator that checks a value with ValidateFirewallPorts.
+func FirewallPortsValidator() validator.String {
+	return firewallPortsValidator{}
+}
+
+func (v firewallPortsValidator) Description(ctx context.Context) string {
+	return "value must be 'Any' or a comma-separated list of ports and port ranges between 1 and 65535"
+}
+
+func (v firewallPortsValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be `Any` or a comma-separated list of ports and port ranges between 1 and 65535"
+}
+
+func (v firewallPortsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateFirewallPorts(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Firewall Port", err.Error())
+	}
+}
+
+var _ validator.String = serialValidator{}
+
+// serialValidator validates the serial of a Meraki device.
+type serialValidator struct{}
+
+// SerialValidator returns a validator that checks a value with ParseSerial.
+func SerialValidator() validator.String {
+	return serialValidator{}
+}
+
+func (v serialValidator) Description(ctx context.Context) string {
+	return "value must be a Meraki serial in the format Qxxx-xxxx-xxxx"
+}
+
+func (v serialValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a Meraki serial in the format `Qxxx-xxxx-xxxx`"
+}
+
+func (v serialValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseSerial(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Serial", err.Error())
+	}
+}
+
+var _ validator.String = ipv4SubnetValidator{}
+
+// ipv4SubnetValidator validates an IPv4 subnet in CIDR notation.
+type ipv4SubnetValidator struct{}
+
+// IPv4SubnetValidator returns a validator that checks a value with ParseIPv4Subnet.
+func IPv4SubnetValidator() validator.String {
+	return ipv4SubnetValidator{}
+}
+
+func (v ipv4SubnetValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 subnet in CIDR notation, such as 10.1.1.0/24"
+}
+
+func (v ipv4SubnetValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an IPv4 subnet in CIDR notation, such as `10.1.1.0/24`"
+}
+
+func (v ipv4SubnetValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseIPv4Subnet(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Subnet", err.Error())
+	}
+}
+
+var _ validator.String = ipv4AddressValidator{}
+
+// ipv4AddressValidator validates an IPv4 address.
+type ipv4AddressValidator struct{}
+
+// IPv4AddressValidator returns a validator that checks that a value is an IPv4 address.
+func IPv4AddressValidator() validator.String {
+	return ipv4AddressValidator{}
+}
+
+func (v ipv4AddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 address"
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(req.ConfigValue.ValueString()))
+	if err == nil && !addr.Is4() {
+		err = fmt.Errorf("%q is not an IPv4 address", req.ConfigValue.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IP Address", err.Error())
+	}
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerialValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		err   bool
+	}{
+		{name: "valid", value: types.StringValue("Q2AB-CD12-EF34")},
+		{name: "lower case", value: types.StringValue("q2ab-cd12-ef34")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "invalid", value: types.StringValue("Q2AB-CD12"), err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("serial"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			SerialValidator().ValidateString(context.Background(), req, resp)
+			assert.Equal(t, tt.err, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestIPv4Validators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		err       bool
+	}{
+		{name: "subnet", validator: IPv4SubnetValidator(), value: types.StringValue("10.1.1.0/24")},
+		{name: "subnet with host bits", validator: IPv4SubnetValidator(), value: types.StringValue("10.1.1.1/24"), err: true},
+		{name: "subnet unknown", validator: IPv4SubnetValidator(), value: types.StringUnknown()},
+		{name: "address", validator: IPv4AddressValidator(), value: types.StringValue("10.1.1.1")},
+		{name: "IPv6 address", validator: IPv4AddressValidator(), value: types.StringValue("2001:db8::1"), err: true},
+		{name: "address null", validator: IPv4AddressValidator(), value: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("subnet"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			tt.validator.ValidateString(context.Background(), req, resp)
+			assert.Equal(t, tt.err, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// serialPattern matches Meraki serial numbers such as Q2XX-XXXX-XXXX.
+var serialPattern = regexp.MustCompile(`^Q[0-9A-Z]{3}-[0-9A-Z]{4}-[0-9A-Z]{4}$`)
+
+// IsKnown reports whether value is neither null nor unknown, which is when it can be sent to the Dashboard API.
+func IsKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// NormalizeMAC returns mac as lowercase colon-separated octets, the format the Dashboard API returns.
+// Colon, hyphen and dot separated addresses as well as bare hex strings are accepted.
+func NormalizeMAC(mac string) (string, error) {
+	digits := strings.NewReplacer(":", "", "-", "", ".", "").Replace(strings.TrimSpace(mac))
+	if len(digits) != 12 {
+		return "", fmt.Errorf("%q is not a MAC address: expected 12 hex digits", mac)
+	}
+
+	octets, err := hex.DecodeString(digits)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a MAC address: %w", mac, err)
+	}
+
+	normalized := make([]string, len(octets))
+	for i, octet := range octets {
+		normalized[i] = fmt.Sprintf("%02x", octet)
+	}
+	return strings.Join(normalized, ":"), nil
+}
+
+// ParseSerial returns serial in upper case after checking that it has the Qxxx-xxxx-xxxx format of Meraki serials.
+func ParseSerial(serial string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(serial))
+	if !serialPattern.MatchString(normalized) {
+		return "", fmt.Errorf("%q is not a Meraki serial: expected the format Qxxx-xxxx-xxxx", serial)
+	}
+	return normalized, nil
+}
+
+// ApplianceSubnet returns the network address of an IPv4 CIDR and the address of the given host within it, which
+// are the subnet and appliance_ip of an appliance VLAN. Host 1 is the first usable address.
+func ApplianceSubnet(cidr string, host int64) (string, string, error) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+	if err != nil {
+		return "", "", fmt.Errorf("%q is not a CIDR: %w", cidr, err)
+	}
+	if !prefix.Addr().Is4() {
+		return "", "", fmt.Errorf("%q is not an IPv4 CIDR", cidr)
+	}
+
+	prefix = prefix.Masked()
+	usable := int64(1)<<(32-prefix.Bits()) - 2
+	if usable < 1 {
+		return "", "", fmt.Errorf("subnet %s has no usable host addresses", prefix)
+	}
+	if host < 1 || host > usable {
+		return "", "", fmt.Errorf("host %d is outside of the usable range 1-%d of subnet %s", host, usable, prefix)
+	}
+
+	network := prefix.Addr().As4()
+	address := uint32(network[0])<<24 | uint32(network[1])<<16 | uint32(network[2])<<8 | uint32(network[3])
+	address += uint32(host)
+	applianceIP := netip.AddrFrom4([4]byte{byte(address >> 24), byte(address >> 16), byte(address >> 8), byte(address)})
+
+	return prefix.String(), applianceIP.String(), nil
+}
+
+// ValidateApplianceIP checks that applianceIP is a usable host address within the IPv4 subnet of a VLAN.
+func ValidateApplianceIP(subnet, applianceIP string) error {
+	return validateHostIP(subnet, applianceIP, "appliance IP")
+}
+
+// ValidateInterfaceIP checks that interfaceIP is a usable host address within the IPv4 subnet of a switch routing
+// interface.
+func ValidateInterfaceIP(subnet, interfaceIP string) error {
+	return validateHostIP(subnet, interfaceIP, "interface IP")
+}
+
+// validateHostIP checks that ip is a usable host address within an IPv4 subnet. name describes the address in
+// errors.
+func validateHostIP(subnet, ip, name string) error {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(subnet))
+	if err != nil {
+		return fmt.Errorf("%q is not a CIDR: %w", subnet, err)
+	}
+	if !prefix.Addr().Is4() {
+		return fmt.Errorf("%q is not an IPv4 CIDR", subnet)
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return fmt.Errorf("%q is not an IP address: %w", ip, err)
+	}
+
+	prefix = prefix.Masked()
+	if !prefix.Contains(addr) {
+		return fmt.Errorf("%s %s is not within subnet %s", name, addr, prefix)
+	}
+
+	// The network and broadcast addresses cannot be assigned to a host
+	if prefix.Bits() < 31 {
+		broadcast := prefix.Addr().As4()
+		for i := prefix.Bits(); i < 32; i++ {
+			broadcast[i/8] |= 1 << (7 - i%8)
+		}
+		if addr == prefix.Addr() || addr == netip.AddrFrom4(broadcast) {
+			return fmt.Errorf("%s %s is the network or broadcast address of subnet %s", name, addr, prefix)
+		}
+	}
+
+	return nil
+}
+
+// ParseIPv4Subnet parses an IPv4 CIDR that is written as its network address, such as 10.1.1.0/24. The Dashboard
+// API returns subnets in this form, so a CIDR with host bits set would show up as a change after every apply.
+func ParseIPv4Subnet(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a CIDR: %w", cidr, err)
+	}
+	if !prefix.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("%q is not an IPv4 CIDR", cidr)
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return netip.Prefix{}, fmt.Errorf("%q has host bits set: use the network address %s", cidr, masked)
+	}
+	return prefix, nil
+}
+
+// FirewallPortRange returns the src_port or dest_port value of a firewall rule for the ports from-to.
+// A single port is returned when from and to are equal.
+func FirewallPortRange(from, to int64) (string, error) {
+	if err := validatePort(from); err != nil {
+		return "", err
+	}
+	if err := validatePort(to); err != nil {
+		return "", err
+	}
+	if from > to {
+		return "", fmt.Errorf("port range start %d is greater than its end %d", from, to)
+	}
+
+	if from == to {
+		return strconv.FormatInt(from, 10), nil
+	}
+	return fmt.Sprintf("%d-%d", from, to), nil
+}
+
+// ValidateFirewallPorts checks a firewall rule src_port or dest_port value, which is 'Any' or a comma-separated list
+// of ports and port ranges between 1 and 65535.
+func ValidateFirewallPorts(ports string) error {
+	if strings.EqualFold(strings.TrimSpace(ports), "any") {
+		return nil
+	}
+
+	for _, entry := range strings.Split(ports, ",") {
+		entry = strings.TrimSpace(entry)
+		start, end, isRange := strings.Cut(entry, "-")
+		if !isRange {
+			end = start
+		}
+
+		from, err := strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a port or port range", entry)
+		}
+		to, err := strconv.ParseInt(strings.TrimSpace(end), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a port or port range", entry)
+		}
+		if _, err := FirewallPortRange(from, to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePort(port int64) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("port %d is outside of the range 1-65535", port)
+	}
+	return nil
+}
+
+// ParsePortRange parses a single port or a port range such as 8080-8090, returning the first and last port.
+// A single port is returned as a range of one port.
+func ParsePortRange(ports string) (int64, int64, error) {
+	start, end, isRange := strings.Cut(strings.TrimSpace(ports), "-")
+	if !isRange {
+		end = start
+	}
+
+	from, err := strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%q is not a port or port range", ports)
+	}
+	to, err := strconv.ParseInt(strings.TrimSpace(end), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%q is not a port or port range", ports)
+	}
+	if _, err := FirewallPortRange(from, to); err != nil {
+		return 0, 0, err
+	}
+	return from, to, nil
+}
+
+// ValidateAllowedIp checks a remote address that NAT and port forwarding rules allow inbound connections from,
+// which is 'any', an IPv4 address or an IPv4 CIDR.
+func ValidateAllowedIp(ip string) error {
+	ip = strings.TrimSpace(ip)
+	if strings.EqualFold(ip, "any") {
+		return nil
+	}
+
+	if strings.Contains(ip, "/") {
+		prefix, err := netip.ParsePrefix(ip)
+		if err != nil || !prefix.Addr().Is4() {
+			return fmt.Errorf("%q is not 'any', an IPv4 address or an IPv4 CIDR", ip)
+		}
+		return nil
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || !addr.Is4() {
+		return fmt.Errorf("%q is not 'any', an IPv4 address or an IPv4 CIDR", ip)
+	}
+	return nil
+}
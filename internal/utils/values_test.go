@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsKnown(t *testing.T) {
+	assert.True(t, IsKnown(types.StringValue("")))
+	assert.True(t, IsKnown(types.Int64Value(0)))
+	assert.False(t, IsKnown(types.StringNull()))
+	assert.False(t, IsKnown(types.ListUnknown(types.StringType)))
+}
+
+func TestNormalizeMAC(t *testing.T) {
+	for _, mac := range []string{"AA:BB:CC:DD:EE:0F", "aa-bb-cc-dd-ee-0f", "aabb.ccdd.ee0f", "AABBCCDDEE0F", " aa:bb:cc:dd:ee:0f "} {
+		normalized, err := NormalizeMAC(mac)
+		require.NoError(t, err, mac)
+		assert.Equal(t, "aa:bb:cc:dd:ee:0f", normalized, mac)
+	}
+
+	for _, mac := range []string{"", "aa:bb:cc:dd:ee", "aa:bb:cc:dd:ee:gg", "aa:bb:cc:dd:ee:ff:00"} {
+		_, err := NormalizeMAC(mac)
+		assert.Error(t, err, mac)
+	}
+}
+
+func TestParseSerial(t *testing.T) {
+	serial, err := ParseSerial(" q2ab-cd12-ef34 ")
+	require.NoError(t, err)
+	assert.Equal(t, "Q2AB-CD12-EF34", serial)
+
+	for _, serial := range []string{"", "Q2AB-CD12", "X2AB-CD12-EF34", "Q2AB_CD12_EF34", "Q2AB-CD12-EF345"} {
+		_, err := ParseSerial(serial)
+		assert.Error(t, err, serial)
+	}
+}
+
+func TestApplianceSubnet(t *testing.T) {
+	// Test case: The CIDR is masked to its network address
+	subnet, applianceIP, err := ApplianceSubnet("192.168.10.77/24", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.10.0/24", subnet)
+	assert.Equal(t, "192.168.10.1", applianceIP)
+
+	// Test case: Host numbers carry across octets
+	_, applianceIP, err = ApplianceSubnet("10.0.0.0/16", 300)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.1.44", applianceIP)
+
+	// Test case: The last usable host is accepted, the broadcast address is not
+	_, applianceIP, err = ApplianceSubnet("192.168.10.0/30", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.10.2", applianceIP)
+	_, _, err = ApplianceSubnet("192.168.10.0/30", 3)
+	assert.Error(t, err)
+
+	for _, cidr := range []string{"192.168.10.0", "192.168.10.0/32", "2001:db8::/64"} {
+		_, _, err := ApplianceSubnet(cidr, 1)
+		assert.Error(t, err, cidr)
+	}
+	_, _, err = ApplianceSubnet("192.168.10.0/24", 0)
+	assert.Error(t, err)
+}
+
+func TestValidateApplianceIP(t *testing.T) {
+	assert.NoError(t, ValidateApplianceIP("192.168.1.0/24", "192.168.1.2"))
+	assert.NoError(t, ValidateApplianceIP("192.168.1.0/31", "192.168.1.0"))
+
+	assert.ErrorContains(t, ValidateApplianceIP("192.168.1.0/24", "192.168.2.1"), "not within subnet")
+	assert.ErrorContains(t, ValidateApplianceIP("192.168.1.0/24", "192.168.1.0"), "network or broadcast")
+	assert.ErrorContains(t, ValidateApplianceIP("192.168.1.0/24", "192.168.1.255"), "network or broadcast")
+	assert.Error(t, ValidateApplianceIP("192.168.1.0", "192.168.1.1"))
+	assert.Error(t, ValidateApplianceIP("192.168.1.0/24", "my-appliance"))
+}
+
+func TestValidateInterfaceIP(t *testing.T) {
+	assert.NoError(t, ValidateInterfaceIP("10.1.10.0/24", "10.1.10.1"))
+
+	assert.ErrorContains(t, ValidateInterfaceIP("10.1.10.0/24", "10.1.20.1"), "interface IP 10.1.20.1 is not within subnet")
+	assert.ErrorContains(t, ValidateInterfaceIP("10.1.10.0/24", "10.1.10.255"), "network or broadcast")
+}
+
+func TestParseIPv4Subnet(t *testing.T) {
+	prefix, err := ParseIPv4Subnet("10.1.10.0/24")
+	require.NoError(t, err)
+	assert.Equal(t, "10.1.10.0/24", prefix.String())
+
+	_, err = ParseIPv4Subnet("10.1.10.1/24")
+	assert.ErrorContains(t, err, "use the network address 10.1.10.0/24")
+	_, err = ParseIPv4Subnet("2001:db8::/32")
+	assert.Error(t, err)
+	_, err = ParseIPv4Subnet("10.1.10.0")
+	assert.Error(t, err)
+}
+
+func TestFirewallPortRange(t *testing.T) {
+	ports, err := FirewallPortRange(8080, 8090)
+	require.NoError(t, err)
+	assert.Equal(t, "8080-8090", ports)
+
+	ports, err = FirewallPortRange(443, 443)
+	require.NoError(t, err)
+	assert.Equal(t, "443", ports)
+
+	_, err = FirewallPortRange(0, 80)
+	assert.Error(t, err)
+	_, err = FirewallPortRange(80, 65536)
+	assert.Error(t, err)
+	_, err = FirewallPortRange(90, 80)
+	assert.Error(t, err)
+}
+
+func TestValidateFirewallPorts(t *testing.T) {
+	for _, ports := range []string{"Any", "any", "443", "1,33", "80, 443, 8080-8090", "1-65535"} {
+		assert.NoError(t, ValidateFirewallPorts(ports), ports)
+	}
+
+	for _, ports := range []string{"", "http", "0", "65536", "90-80", "80,", "80-"} {
+		assert.Error(t, ValidateFirewallPorts(ports), ports)
+	}
+}
+
+func TestParsePortRange(t *testing.T) {
+	from, to, err := ParsePortRange("8080-8090")
+	require.NoError(t, err)
+	assert.Equal(t, int64(8080), from)
+	assert.Equal(t, int64(8090), to)
+
+	// Test case: A single port is a range of one port
+	from, to, err = ParsePortRange(" 443 ")
+	require.NoError(t, err)
+	assert.Equal(t, int64(443), from)
+	assert.Equal(t, int64(443), to)
+
+	for _, ports := range []string{"", "any", "0", "65536", "90-80", "80,443", "80-"} {
+		_, _, err := ParsePortRange(ports)
+		assert.Error(t, err, ports)
+	}
+}
+
+func TestValidateAllowedIp(t *testing.T) {
+	for _, ip := range []string{"any", "Any", "192.0.2.10", "192.0.2.0/24"} {
+		assert.NoError(t, ValidateAllowedIp(ip), ip)
+	}
+	for _, ip := range []string{"", "192.0.2", "192.0.2.0/33", "2001:db8::1", "example.com"} {
+		assert.Error(t, ValidateAllowedIp(ip), ip)
+	}
+}